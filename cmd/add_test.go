@@ -114,7 +114,7 @@ func (m *mockAddGitClient) RemoteRemove(_ string) error    { return nil }
 func (m *mockAddGitClient) RemoteSetURL(_, _ string) error { return nil }
 
 // Tag Operations methods
-func (m *mockAddGitClient) TagList(_ []string) error              { return nil }
+func (m *mockAddGitClient) TagList(_ []string) (string, error)    { return "", nil }
 func (m *mockAddGitClient) TagCreate(_, _ string) error           { return nil }
 func (m *mockAddGitClient) TagCreateAnnotated(_, _ string) error  { return nil }
 func (m *mockAddGitClient) TagDelete(_ []string) error            { return nil }
@@ -0,0 +1,417 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/bmf-san/ggc/v8/internal/git"
+	"github.com/bmf-san/ggc/v8/internal/prompt"
+	"github.com/bmf-san/ggc/v8/internal/ui"
+)
+
+const (
+	// bisectTagLimit and bisectCommitLimit bound the good commit picker.
+	bisectTagLimit    = 10
+	bisectCommitLimit = 20
+	// bisectBarWidth is the width of the progress bar.
+	bisectBarWidth = 20
+)
+
+// Bisecter handles bisect operations.
+type Bisecter struct {
+	gitClient    git.BisectOps
+	outputWriter io.Writer
+	helper       *Helper
+	prompter     prompt.Prompter
+}
+
+// NewBisecter creates a new Bisecter instance.
+func NewBisecter(client git.BisectOps) *Bisecter {
+	output := os.Stdout
+	helper := NewHelper()
+	helper.outputWriter = output
+	return &Bisecter{
+		gitClient:    client,
+		outputWriter: output,
+		helper:       helper,
+		prompter:     prompt.New(os.Stdin, output),
+	}
+}
+
+// Bisect executes bisect commands. Without arguments it starts or resumes
+// the guided mode.
+func (b *Bisecter) Bisect(args []string) {
+	if len(args) == 0 {
+		b.guide()
+		return
+	}
+
+	switch args[0] {
+	case "start":
+		if len(args) > 3 {
+			b.helper.ShowBisectHelp()
+			return
+		}
+		if b.start(args[1:]) {
+			WriteLine(b.outputWriter, "Test this commit, then run 'ggc bisect good', 'ggc bisect bad' or 'ggc bisect skip'.")
+		}
+	case "good", "bad", "skip":
+		if len(args) > 1 {
+			b.helper.ShowBisectHelp()
+			return
+		}
+		b.mark(args[0])
+	case "run":
+		if len(args) == 1 {
+			b.helper.ShowBisectHelp()
+			return
+		}
+		b.run(args[1:])
+	case "status":
+		b.showStatus()
+	case "reset":
+		b.reset()
+	default:
+		b.helper.ShowBisectHelp()
+	}
+}
+
+// inProgress reports whether a bisect is in progress; when none is and
+// report is set, it says so.
+func (b *Bisecter) inProgress(report bool) bool {
+	op, err := b.gitClient.OperationInProgress()
+	if err != nil {
+		WriteError(b.outputWriter, err)
+		return false
+	}
+	if op != git.OperationBisect {
+		if report {
+			WriteLine(b.outputWriter, "No bisect in progress; run 'ggc bisect' to start one.")
+		}
+		return false
+	}
+	return true
+}
+
+func (b *Bisecter) status() (*git.BisectStatus, bool) {
+	status, err := b.gitClient.BisectStatus()
+	if err != nil {
+		WriteError(b.outputWriter, err)
+		return nil, false
+	}
+	return status, true
+}
+
+// start starts a bisect from a bad commit, HEAD by default, and a good
+// commit that is asked for when not given.
+func (b *Bisecter) start(args []string) bool {
+	op, err := b.gitClient.OperationInProgress()
+	if err != nil {
+		WriteError(b.outputWriter, err)
+		return false
+	}
+	if op == git.OperationBisect {
+		WriteLine(b.outputWriter, "A bisect is already in progress; run 'ggc bisect' to continue it or 'ggc bisect reset' to end it.")
+		return false
+	}
+
+	bad := "HEAD"
+	if len(args) > 0 {
+		bad = args[0]
+	}
+	var good string
+	if len(args) > 1 {
+		good = args[1]
+	} else {
+		var ok bool
+		if good, ok = b.selectGood(); !ok {
+			return false
+		}
+	}
+	for _, ref := range []string{bad, good} {
+		if !b.gitClient.RevParseVerify(ref) {
+			WriteErrorf(b.outputWriter, "unknown ref '%s'", ref)
+			return false
+		}
+	}
+
+	if err := b.gitClient.BisectStart(bad, good); err != nil {
+		WriteError(b.outputWriter, err)
+		return false
+	}
+	WriteLinef(b.outputWriter, "Bisecting between %s (bad) and %s (good)", bad, good)
+	status, ok := b.status()
+	if !ok {
+		return false
+	}
+	if status.Done() {
+		b.finish(status)
+		return false
+	}
+	b.writeProgress(status)
+	return true
+}
+
+// selectGood asks for a commit or tag where the bug did not happen yet.
+func (b *Bisecter) selectGood() (string, bool) {
+	var refs, items []string
+	tags, err := b.gitClient.TagList(nil)
+	if err != nil {
+		WriteError(b.outputWriter, err)
+		return "", false
+	}
+	for _, tag := range strings.Fields(tags) {
+		if len(refs) == bisectTagLimit {
+			break
+		}
+		refs = append(refs, tag)
+		items = append(items, "tag "+tag)
+	}
+	commits, err := b.gitClient.LogRecent(bisectCommitLimit)
+	if err != nil {
+		WriteError(b.outputWriter, err)
+		return "", false
+	}
+	for _, line := range strings.Split(strings.TrimSpace(commits), "\n") {
+		if fields := strings.Fields(line); len(fields) > 0 {
+			refs = append(refs, fields[0])
+			items = append(items, line)
+		}
+	}
+	if len(refs) == 0 {
+		WriteLine(b.outputWriter, "No commits to bisect.")
+		return "", false
+	}
+	if b.prompter == nil {
+		return "", false
+	}
+
+	idx, canceled, err := b.prompter.Select("Tags and recent commits:", items, "Enter the number of a commit where the bug did not happen: ")
+	if canceled {
+		return "", false
+	}
+	if err != nil {
+		if errors.Is(err, prompt.ErrInvalidSelection) {
+			WriteLine(b.outputWriter, "Invalid number.")
+		} else {
+			WriteError(b.outputWriter, err)
+		}
+		return "", false
+	}
+	return refs[idx], true
+}
+
+// writeProgress shows the commit being tested and a bar of the steps done
+// against the estimated total.
+func (b *Bisecter) writeProgress(status *git.BisectStatus) {
+	colors := ui.NewANSIColors()
+	total := status.Tested + 1 + status.Steps
+	filled := status.Tested * bisectBarWidth / total
+	bar := strings.Repeat("#", filled) + strings.Repeat("-", bisectBarWidth-filled)
+	WriteLinef(b.outputWriter, "%s[%s]%s step %d of about %d, %d commit%s left to test after this one",
+		colors.Cyan, bar, colors.Reset, status.Tested+1, total, status.Remaining, ui.Pluralize(status.Remaining))
+	if current, err := b.gitClient.LogRecent(1); err == nil && strings.TrimSpace(current) != "" {
+		WriteLinef(b.outputWriter, "Testing: %s%s%s", colors.Bold, strings.TrimSpace(current), colors.Reset)
+	}
+}
+
+// mark marks the checked out commit and moves on to the next one.
+func (b *Bisecter) mark(term string) {
+	if !b.inProgress(true) {
+		return
+	}
+	status, ok := b.markAndLoad(term)
+	if !ok {
+		return
+	}
+	if status.Done() {
+		b.finish(status)
+		return
+	}
+	b.writeProgress(status)
+}
+
+// markAndLoad marks the checked out commit and returns the new status. git
+// fails the mark when only skipped commits are left, which the status
+// reports, so that error is not shown.
+func (b *Bisecter) markAndLoad(term string) (*git.BisectStatus, bool) {
+	markErr := b.gitClient.BisectMark(term)
+	status, err := b.gitClient.BisectStatus()
+	if markErr != nil && (err != nil || !status.Done()) {
+		WriteError(b.outputWriter, markErr)
+		return nil, false
+	}
+	if err != nil {
+		WriteError(b.outputWriter, err)
+		return nil, false
+	}
+	return status, true
+}
+
+// bisectActions are the choices offered for the checked out commit.
+var bisectActions = []string{
+	"good: the bug does not happen",
+	"bad: the bug happens",
+	"skip: this commit cannot be tested",
+	"reset: end the bisect",
+}
+
+var bisectTerms = []string{"good", "bad", "skip"}
+
+// guide starts a bisect if needed and asks for the result of each commit
+// until the first bad commit is found.
+func (b *Bisecter) guide() {
+	op, err := b.gitClient.OperationInProgress()
+	if err != nil {
+		WriteError(b.outputWriter, err)
+		return
+	}
+	resuming := op == git.OperationBisect
+	if !resuming && !b.start(nil) {
+		return
+	}
+	status, ok := b.status()
+	if !ok {
+		return
+	}
+	if resuming && !status.Done() {
+		b.writeProgress(status)
+	}
+	if b.prompter == nil {
+		return
+	}
+
+	for !status.Done() {
+		idx, canceled, err := b.prompter.Select("Mark the checked out commit:", bisectActions, "Enter the number of the result: ")
+		if canceled {
+			WriteLine(b.outputWriter, "Run 'ggc bisect' to continue, or 'ggc bisect reset' to end the bisect.")
+			return
+		}
+		if err != nil {
+			if !errors.Is(err, prompt.ErrInvalidSelection) {
+				WriteError(b.outputWriter, err)
+				return
+			}
+			WriteLine(b.outputWriter, "Invalid number.")
+			continue
+		}
+		if idx == len(bisectTerms) {
+			b.reset()
+			return
+		}
+		if status, ok = b.markAndLoad(bisectTerms[idx]); !ok {
+			return
+		}
+		if !status.Done() {
+			b.writeProgress(status)
+		}
+	}
+	b.finish(status)
+}
+
+// run lets a script mark the commits.
+func (b *Bisecter) run(command []string) {
+	if !b.inProgress(true) {
+		return
+	}
+	runErr := b.gitClient.BisectRun(command)
+	status, ok := b.status()
+	if !ok {
+		return
+	}
+	if !status.Done() {
+		if runErr != nil {
+			WriteError(b.outputWriter, runErr)
+		}
+		b.writeProgress(status)
+		return
+	}
+	// git does not end its last line of output.
+	WriteLine(b.outputWriter, "")
+	b.finish(status)
+}
+
+func (b *Bisecter) showStatus() {
+	if !b.inProgress(true) {
+		return
+	}
+	status, ok := b.status()
+	if !ok {
+		return
+	}
+	if status.Done() {
+		b.writeResult(status)
+		return
+	}
+	b.writeProgress(status)
+}
+
+// finish shows the result and offers to return to the original branch.
+func (b *Bisecter) finish(status *git.BisectStatus) {
+	b.writeResult(status)
+	original := status.OriginalRef
+	if original == "" {
+		original = "the original branch"
+	}
+	if b.prompter == nil {
+		return
+	}
+	confirmed, canceled, err := b.prompter.Confirm(fmt.Sprintf("Reset to %s? (y/n): ", original))
+	if canceled || err != nil || !confirmed {
+		WriteLinef(b.outputWriter, "Run 'ggc bisect reset' to return to %s.", original)
+		return
+	}
+	b.reset()
+}
+
+// writeResult shows the first bad commit with details, or the candidates
+// when skipped commits hide it.
+func (b *Bisecter) writeResult(status *git.BisectStatus) {
+	colors := ui.NewANSIColors()
+	if status.FirstBad == "" {
+		WriteLinef(b.outputWriter, "%sOnly skipped commits are left; the first bad commit is one of:%s", colors.Bold+colors.Yellow, colors.Reset)
+		for _, c := range status.Candidates {
+			WriteLinef(b.outputWriter, "  %s", c)
+		}
+		return
+	}
+
+	WriteLinef(b.outputWriter, "%sFound the first bad commit%s", colors.Bold+colors.Red, colors.Reset)
+	commit := strings.Fields(status.FirstBad)[0]
+	details, err := b.gitClient.CommitDetails(commit)
+	if err != nil {
+		WriteLinef(b.outputWriter, "Commit: %s", status.FirstBad)
+		return
+	}
+	WriteLinef(b.outputWriter, "Commit: %s %s", details.Hash, details.Subject)
+	WriteLinef(b.outputWriter, "Author: %s", details.Author)
+	WriteLinef(b.outputWriter, "Date: %s", details.Date)
+	if branches, err := b.gitClient.BranchesContaining(commit); err == nil {
+		// Skip the "(HEAD detached at ...)" entry of the bisect checkout.
+		branches = slices.DeleteFunc(branches, func(name string) bool { return strings.HasPrefix(name, "(") })
+		if len(branches) > 0 {
+			WriteLinef(b.outputWriter, "Branches: %s", strings.Join(branches, ", "))
+		}
+	}
+	if files, err := b.gitClient.CommitFiles(commit, 0); err == nil && strings.TrimSpace(files) != "" {
+		WriteLine(b.outputWriter, "Files:")
+		for _, line := range strings.Split(strings.TrimSpace(files), "\n") {
+			WriteLinef(b.outputWriter, "  %s", strings.ReplaceAll(line, "\t", " "))
+		}
+	}
+}
+
+func (b *Bisecter) reset() {
+	if !b.inProgress(true) {
+		return
+	}
+	if err := b.gitClient.BisectReset(); err != nil {
+		WriteError(b.outputWriter, err)
+		return
+	}
+	WriteLine(b.outputWriter, "Bisect ended")
+}
@@ -0,0 +1,263 @@
+package cmd
+
+import (
+	"bytes"
+	"errors"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/bmf-san/ggc/v8/internal/git"
+	"github.com/bmf-san/ggc/v8/internal/prompt"
+)
+
+// mockBisectClient implements git.BisectOps. Each mark pops the next
+// status from steps, so a test scripts how the bisect narrows down.
+type mockBisectClient struct {
+	calls     []string
+	bisecting bool
+	status    *git.BisectStatus
+	steps     []*git.BisectStatus
+	markErr   error
+	tags      string
+	recent    string
+	validRefs map[string]bool
+}
+
+func (m *mockBisectClient) BisectStart(bad, good string) error {
+	m.calls = append(m.calls, "start "+bad+" "+good)
+	m.bisecting = true
+	return nil
+}
+func (m *mockBisectClient) BisectMark(term string) error {
+	m.calls = append(m.calls, term)
+	if len(m.steps) > 0 {
+		m.status, m.steps = m.steps[0], m.steps[1:]
+	}
+	return m.markErr
+}
+func (m *mockBisectClient) BisectRun(command []string) error {
+	m.calls = append(m.calls, "run "+strings.Join(command, " "))
+	if len(m.steps) > 0 {
+		m.status = m.steps[len(m.steps)-1]
+	}
+	return nil
+}
+func (m *mockBisectClient) BisectReset() error {
+	m.calls = append(m.calls, "reset")
+	m.bisecting = false
+	return nil
+}
+func (m *mockBisectClient) BisectStatus() (*git.BisectStatus, error) { return m.status, nil }
+func (m *mockBisectClient) OperationInProgress() (git.Operation, error) {
+	if m.bisecting {
+		return git.OperationBisect, nil
+	}
+	return git.OperationNone, nil
+}
+func (m *mockBisectClient) TagList([]string) (string, error) { return m.tags, nil }
+func (m *mockBisectClient) LogRecent(limit int) (string, error) {
+	if limit == 1 {
+		return "abc1234 current commit\n", nil
+	}
+	return m.recent, nil
+}
+func (m *mockBisectClient) CommitDetails(commit string) (*git.CommitDetails, error) {
+	return &git.CommitDetails{Hash: commit, Author: "Jane <jane@example.com>", Date: "2026-10-19", Subject: "break parser"}, nil
+}
+func (m *mockBisectClient) CommitFiles(string, int) (string, error) { return "M\tparser.go\n", nil }
+func (m *mockBisectClient) BranchesContaining(string) ([]string, error) {
+	return []string{"(HEAD detached at abc1234)", "main"}, nil
+}
+func (m *mockBisectClient) RevParseVerify(ref string) bool { return m.validRefs[ref] }
+
+var _ git.BisectOps = (*mockBisectClient)(nil)
+
+func newMockBisectClient() *mockBisectClient {
+	return &mockBisectClient{
+		status:    &git.BisectStatus{OriginalRef: "main", Remaining: 3, Steps: 2},
+		tags:      "v1.1.0\nv1.0.0\n",
+		recent:    "fff0001 newest\neee0002 older\n",
+		validRefs: map[string]bool{"HEAD": true, "v1.1.0": true, "v1.0.0": true, "eee0002": true},
+	}
+}
+
+func newTestBisecter(client *mockBisectClient, buf *bytes.Buffer, input string) *Bisecter {
+	helper := NewHelper()
+	helper.outputWriter = buf
+	return &Bisecter{
+		gitClient:    client,
+		outputWriter: buf,
+		helper:       helper,
+		prompter:     prompt.New(strings.NewReader(input), buf),
+	}
+}
+
+var bisectFound = &git.BisectStatus{OriginalRef: "main", Tested: 2, FirstBad: "ddd0003 break parser"}
+
+func TestBisecter_StartWithRefs(t *testing.T) {
+	var buf bytes.Buffer
+	client := newMockBisectClient()
+	newTestBisecter(client, &buf, "").Bisect([]string{"start", "HEAD", "v1.0.0"})
+
+	if !slices.Equal(client.calls, []string{"start HEAD v1.0.0"}) {
+		t.Errorf("calls = %v", client.calls)
+	}
+	out := buf.String()
+	if !strings.Contains(out, "step 1 of about 3") || !strings.Contains(out, "abc1234 current commit") {
+		t.Errorf("expected the progress and the commit to test, got %q", out)
+	}
+	if !strings.Contains(out, "ggc bisect good") {
+		t.Errorf("expected a hint on how to mark the commit, got %q", out)
+	}
+}
+
+func TestBisecter_StartPicksGoodFromTagsAndLog(t *testing.T) {
+	var buf bytes.Buffer
+	client := newMockBisectClient()
+	newTestBisecter(client, &buf, "4\n").Bisect([]string{"start"})
+
+	if !slices.Equal(client.calls, []string{"start HEAD eee0002"}) {
+		t.Errorf("calls = %v; output %q", client.calls, buf.String())
+	}
+	if !strings.Contains(buf.String(), "[1] tag v1.1.0") {
+		t.Errorf("expected tags to be offered first, got %q", buf.String())
+	}
+}
+
+func TestBisecter_StartRejectsUnknownRef(t *testing.T) {
+	var buf bytes.Buffer
+	client := newMockBisectClient()
+	newTestBisecter(client, &buf, "").Bisect([]string{"start", "HEAD", "nope"})
+
+	if len(client.calls) != 0 {
+		t.Errorf("expected no bisect to start, got %v", client.calls)
+	}
+	if !strings.Contains(buf.String(), "unknown ref 'nope'") {
+		t.Errorf("unexpected output %q", buf.String())
+	}
+}
+
+func TestBisecter_StartWhileBisecting(t *testing.T) {
+	var buf bytes.Buffer
+	client := newMockBisectClient()
+	client.bisecting = true
+	newTestBisecter(client, &buf, "").Bisect([]string{"start", "HEAD", "v1.0.0"})
+
+	if len(client.calls) != 0 || !strings.Contains(buf.String(), "already in progress") {
+		t.Errorf("calls = %v, output %q", client.calls, buf.String())
+	}
+}
+
+func TestBisecter_Guided(t *testing.T) {
+	var buf bytes.Buffer
+	client := newMockBisectClient()
+	client.steps = []*git.BisectStatus{
+		{OriginalRef: "main", Tested: 1, Remaining: 1, Steps: 1},
+		bisectFound,
+	}
+	// Pick v1.0.0 as good, mark good, then bad, then accept the reset.
+	newTestBisecter(client, &buf, "2\n1\n2\ny\n").Bisect(nil)
+
+	want := []string{"start HEAD v1.0.0", "good", "bad", "reset"}
+	if !slices.Equal(client.calls, want) {
+		t.Errorf("calls = %v, want %v", client.calls, want)
+	}
+	out := buf.String()
+	for _, s := range []string{"step 2 of about 3", "Found the first bad commit", "Commit: ddd0003 break parser",
+		"Author: Jane <jane@example.com>", "Branches: main", "M parser.go", "Reset to main?"} {
+		if !strings.Contains(out, s) {
+			t.Errorf("output missing %q:\n%s", s, out)
+		}
+	}
+	if strings.Contains(out, "HEAD detached") {
+		t.Errorf("the detached HEAD should not be listed as a branch:\n%s", out)
+	}
+}
+
+func TestBisecter_GuidedResumeAndReset(t *testing.T) {
+	var buf bytes.Buffer
+	client := newMockBisectClient()
+	client.bisecting = true
+	newTestBisecter(client, &buf, "4\n").Bisect(nil)
+
+	if !slices.Equal(client.calls, []string{"reset"}) {
+		t.Errorf("calls = %v", client.calls)
+	}
+	out := buf.String()
+	if !strings.Contains(out, "abc1234 current commit") || !strings.Contains(out, "Bisect ended") {
+		t.Errorf("expected the progress before the reset, got %q", out)
+	}
+}
+
+func TestBisecter_MarkFindsCulpritAndDeclinesReset(t *testing.T) {
+	var buf bytes.Buffer
+	client := newMockBisectClient()
+	client.bisecting = true
+	client.steps = []*git.BisectStatus{bisectFound}
+	newTestBisecter(client, &buf, "n\n").Bisect([]string{"bad"})
+
+	if !slices.Equal(client.calls, []string{"bad"}) {
+		t.Errorf("calls = %v", client.calls)
+	}
+	if !strings.Contains(buf.String(), "Run 'ggc bisect reset' to return to main.") {
+		t.Errorf("expected a reset hint, got %q", buf.String())
+	}
+}
+
+func TestBisecter_OnlySkippedLeft(t *testing.T) {
+	var buf bytes.Buffer
+	client := newMockBisectClient()
+	client.bisecting = true
+	client.markErr = errors.New("exit status 2")
+	client.steps = []*git.BisectStatus{{OriginalRef: "main", Candidates: []string{"aaa0001 one", "bbb0002 two"}}}
+	newTestBisecter(client, &buf, "n\n").Bisect([]string{"skip"})
+
+	out := buf.String()
+	if strings.Contains(out, "exit status 2") {
+		t.Errorf("git's error should not be shown when only skipped commits are left, got %q", out)
+	}
+	if !strings.Contains(out, "Only skipped commits are left") || !strings.Contains(out, "bbb0002 two") {
+		t.Errorf("expected the candidates, got %q", out)
+	}
+}
+
+func TestBisecter_MarkError(t *testing.T) {
+	var buf bytes.Buffer
+	client := newMockBisectClient()
+	client.bisecting = true
+	client.markErr = errors.New("checkout failed")
+	newTestBisecter(client, &buf, "").Bisect([]string{"good"})
+
+	if !strings.Contains(buf.String(), "checkout failed") {
+		t.Errorf("expected the error, got %q", buf.String())
+	}
+}
+
+func TestBisecter_Run(t *testing.T) {
+	var buf bytes.Buffer
+	client := newMockBisectClient()
+	client.bisecting = true
+	client.steps = []*git.BisectStatus{bisectFound}
+	newTestBisecter(client, &buf, "y\n").Bisect([]string{"run", "go", "test", "./..."})
+
+	want := []string{"run go test ./...", "reset"}
+	if !slices.Equal(client.calls, want) {
+		t.Errorf("calls = %v, want %v", client.calls, want)
+	}
+	if !strings.Contains(buf.String(), "Commit: ddd0003 break parser") {
+		t.Errorf("expected the culprit, got %q", buf.String())
+	}
+}
+
+func TestBisecter_NotBisecting(t *testing.T) {
+	for _, args := range [][]string{{"good"}, {"run", "true"}, {"status"}, {"reset"}} {
+		var buf bytes.Buffer
+		client := newMockBisectClient()
+		newTestBisecter(client, &buf, "").Bisect(args)
+
+		if len(client.calls) != 0 || !strings.Contains(buf.String(), "No bisect in progress") {
+			t.Errorf("%v: calls = %v, output %q", args, client.calls, buf.String())
+		}
+	}
+}
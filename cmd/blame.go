@@ -0,0 +1,287 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/bmf-san/ggc/v8/internal/git"
+	"github.com/bmf-san/ggc/v8/internal/prompt"
+	"github.com/bmf-san/ggc/v8/internal/ui"
+)
+
+// blameAuthorWidth caps the width of the author column.
+const blameAuthorWidth = 20
+
+// Blamer handles blame operations.
+type Blamer struct {
+	gitClient    git.BlameOps
+	outputWriter io.Writer
+	helper       *Helper
+	pager        *pager
+	prompter     prompt.Prompter
+	// view and collapse are the diff.view and diff.collapse settings used
+	// for commit diffs.
+	view     diffView
+	collapse []string
+	now      func() time.Time
+}
+
+// NewBlamer creates a new Blamer instance.
+func NewBlamer(client git.BlameOps) *Blamer {
+	output := os.Stdout
+	helper := NewHelper()
+	helper.outputWriter = output
+	return &Blamer{
+		gitClient:    client,
+		outputWriter: output,
+		helper:       helper,
+		prompter:     prompt.New(os.Stdin, output),
+		now:          time.Now,
+	}
+}
+
+// blameRevision is a file at a revision; an empty rev is the working tree.
+type blameRevision struct {
+	rev  string
+	path string
+}
+
+// Blame executes blame commands.
+func (b *Blamer) Blame(args []string) {
+	interactive := len(args) > 0 && args[0] == "interactive"
+	if interactive {
+		args = args[1:]
+	}
+	target, ok := parseBlameArgs(args)
+	if !ok {
+		b.helper.ShowBlameHelp()
+		return
+	}
+	if interactive {
+		b.browse(target)
+		return
+	}
+
+	lines, err := b.gitClient.Blame(target.rev, target.path)
+	if err != nil {
+		WriteError(b.outputWriter, err)
+		return
+	}
+	b.pager.write(b.outputWriter, "blame", b.render(lines))
+}
+
+// parseBlameArgs reads "[<commit>] [--] <file>".
+func parseBlameArgs(args []string) (blameRevision, bool) {
+	if i := slices.Index(args, "--"); i >= 0 {
+		if i > 1 || len(args) != i+2 {
+			return blameRevision{}, false
+		}
+		target := blameRevision{path: args[i+1]}
+		if i == 1 {
+			target.rev = args[0]
+		}
+		return target, true
+	}
+	switch len(args) {
+	case 1:
+		return blameRevision{path: args[0]}, true
+	case 2:
+		return blameRevision{rev: args[0], path: args[1]}, true
+	}
+	return blameRevision{}, false
+}
+
+// render lays out one line per file line: the commit, its author and age,
+// colored by age, then the line number and content.
+func (b *Blamer) render(lines []git.BlameLine) string {
+	colors := ui.NewANSIColors()
+	sym := ui.NewSymbols()
+	now := b.now()
+
+	authorWidth, ageWidth := 0, 0
+	for _, l := range lines {
+		authorWidth = max(authorWidth, min(ui.DisplayWidth(l.Commit.Author), blameAuthorWidth))
+		ageWidth = max(ageWidth, len(blameAge(now.Sub(l.Commit.AuthorTime))))
+	}
+	numWidth := len(strconv.Itoa(len(lines)))
+
+	var sb strings.Builder
+	for _, l := range lines {
+		c := l.Commit
+		fmt.Fprintf(&sb, "%s%s %s %-*s%s %s%*d %s%s %s\n",
+			b.ageColor(colors, c, now), c.ShortHash(), fitWidth(c.Author, authorWidth),
+			ageWidth, blameAge(now.Sub(c.AuthorTime)), colors.Reset,
+			colors.BrightBlack, numWidth, l.Line, sym.Separator, colors.Reset, expandTabs(l.Text))
+	}
+	return sb.String()
+}
+
+// ageColor highlights recent changes and dims old ones.
+func (b *Blamer) ageColor(colors *ui.ANSIColors, c *git.BlameCommit, now time.Time) string {
+	if c.Uncommitted() {
+		return colors.Magenta
+	}
+	age := now.Sub(c.AuthorTime)
+	switch {
+	case age < 7*24*time.Hour:
+		return colors.BrightGreen
+	case age < 30*24*time.Hour:
+		return colors.Green
+	case age < 365*24*time.Hour:
+		return colors.Yellow
+	default:
+		return colors.BrightBlack
+	}
+}
+
+// blameAge renders a short age such as "3d ago" or "2y ago".
+func blameAge(age time.Duration) string {
+	const day = 24 * time.Hour
+	switch {
+	case age < time.Minute:
+		return "just now"
+	case age < time.Hour:
+		return fmt.Sprintf("%dm ago", int(age/time.Minute))
+	case age < day:
+		return fmt.Sprintf("%dh ago", int(age/time.Hour))
+	case age < 30*day:
+		return fmt.Sprintf("%dd ago", int(age/day))
+	case age < 365*day:
+		return fmt.Sprintf("%dmo ago", int(age/(30*day)))
+	default:
+		return fmt.Sprintf("%dy ago", int(age/(365*day)))
+	}
+}
+
+// fitWidth pads s to width columns, cutting it with an ellipsis when it is
+// wider.
+func fitWidth(s string, width int) string {
+	return ui.FitWidth(ui.TruncateToWidth(s, width), width)
+}
+
+// The actions offered for a selected line.
+const (
+	blameActionDiff = iota
+	blameActionPrevious
+)
+
+// browse shows the blame of target and, for a selected line, the diff of
+// its commit or the blame of the revision before it. Revisions visited
+// that way can be walked back.
+func (b *Blamer) browse(target blameRevision) {
+	var history []blameRevision
+	for {
+		lines, err := b.gitClient.Blame(target.rev, target.path)
+		if err != nil {
+			WriteError(b.outputWriter, err)
+			return
+		}
+		if len(lines) == 0 {
+			WriteLinef(b.outputWriter, "%s is empty.", target.path)
+			return
+		}
+
+		b.writeHeading(target)
+		_, _ = io.WriteString(b.outputWriter, b.render(lines))
+		promptText := "Enter a line number (Enter: quit): "
+		if len(history) > 0 {
+			promptText = "Enter a line number (b: back to the newer revision, Enter: quit): "
+		}
+		input, ok := ReadLine(b.prompter, b.outputWriter, promptText)
+		input = strings.TrimSpace(input)
+		if !ok || input == "" {
+			return
+		}
+		if input == "b" && len(history) > 0 {
+			target, history = history[len(history)-1], history[:len(history)-1]
+			continue
+		}
+		n, err := strconv.Atoi(input)
+		if err != nil || n < 1 || n > len(lines) {
+			WriteLine(b.outputWriter, "Invalid number.")
+			continue
+		}
+
+		commit := lines[n-1].Commit
+		if commit.Uncommitted() {
+			WriteLinef(b.outputWriter, "Line %d is not committed yet.", n)
+			continue
+		}
+		action, ok := b.selectLineAction(n, commit)
+		if !ok {
+			continue
+		}
+		switch action {
+		case blameActionDiff:
+			b.showCommit(commit.Hash)
+		case blameActionPrevious:
+			history = append(history, target)
+			target = blameRevision{rev: commit.PreviousHash, path: commit.PreviousPath}
+		}
+	}
+}
+
+func (b *Blamer) writeHeading(target blameRevision) {
+	colors := ui.NewANSIColors()
+	heading := "Blame of " + target.path
+	if rev := target.rev; rev != "" {
+		// Shorten the full hashes of revisions reached through a commit.
+		if len(rev) >= 40 && strings.Trim(rev, "0123456789abcdef") == "" {
+			rev = rev[:7]
+		}
+		heading += " at " + rev
+	}
+	WriteLinef(b.outputWriter, "%s%s:%s", colors.Bold+colors.Cyan, heading, colors.Reset)
+}
+
+// selectLineAction asks what to do with the commit of line n.
+func (b *Blamer) selectLineAction(n int, commit *git.BlameCommit) (int, bool) {
+	actions := []string{fmt.Sprintf("Show the diff of %s %s", commit.ShortHash(), commit.Summary)}
+	if commit.PreviousHash != "" {
+		actions = append(actions, fmt.Sprintf("Blame the revision before %s", commit.ShortHash()))
+	}
+	title := fmt.Sprintf("Line %d was last changed in %s by %s, %s:", n, commit.ShortHash(), commit.Author,
+		blameAge(b.now().Sub(commit.AuthorTime)))
+	idx, canceled, err := b.prompter.Select(title, actions, "Enter the number of the action: ")
+	if canceled {
+		return 0, false
+	}
+	if err != nil {
+		if errors.Is(err, prompt.ErrInvalidSelection) {
+			WriteLine(b.outputWriter, "Invalid number.")
+		} else {
+			WriteError(b.outputWriter, err)
+		}
+		return 0, false
+	}
+	return idx, true
+}
+
+// showCommit shows the header and the diff of commit.
+func (b *Blamer) showCommit(commit string) {
+	details, err := b.gitClient.CommitDetails(commit)
+	if err != nil {
+		WriteError(b.outputWriter, err)
+		return
+	}
+	diff, err := b.gitClient.CommitDiff(commit)
+	if err != nil {
+		WriteError(b.outputWriter, err)
+		return
+	}
+
+	colors := ui.NewANSIColors()
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "%scommit %s%s\n", colors.Yellow, details.Hash, colors.Reset)
+	fmt.Fprintf(&sb, "Author: %s\n", details.Author)
+	fmt.Fprintf(&sb, "Date:   %s\n\n", details.Date)
+	fmt.Fprintf(&sb, "    %s\n\n", details.Subject)
+	sb.WriteString(renderCommitDiff(b.outputWriter, diff, b.view, b.collapse))
+	b.pager.write(b.outputWriter, "blame", sb.String())
+}
@@ -0,0 +1,220 @@
+package cmd
+
+import (
+	"bytes"
+	"errors"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/bmf-san/ggc/v8/internal/git"
+	"github.com/bmf-san/ggc/v8/internal/prompt"
+	"github.com/bmf-san/ggc/v8/internal/ui"
+)
+
+var blameNow = time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)
+
+// mockBlameClient serves a blame per revision and records the calls.
+type mockBlameClient struct {
+	calls  []string
+	blames map[string][]git.BlameLine
+	err    error
+}
+
+func (m *mockBlameClient) Blame(rev, path string) ([]git.BlameLine, error) {
+	m.calls = append(m.calls, strings.Join(strings.Fields("blame "+rev+" "+path), " "))
+	return m.blames[rev], m.err
+}
+func (m *mockBlameClient) CommitDetails(commit string) (*git.CommitDetails, error) {
+	return &git.CommitDetails{Hash: commit[:7], Author: "Jane <jane@example.com>", Date: "2026-10-18", Subject: "fix parser"}, nil
+}
+func (m *mockBlameClient) CommitDiff(commit string) (string, error) {
+	m.calls = append(m.calls, "diff "+commit)
+	return "diff --git a/f.go b/f.go\n+fixed\n", nil
+}
+
+var _ git.BlameOps = (*mockBlameClient)(nil)
+
+func newMockBlameClient() *mockBlameClient {
+	old := &git.BlameCommit{Hash: "1111111111111111111111111111111111111111", Author: "Alexander Longname-Smithson",
+		AuthorTime: blameNow.AddDate(-2, 0, 0), Summary: "initial"}
+	recent := &git.BlameCommit{Hash: "2222222222222222222222222222222222222222", Author: "Jane",
+		AuthorTime: blameNow.Add(-26 * time.Hour), Summary: "fix parser",
+		PreviousHash: "1111111111111111111111111111111111111111", PreviousPath: "old.go"}
+	wip := &git.BlameCommit{Hash: "0000000000000000000000000000000000000000", Author: "Not Committed Yet", AuthorTime: blameNow}
+	return &mockBlameClient{
+		blames: map[string][]git.BlameLine{
+			"": {
+				{Commit: old, OrigLine: 1, Line: 1, Text: "package f"},
+				{Commit: recent, OrigLine: 2, Line: 2, Text: "\tfixed()"},
+				{Commit: wip, OrigLine: 3, Line: 3, Text: "// todo"},
+			},
+			"1111111111111111111111111111111111111111": {
+				{Commit: old, OrigLine: 1, Line: 1, Text: "package f"},
+			},
+		},
+	}
+}
+
+func newTestBlamer(client *mockBlameClient, buf *bytes.Buffer, input string) *Blamer {
+	helper := NewHelper()
+	helper.outputWriter = buf
+	return &Blamer{
+		gitClient:    client,
+		outputWriter: buf,
+		helper:       helper,
+		prompter:     prompt.New(strings.NewReader(input), buf),
+		now:          func() time.Time { return blameNow },
+	}
+}
+
+func TestBlamer_Blame(t *testing.T) {
+	defer ui.SetColor(true)
+	ui.SetColor(false)
+	var buf bytes.Buffer
+	client := newMockBlameClient()
+	newTestBlamer(client, &buf, "").Blame([]string{"f.go"})
+
+	if !slices.Equal(client.calls, []string{"blame f.go"}) {
+		t.Errorf("calls = %v", client.calls)
+	}
+	lines := strings.Split(strings.TrimRight(buf.String(), "\n"), "\n")
+	want := []string{
+		"1111111 Alexander Longname-… 2y ago   1 │ package f",
+		"2222222 Jane                 1d ago   2 │     fixed()",
+		"0000000 Not Committed Yet    just now 3 │ // todo",
+	}
+	if !slices.Equal(lines, want) {
+		t.Errorf("output =\n%s\nwant\n%s", strings.Join(lines, "\n"), strings.Join(want, "\n"))
+	}
+}
+
+func TestBlamer_ColorsByAge(t *testing.T) {
+	var buf bytes.Buffer
+	newTestBlamer(newMockBlameClient(), &buf, "").Blame([]string{"f.go"})
+
+	colors := ui.NewANSIColors()
+	lines := strings.Split(buf.String(), "\n")
+	for i, want := range []string{colors.BrightBlack, colors.BrightGreen, colors.Magenta} {
+		if !strings.HasPrefix(lines[i], want) {
+			t.Errorf("line %d = %q, want it to start with %q", i+1, lines[i], want)
+		}
+	}
+}
+
+func TestBlamer_Args(t *testing.T) {
+	tests := []struct {
+		args     []string
+		wantCall string
+	}{
+		{[]string{"v1.0.0", "f.go"}, "blame v1.0.0 f.go"},
+		{[]string{"--", "interactive"}, "blame interactive"},
+		{[]string{"HEAD", "--", "f.go"}, "blame HEAD f.go"},
+	}
+
+	for _, tt := range tests {
+		t.Run(strings.Join(tt.args, " "), func(t *testing.T) {
+			var buf bytes.Buffer
+			client := newMockBlameClient()
+			newTestBlamer(client, &buf, "").Blame(tt.args)
+
+			if !slices.Equal(client.calls, []string{tt.wantCall}) {
+				t.Errorf("calls = %v, want [%s]", client.calls, tt.wantCall)
+			}
+		})
+	}
+}
+
+func TestBlamer_Usage(t *testing.T) {
+	for _, args := range [][]string{nil, {"a", "b", "c"}, {"a", "b", "--", "c"}, {"interactive"}} {
+		var buf bytes.Buffer
+		client := newMockBlameClient()
+		newTestBlamer(client, &buf, "").Blame(args)
+
+		if len(client.calls) != 0 || !strings.Contains(buf.String(), "ggc blame") {
+			t.Errorf("%v: calls = %v, output %q", args, client.calls, buf.String())
+		}
+	}
+}
+
+func TestBlamer_Error(t *testing.T) {
+	var buf bytes.Buffer
+	client := newMockBlameClient()
+	client.err = errors.New("no such path")
+	newTestBlamer(client, &buf, "").Blame([]string{"nope.go"})
+
+	if !strings.Contains(buf.String(), "no such path") {
+		t.Errorf("expected the error, got %q", buf.String())
+	}
+}
+
+func TestBlamer_InteractiveShowsDiff(t *testing.T) {
+	var buf bytes.Buffer
+	client := newMockBlameClient()
+	newTestBlamer(client, &buf, "2\n1\n\n").Blame([]string{"interactive", "f.go"})
+
+	want := []string{"blame f.go", "diff 2222222222222222222222222222222222222222", "blame f.go"}
+	if !slices.Equal(client.calls, want) {
+		t.Errorf("calls = %v, want %v", client.calls, want)
+	}
+	out := buf.String()
+	for _, s := range []string{"Blame of f.go:", "Line 2 was last changed in 2222222 by Jane, 1d ago:",
+		"commit 2222222", "Author: Jane <jane@example.com>", "    fix parser", "+fixed"} {
+		if !strings.Contains(out, s) {
+			t.Errorf("output missing %q:\n%s", s, out)
+		}
+	}
+}
+
+func TestBlamer_InteractivePreviousAndBack(t *testing.T) {
+	var buf bytes.Buffer
+	client := newMockBlameClient()
+	newTestBlamer(client, &buf, "2\n2\nb\n\n").Blame([]string{"interactive", "f.go"})
+
+	want := []string{"blame f.go", "blame 1111111111111111111111111111111111111111 old.go", "blame f.go"}
+	if !slices.Equal(client.calls, want) {
+		t.Errorf("calls = %v, want %v", client.calls, want)
+	}
+	out := buf.String()
+	if !strings.Contains(out, "Blame of old.go at 1111111:") || !strings.Contains(out, "b: back to the newer revision") {
+		t.Errorf("expected the previous revision with a way back, got:\n%s", out)
+	}
+}
+
+func TestBlamer_InteractiveLineWithoutPrevious(t *testing.T) {
+	var buf bytes.Buffer
+	client := newMockBlameClient()
+	// Line 1 offers only the diff, so 2 is not an action.
+	newTestBlamer(client, &buf, "1\n2\n3\n9\n\n").Blame([]string{"interactive", "f.go"})
+
+	out := buf.String()
+	if strings.Contains(out, "Blame the revision before 1111111") {
+		t.Errorf("a commit that added the file has no previous revision:\n%s", out)
+	}
+	if !strings.Contains(out, "Invalid number.") || !strings.Contains(out, "Line 3 is not committed yet.") {
+		t.Errorf("expected invalid input and the uncommitted line to be reported:\n%s", out)
+	}
+	if len(client.calls) != 4 {
+		t.Errorf("expected the blame to be shown again after each answer, got %v", client.calls)
+	}
+}
+
+func TestBlameAge(t *testing.T) {
+	tests := []struct {
+		age  time.Duration
+		want string
+	}{
+		{30 * time.Second, "just now"},
+		{5 * time.Minute, "5m ago"},
+		{3 * time.Hour, "3h ago"},
+		{50 * time.Hour, "2d ago"},
+		{90 * 24 * time.Hour, "3mo ago"},
+		{800 * 24 * time.Hour, "2y ago"},
+	}
+	for _, tt := range tests {
+		if got := blameAge(tt.age); got != tt.want {
+			t.Errorf("blameAge(%v) = %q, want %q", tt.age, got, tt.want)
+		}
+	}
+}
@@ -8,48 +8,66 @@ import (
 )
 
 func (b *Brancher) branchCheckout() {
+	branch, ok := b.pickLocalBranch("Enter the number to checkout: ")
+	if !ok {
+		return
+	}
+	if err := b.gitClient.CheckoutBranch(branch); err != nil {
+		WriteError(b.outputWriter, err)
+	}
+}
+
+func (b *Brancher) branchCheckoutRemote() {
+	remoteBranch, localBranch, ok := b.pickRemoteBranch("Enter the number to checkout: ")
+	if !ok {
+		return
+	}
+	if err := b.gitClient.CheckoutNewBranchFromRemote(localBranch, remoteBranch); err != nil {
+		WriteError(b.outputWriter, err)
+	}
+}
+
+// pickLocalBranch asks for one of the local branches.
+func (b *Brancher) pickLocalBranch(promptText string) (string, bool) {
 	branches, err := b.gitClient.ListLocalBranches()
 	if err != nil {
 		WriteError(b.outputWriter, err)
-		return
+		return "", false
 	}
 	if len(branches) == 0 {
 		WriteLine(b.outputWriter, "No local branches found.")
-		return
+		return "", false
 	}
-	idx, ok := b.promptSelectIndex("Local branches:", branches, "Enter the number to checkout: ")
+	idx, ok := b.promptSelectIndex("Local branches:", branches, promptText)
 	if !ok {
-		return
-	}
-	branch := branches[idx]
-	if err := b.gitClient.CheckoutBranch(branch); err != nil {
-		WriteError(b.outputWriter, err)
+		return "", false
 	}
+	return branches[idx], true
 }
 
-func (b *Brancher) branchCheckoutRemote() {
+// pickRemoteBranch asks for one of the remote branches and returns it with
+// the local branch name derived from it, such as foo for origin/foo.
+func (b *Brancher) pickRemoteBranch(promptText string) (remoteBranch, localBranch string, ok bool) {
 	branches, err := b.gitClient.ListRemoteBranches()
 	if err != nil {
 		WriteError(b.outputWriter, err)
-		return
+		return "", "", false
 	}
 	if len(branches) == 0 {
 		WriteLine(b.outputWriter, "No remote branches found.")
-		return
+		return "", "", false
 	}
-	idx, ok := b.promptSelectIndex("Remote branches:", branches, "Enter the number to checkout: ")
+	idx, ok := b.promptSelectIndex("Remote branches:", branches, promptText)
 	if !ok {
-		return
+		return "", "", false
 	}
-	remoteBranch := branches[idx]
+	remoteBranch = branches[idx]
 	localBranch, valid := deriveLocalFromRemote(remoteBranch)
 	if !valid || b.gitClient.ValidateBranchName(localBranch) != nil {
 		WriteLine(b.outputWriter, "Invalid remote branch name.")
-		return
-	}
-	if err := b.gitClient.CheckoutNewBranchFromRemote(localBranch, remoteBranch); err != nil {
-		WriteError(b.outputWriter, err)
+		return "", "", false
 	}
+	return remoteBranch, localBranch, true
 }
 
 // promptSelectIndex prints a list with title and asks for selection, returns 0-based index
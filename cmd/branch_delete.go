@@ -4,6 +4,8 @@ import (
 	"fmt"
 	"strconv"
 	"strings"
+
+	"github.com/bmf-san/ggc/v8/internal/ui"
 )
 
 func (b *Brancher) branchDeleteArgs(args []string) {
@@ -87,11 +89,7 @@ func (b *Brancher) runBranchDeleteLoop(branches []string) {
 
 // displayBranchSelection shows the branch selection interface
 func (b *Brancher) displayBranchSelection(branches []string) {
-	WriteLine(b.outputWriter, "\033[1;36mSelect local branches to delete by number (space separated, all: select all, none: deselect all, e.g. 1 3 5):\033[0m")
-	for i, br := range branches {
-		WriteLinef(b.outputWriter, "  [\033[1;33m%d\033[0m] %s", i+1, br)
-	}
-	_, _ = fmt.Fprint(b.outputWriter, "> ")
+	writeNumberedSelection(b.outputWriter, "Select local branches to delete by number (space separated, all: select all, none: deselect all, e.g. 1 3 5):", branches)
 }
 
 // handleBranchSpecialCommands processes "all" and "none" commands for branches
@@ -135,7 +133,8 @@ func (b *Brancher) parseBranchIndices(input string, branches []string) ([]string
 	for _, idx := range indices {
 		n, err := strconv.Atoi(idx)
 		if err != nil || n < 1 || n > len(branches) {
-			WriteLinef(b.outputWriter, "\033[1;31mInvalid number: %s\033[0m", idx)
+			colors := ui.NewANSIColors()
+			WriteLinef(b.outputWriter, "%sInvalid number: %s%s", colors.Bold+colors.Red, idx, colors.Reset)
 			return nil, false
 		}
 		selectedBranches = append(selectedBranches, branches[n-1])
@@ -203,11 +202,7 @@ func (b *Brancher) runMergedBranchDeleteLoop(branches []string) {
 
 // displayMergedBranchSelection shows the merged branch selection interface
 func (b *Brancher) displayMergedBranchSelection(branches []string) {
-	WriteLine(b.outputWriter, "\033[1;36mSelect merged local branches to delete by number (space separated, all: select all, none: deselect all, e.g. 1 3 5):\033[0m")
-	for i, br := range branches {
-		WriteLinef(b.outputWriter, "  [\033[1;33m%d\033[0m] %s", i+1, br)
-	}
-	_, _ = fmt.Fprint(b.outputWriter, "> ")
+	writeNumberedSelection(b.outputWriter, "Select merged local branches to delete by number (space separated, all: select all, none: deselect all, e.g. 1 3 5):", branches)
 }
 
 // handleMergedBranchSpecialCommands processes "all" and "none" commands for merged branches
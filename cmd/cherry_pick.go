@@ -0,0 +1,148 @@
+package cmd
+
+import (
+	"io"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/bmf-san/ggc/v8/internal/git"
+	"github.com/bmf-san/ggc/v8/internal/prompt"
+	"github.com/bmf-san/ggc/v8/internal/ui"
+)
+
+// CherryPicker handles cherry-pick operations.
+type CherryPicker struct {
+	gitClient    git.CherryPickOps
+	outputWriter io.Writer
+	helper       *Helper
+	prompter     prompt.Prompter
+}
+
+// NewCherryPicker creates a new CherryPicker instance.
+func NewCherryPicker(client git.CherryPickOps) *CherryPicker {
+	output := os.Stdout
+	helper := NewHelper()
+	helper.outputWriter = output
+	return &CherryPicker{
+		gitClient:    client,
+		outputWriter: output,
+		helper:       helper,
+		prompter:     prompt.New(os.Stdin, output),
+	}
+}
+
+// CherryPick executes git cherry-pick commands. Without commits it asks for
+// a branch and the commits on it to apply.
+func (p *CherryPicker) CherryPick(args []string) {
+	if len(args) == 0 {
+		p.pickFromBranch()
+		return
+	}
+
+	switch args[0] {
+	case "continue":
+		p.runStep(p.gitClient.CherryPickContinue, "Cherry-pick successful")
+	case "abort":
+		p.runStep(p.gitClient.CherryPickAbort, "Cherry-pick aborted")
+	case "skip":
+		p.runStep(p.gitClient.CherryPickSkip, "Skipped the commit")
+	default:
+		p.pickCommits(args)
+	}
+}
+
+// pickCommits applies commits and ranges given on the command line.
+func (p *CherryPicker) pickCommits(commits []string) {
+	for _, commit := range commits {
+		for _, ref := range strings.Split(commit, "..") {
+			// An empty side of a range means HEAD.
+			if ref != "" && !p.gitClient.RevParseVerify(ref) {
+				WriteErrorf(p.outputWriter, "unknown ref '%s'", ref)
+				return
+			}
+		}
+	}
+	p.apply(commits)
+}
+
+func (p *CherryPicker) apply(commits []string) {
+	if err := p.gitClient.CherryPick(commits); err != nil {
+		WriteError(p.outputWriter, err)
+		// CHERRY_PICK_HEAD exists while a cherry-pick stopped on conflicts.
+		if p.gitClient.RevParseVerify("CHERRY_PICK_HEAD") {
+			WriteLine(p.outputWriter, "Resolve the conflicts with 'ggc conflicts' and run 'ggc cherry-pick continue', or run 'ggc cherry-pick skip' or 'ggc cherry-pick abort'.")
+		}
+		return
+	}
+	WriteLine(p.outputWriter, "Cherry-pick successful")
+}
+
+func (p *CherryPicker) runStep(step func() error, success string) {
+	if err := step(); err != nil {
+		WriteError(p.outputWriter, err)
+		return
+	}
+	WriteLine(p.outputWriter, success)
+}
+
+// pickFromBranch asks for a branch, lists its commits that are not on the
+// current branch yet and applies the selected ones, oldest first.
+func (p *CherryPicker) pickFromBranch() {
+	branch, ok := selectOtherBranch(p.gitClient, p.prompter, p.outputWriter, "cherry-pick from")
+	if !ok {
+		return
+	}
+	output, err := p.gitClient.LogOnelineUnpicked("HEAD", branch)
+	if err != nil {
+		WriteError(p.outputWriter, err)
+		return
+	}
+	commits := strings.Split(strings.TrimSpace(output), "\n")
+	if len(commits) == 1 && commits[0] == "" {
+		WriteLinef(p.outputWriter, "No commits on %s to cherry-pick.", branch)
+		return
+	}
+
+	selected, ok := p.selectCommits(branch, commits)
+	if !ok {
+		return
+	}
+	hashes := make([]string, len(selected))
+	for i, line := range selected {
+		hashes[i] = strings.Fields(line)[0]
+	}
+	p.apply(hashes)
+}
+
+// selectCommits asks which commits to apply and returns them in the order
+// they were listed, whatever order they were entered in.
+func (p *CherryPicker) selectCommits(branch string, commits []string) ([]string, bool) {
+	heading := "Commits on " + branch + " not on the current branch, oldest first. " +
+		"Select commits to apply by number (space separated, all: select all, Enter: cancel):"
+	for {
+		writeNumberedSelection(p.outputWriter, heading, commits)
+		input, ok := ReadLine(p.prompter, p.outputWriter, "")
+		if !ok {
+			return nil, false
+		}
+
+		selection, invalid := ui.ParseSelectionInput(input, len(commits))
+		switch {
+		case invalid != "":
+			WriteLinef(p.outputWriter, "Invalid number: %s", invalid)
+		case selection.Result == ui.SelectionCanceled:
+			WriteLine(p.outputWriter, "Canceled.")
+			return nil, false
+		case selection.Result == ui.SelectionAll:
+			return commits, true
+		case selection.Result == ui.SelectionItems:
+			indices := slices.Compact(slices.Sorted(slices.Values(selection.Indices)))
+			selected := make([]string, len(indices))
+			for i, idx := range indices {
+				selected[i] = commits[idx]
+			}
+			return selected, true
+		}
+	}
+}
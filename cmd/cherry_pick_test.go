@@ -0,0 +1,199 @@
+package cmd
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/bmf-san/ggc/v8/internal/git"
+	"github.com/bmf-san/ggc/v8/internal/prompt"
+)
+
+// mockCherryPickClient implements git.CherryPickOps and records the
+// cherry-picks performed.
+type mockCherryPickClient struct {
+	calls      []string
+	branches   []string
+	current    string
+	unpicked   string
+	logFrom    string
+	logTo      string
+	validRefs  map[string]bool
+	pickErr    error
+	pickHead   bool
+	stepErrors map[string]error
+}
+
+func (m *mockCherryPickClient) CherryPick(commits []string) error {
+	m.calls = append(m.calls, "pick "+strings.Join(commits, " "))
+	return m.pickErr
+}
+func (m *mockCherryPickClient) step(name string) error {
+	m.calls = append(m.calls, name)
+	return m.stepErrors[name]
+}
+func (m *mockCherryPickClient) CherryPickContinue() error            { return m.step("continue") }
+func (m *mockCherryPickClient) CherryPickAbort() error               { return m.step("abort") }
+func (m *mockCherryPickClient) CherryPickSkip() error                { return m.step("skip") }
+func (m *mockCherryPickClient) GetCurrentBranch() (string, error)    { return m.current, nil }
+func (m *mockCherryPickClient) ListLocalBranches() ([]string, error) { return m.branches, nil }
+func (m *mockCherryPickClient) LogOnelineUnpicked(from, to string) (string, error) {
+	m.logFrom, m.logTo = from, to
+	return m.unpicked, nil
+}
+func (m *mockCherryPickClient) RevParseVerify(ref string) bool {
+	if ref == "CHERRY_PICK_HEAD" {
+		return m.pickHead
+	}
+	return m.validRefs[ref]
+}
+
+var _ git.CherryPickOps = (*mockCherryPickClient)(nil)
+
+func newTestCherryPicker(client *mockCherryPickClient, buf *bytes.Buffer, input string) *CherryPicker {
+	helper := NewHelper()
+	helper.outputWriter = buf
+	return &CherryPicker{
+		gitClient:    client,
+		outputWriter: buf,
+		helper:       helper,
+		prompter:     prompt.New(strings.NewReader(input), buf),
+	}
+}
+
+func TestCherryPicker_Commits(t *testing.T) {
+	tests := []struct {
+		args     []string
+		wantCall string
+	}{
+		{[]string{"abc123"}, "pick abc123"},
+		{[]string{"abc123", "def456"}, "pick abc123 def456"},
+		{[]string{"v1.0..fix"}, "pick v1.0..fix"},
+		{[]string{"..fix"}, "pick ..fix"},
+	}
+
+	for _, tt := range tests {
+		t.Run(strings.Join(tt.args, " "), func(t *testing.T) {
+			var buf bytes.Buffer
+			client := &mockCherryPickClient{validRefs: map[string]bool{"abc123": true, "def456": true, "v1.0": true, "fix": true}}
+			newTestCherryPicker(client, &buf, "").CherryPick(tt.args)
+
+			if len(client.calls) != 1 || client.calls[0] != tt.wantCall {
+				t.Fatalf("calls = %v, want [%s]", client.calls, tt.wantCall)
+			}
+			if !strings.Contains(buf.String(), "Cherry-pick successful") {
+				t.Errorf("unexpected output %q", buf.String())
+			}
+		})
+	}
+}
+
+func TestCherryPicker_UnknownRef(t *testing.T) {
+	var buf bytes.Buffer
+	client := &mockCherryPickClient{validRefs: map[string]bool{"abc123": true}}
+	newTestCherryPicker(client, &buf, "").CherryPick([]string{"abc123", "v9..abc123"})
+
+	if len(client.calls) != 0 {
+		t.Fatalf("expected no cherry-pick, got %v", client.calls)
+	}
+	if !strings.Contains(buf.String(), "unknown ref 'v9'") {
+		t.Errorf("unexpected output %q", buf.String())
+	}
+}
+
+func TestCherryPicker_ConflictHint(t *testing.T) {
+	var buf bytes.Buffer
+	client := &mockCherryPickClient{
+		validRefs: map[string]bool{"abc123": true},
+		pickErr:   errors.New("cherry-pick failed"),
+		pickHead:  true,
+	}
+	newTestCherryPicker(client, &buf, "").CherryPick([]string{"abc123"})
+
+	out := buf.String()
+	if !strings.Contains(out, "cherry-pick failed") || !strings.Contains(out, "ggc cherry-pick continue") {
+		t.Errorf("expected error with conflict hint, got %q", out)
+	}
+}
+
+func TestCherryPicker_Steps(t *testing.T) {
+	var buf bytes.Buffer
+	client := &mockCherryPickClient{stepErrors: map[string]error{"skip": errors.New("nothing to skip")}}
+	p := newTestCherryPicker(client, &buf, "")
+
+	p.CherryPick([]string{"continue"})
+	p.CherryPick([]string{"abort"})
+	p.CherryPick([]string{"skip"})
+
+	if strings.Join(client.calls, ",") != "continue,abort,skip" {
+		t.Fatalf("calls = %v", client.calls)
+	}
+	out := buf.String()
+	for _, want := range []string{"Cherry-pick successful", "Cherry-pick aborted", "nothing to skip"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("output %q missing %q", out, want)
+		}
+	}
+	if strings.Contains(out, "Skipped the commit") {
+		t.Errorf("did not expect success after a failed skip, got %q", out)
+	}
+}
+
+func TestCherryPicker_PickFromBranch(t *testing.T) {
+	var buf bytes.Buffer
+	client := &mockCherryPickClient{
+		current:  "release/1.2",
+		branches: []string{"main", "release/1.2"},
+		unpicked: "aaa1111 Fix crash\nbbb2222 Add flag\nccc3333 Update docs\n",
+	}
+	// Choose main, then commits 3 and 1, which are applied oldest first.
+	newTestCherryPicker(client, &buf, "1\n3 1 3\n").CherryPick(nil)
+
+	if client.logFrom != "HEAD" || client.logTo != "main" {
+		t.Errorf("listed commits of %s..%s, want HEAD..main", client.logFrom, client.logTo)
+	}
+	if len(client.calls) != 1 || client.calls[0] != "pick aaa1111 ccc3333" {
+		t.Fatalf("calls = %v, want [pick aaa1111 ccc3333]", client.calls)
+	}
+	if strings.Contains(buf.String(), "] release/1.2") {
+		t.Errorf("current branch should not be offered, got %q", buf.String())
+	}
+}
+
+func TestCherryPicker_PickFromBranch_InvalidThenAll(t *testing.T) {
+	var buf bytes.Buffer
+	client := &mockCherryPickClient{
+		current:  "release/1.2",
+		branches: []string{"main", "release/1.2"},
+		unpicked: "aaa1111 Fix crash\nbbb2222 Add flag\n",
+	}
+	newTestCherryPicker(client, &buf, "1\n7\nall\n").CherryPick(nil)
+
+	if !strings.Contains(buf.String(), "Invalid number: 7") {
+		t.Errorf("unexpected output %q", buf.String())
+	}
+	if len(client.calls) != 1 || client.calls[0] != "pick aaa1111 bbb2222" {
+		t.Fatalf("calls = %v, want [pick aaa1111 bbb2222]", client.calls)
+	}
+}
+
+func TestCherryPicker_PickFromBranch_CancelAndEmpty(t *testing.T) {
+	var buf bytes.Buffer
+	client := &mockCherryPickClient{
+		current:  "release/1.2",
+		branches: []string{"main", "release/1.2"},
+		unpicked: "aaa1111 Fix crash\n",
+	}
+	newTestCherryPicker(client, &buf, "1\n\n").CherryPick(nil)
+	if len(client.calls) != 0 || !strings.Contains(buf.String(), "Canceled.") {
+		t.Errorf("expected cancel, got calls %v and output %q", client.calls, buf.String())
+	}
+
+	buf.Reset()
+	client.unpicked = ""
+	newTestCherryPicker(client, &buf, "1\n").CherryPick(nil)
+	if len(client.calls) != 0 || !strings.Contains(buf.String(), "No commits on main to cherry-pick.") {
+		t.Errorf("expected no commits, got calls %v and output %q", client.calls, buf.String())
+	}
+}
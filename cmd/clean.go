@@ -2,7 +2,6 @@
 package cmd
 
 import (
-	"fmt"
 	"io"
 	"os"
 	"strconv"
@@ -10,6 +9,7 @@ import (
 
 	"github.com/bmf-san/ggc/v8/internal/git"
 	"github.com/bmf-san/ggc/v8/internal/prompt"
+	"github.com/bmf-san/ggc/v8/internal/ui"
 )
 
 // Cleaner provides functionality for the clean command.
@@ -113,11 +113,7 @@ func (c *Cleaner) runInteractiveCleanLoop(files []string) {
 
 // displayFileSelection shows the file selection interface
 func (c *Cleaner) displayFileSelection(files []string) {
-	WriteLine(c.outputWriter, "\033[1;36mSelect files to delete by number (space separated, all: select all, none: deselect all, e.g. 1 3 5):\033[0m")
-	for i, f := range files {
-		WriteLinef(c.outputWriter, "  [\033[1;33m%d\033[0m] %s", i+1, f)
-	}
-	_, _ = fmt.Fprint(c.outputWriter, "> ")
+	writeNumberedSelection(c.outputWriter, "Select files to delete by number (space separated, all: select all, none: deselect all, e.g. 1 3 5):", files)
 }
 
 // handleSpecialCommands processes "all" and "none" commands
@@ -139,7 +135,8 @@ func (c *Cleaner) handleFileSelection(input string, files []string) bool {
 		return false // Continue loop
 	}
 	if len(selectedFiles) == 0 {
-		WriteLine(c.outputWriter, "\033[1;33mNothing selected.\033[0m")
+		colors := ui.NewANSIColors()
+		WriteLinef(c.outputWriter, "%sNothing selected.%s", colors.Bold+colors.Yellow, colors.Reset)
 		return false // Continue loop
 	}
 
@@ -154,7 +151,8 @@ func (c *Cleaner) parseFileIndices(input string, files []string) ([]string, bool
 	for _, idx := range indices {
 		n, err := strconv.Atoi(idx)
 		if err != nil || n < 1 || n > len(files) {
-			WriteLinef(c.outputWriter, "\033[1;31mInvalid number: %s\033[0m", idx)
+			colors := ui.NewANSIColors()
+			WriteLinef(c.outputWriter, "%sInvalid number: %s%s", colors.Bold+colors.Red, idx, colors.Reset)
 			return nil, false
 		}
 		selectedFiles = append(selectedFiles, files[n-1])
@@ -164,14 +162,15 @@ func (c *Cleaner) parseFileIndices(input string, files []string) ([]string, bool
 
 // confirmAndDelete confirms deletion and executes it
 func (c *Cleaner) confirmAndDelete(selectedFiles []string) bool {
-	WriteLinef(c.outputWriter, "\033[1;32mSelected files: %v\033[0m", selectedFiles)
+	colors := ui.NewANSIColors()
+	WriteLinef(c.outputWriter, "%sSelected files: %v%s", colors.Bold+colors.Green, selectedFiles, colors.Reset)
 	for {
 		confirm, canceled, err := c.prompter.Confirm("Delete these files? (y/n): ")
 		if canceled {
 			return true
 		}
 		if err != nil {
-			WriteLine(c.outputWriter, "\033[1;31mInvalid choice.\033[0m")
+			WriteLinef(c.outputWriter, "%sInvalid choice.%s", colors.Bold+colors.Red, colors.Reset)
 			continue
 		}
 		if confirm {
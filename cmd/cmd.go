@@ -14,13 +14,15 @@ import (
 	"github.com/bmf-san/ggc/v8/internal/config"
 	"github.com/bmf-san/ggc/v8/internal/git"
 	"github.com/bmf-san/ggc/v8/internal/interactive"
+	"github.com/bmf-san/ggc/v8/internal/termio"
+	uiutil "github.com/bmf-san/ggc/v8/internal/ui"
 )
 
 // Interactive mode command constants.
 // These are used to signal special states when returning from interactive mode.
 const (
 	interactiveQuitCommand     = "quit"
-	interactiveWorkflowCommand = "workflow-executed"
+	interactiveWorkflowCommand = interactive.WorkflowExecutedCommand
 )
 
 // Cmd represents the command-line interface.
@@ -40,6 +42,15 @@ type Cmd struct {
 	adder         *Adder
 	remoter       *Remoter
 	rebaser       *Rebaser
+	merger        *Merger
+	resolver      *ConflictResolver
+	cherryPicker  *CherryPicker
+	reverter      *Reverter
+	worktrees     *WorktreeManager
+	bisecter      *Bisecter
+	blamer        *Blamer
+	shower        *Shower
+	submodules    *SubmoduleManager
 	stasher       *Stasher
 	configurer    *Configurer
 	hooker        *Hooker
@@ -67,11 +78,23 @@ type GitDeps interface {
 	git.Stager
 	git.RemoteManager
 	git.RebaseOps
+	git.MergeOps
+	git.ConflictOps
+	git.CherryPickOps
+	git.RevertOps
+	git.WorktreeOps
+	git.BisectOps
+	git.BlameOps
+	git.ShowOps
+	git.SubmoduleOps
+	git.GitPathResolver
+	git.RepositoryRootReader
 	git.StashOps
 	git.ConfigOps
 	git.TagOps
 	git.StatusInfoReader
 	git.DiffReader
+	git.DefaultBranchReader
 	git.RestoreOps
 	git.FetchOps
 	git.LocalBranchLister
@@ -100,6 +123,15 @@ func NewCmd(client GitDeps, cm *config.Manager) *Cmd {
 		}
 	}
 
+	// Apply ui.style before any command writes output; an unknown style
+	// falls back to the default.
+	styleSetting := ""
+	if cm != nil {
+		styleSetting = cm.GetConfig().UI.Style
+	}
+	style, _ := uiutil.ParseStyle(styleSetting, os.Getenv("TERM"))
+	uiutil.SetStyle(style)
+
 	cmd := &Cmd{
 		registry:      registry,
 		configManager: cm,
@@ -116,6 +148,15 @@ func NewCmd(client GitDeps, cm *config.Manager) *Cmd {
 		adder:         NewAdder(client),
 		remoter:       NewRemoter(client),
 		rebaser:       NewRebaser(client),
+		merger:        NewMerger(client),
+		resolver:      NewConflictResolver(client),
+		cherryPicker:  NewCherryPicker(client),
+		reverter:      NewReverter(client),
+		worktrees:     NewWorktreeManager(client),
+		bisecter:      NewBisecter(client),
+		blamer:        NewBlamer(client),
+		shower:        NewShower(client),
+		submodules:    NewSubmoduleManager(client),
 		stasher:       NewStasher(client),
 		configurer:    NewConfigurer(client),
 		hooker:        NewHooker(client),
@@ -127,10 +168,51 @@ func NewCmd(client GitDeps, cm *config.Manager) *Cmd {
 		fetcher:       NewFetcher(client),
 		debugger:      NewDebugger(),
 	}
+	if cm != nil {
+		cmd.differ.view, _ = parseDiffView(cm.GetConfig().Diff.View)
+		cmd.differ.collapse = cm.GetConfig().Diff.Collapse
+		cmd.blamer.view = cmd.differ.view
+		cmd.blamer.collapse = cmd.differ.collapse
+		cmd.shower.view = cmd.differ.view
+		cmd.shower.collapse = cmd.differ.collapse
+		cmd.differ.defaultRemote = tagger.defaultRemote
+		cmd.resolver.mergeTool = strings.TrimSpace(cm.GetConfig().Default.MergeTool)
+		cmd.worktrees.root = strings.TrimSpace(cm.GetConfig().Worktree.Root)
+	}
+	pg := newPager(cm, client)
+	cmd.differ.pager = pg
+	cmd.blamer.pager = pg
+	cmd.shower.pager = pg
+	cmd.tagger.pager = pg
+	cmd.stasher.pager = pg
 	cmd.cmdRouter = mustNewCommandRouter(cmd)
+	cmd.applyColor(uiutil.ColorEnabled(resolveColorMode(cm, os.Getenv), os.Stdout))
 	return cmd
 }
 
+// resolveColorMode picks the color policy: GGC_COLOR and NO_COLOR take
+// precedence over ui.color.
+func resolveColorMode(cm *config.Manager, getenv func(string) string) uiutil.ColorMode {
+	if mode, ok := uiutil.ColorModeFromEnv(getenv); ok {
+		return mode
+	}
+	if cm != nil {
+		if mode, err := cm.GetConfig().ColorMode(); err == nil {
+			return mode
+		}
+	}
+	return uiutil.ColorAuto
+}
+
+// applyColor enables or disables color for rendered output and for git
+// invocations that would otherwise force it.
+func (c *Cmd) applyColor(enabled bool) {
+	uiutil.SetColor(enabled)
+	if setter, ok := c.gitClient.(git.ColorSetter); ok {
+		setter.SetColor(enabled)
+	}
+}
+
 // Help displays help information.
 func (c *Cmd) Help(args []string) {
 	var name string
@@ -159,6 +241,51 @@ func (c *Cmd) Rebase(args []string) {
 	c.rebaser.Rebase(args)
 }
 
+// Merge executes the merge command with the given arguments.
+func (c *Cmd) Merge(args []string) {
+	c.merger.Merge(args)
+}
+
+// Conflicts executes the conflicts command with the given arguments.
+func (c *Cmd) Conflicts(args []string) {
+	c.resolver.Conflicts(args)
+}
+
+// CherryPick executes the cherry-pick command with the given arguments.
+func (c *Cmd) CherryPick(args []string) {
+	c.cherryPicker.CherryPick(args)
+}
+
+// Revert executes the revert command with the given arguments.
+func (c *Cmd) Revert(args []string) {
+	c.reverter.Revert(args)
+}
+
+// Worktree executes the worktree command with the given arguments.
+func (c *Cmd) Worktree(args []string) {
+	c.worktrees.Worktree(args)
+}
+
+// Bisect executes the bisect command with the given arguments.
+func (c *Cmd) Bisect(args []string) {
+	c.bisecter.Bisect(args)
+}
+
+// Blame executes the blame command with the given arguments.
+func (c *Cmd) Blame(args []string) {
+	c.blamer.Blame(args)
+}
+
+// Show executes the show command with the given arguments.
+func (c *Cmd) Show(args []string) {
+	c.shower.Show(args)
+}
+
+// Submodule executes the submodule command with the given arguments.
+func (c *Cmd) Submodule(args []string) {
+	c.submodules.Submodule(args)
+}
+
 // Stash executes the stash command with the given arguments.
 func (c *Cmd) Stash(args []string) {
 	c.stasher.Stash(args)
@@ -244,28 +371,85 @@ func (c *Cmd) DebugKeys(args []string) {
 	c.debugger.DebugKeys(args)
 }
 
-// buildInteractiveCommands converts the command registry into the flat list of
-// CommandInfo entries consumed by the interactive UI. This keeps the cmd layer
-// as the sole owner of registry knowledge so that internal/interactive has no
-// dependency on cmd/command.
-func buildInteractiveCommands(registry *commandregistry.Registry) []interactive.CommandInfo {
+// buildInteractiveCommands converts the command registry, plus any aliases and
+// named workflows from cfg, into the flat list of CommandInfo entries consumed
+// by the interactive UI. This keeps the cmd layer as the sole owner of registry
+// knowledge so that internal/interactive has no dependency on cmd/command.
+func buildInteractiveCommands(registry *commandregistry.Registry, cfg *config.Config) []interactive.CommandInfo {
 	var list []interactive.CommandInfo
 	allCmds := registry.All()
+	// Group built-in commands by category so the category browser shows
+	// them in the same order as the help output.
+	sort.SliceStable(allCmds, func(i, j int) bool {
+		return commandregistry.CategoryOrder(allCmds[i].Category) < commandregistry.CategoryOrder(allCmds[j].Category)
+	})
 	for i := range allCmds {
 		if allCmds[i].Hidden {
 			continue
 		}
+		category := string(allCmds[i].Category)
 		if len(allCmds[i].Subcommands) == 0 {
-			list = append(list, interactive.CommandInfo{Command: allCmds[i].Name, Description: allCmds[i].Summary})
+			list = append(list, interactive.CommandInfo{Command: allCmds[i].Name, Description: allCmds[i].Summary, Category: category})
 			continue
 		}
 		for j := range allCmds[i].Subcommands {
 			if allCmds[i].Subcommands[j].Hidden {
 				continue
 			}
-			list = append(list, interactive.CommandInfo{Command: allCmds[i].Subcommands[j].Name, Description: allCmds[i].Subcommands[j].Summary})
+			list = append(list, interactive.CommandInfo{Command: allCmds[i].Subcommands[j].Name, Description: allCmds[i].Subcommands[j].Summary, Category: category})
 		}
 	}
+	if cfg == nil {
+		return list
+	}
+	list = append(list, interactiveAliasEntries(cfg)...)
+	return append(list, interactiveWorkflowEntries(cfg)...)
+}
+
+// interactiveAliasEntries lists configured aliases, sorted by name. Positional
+// placeholders become <argN> so the interactive UI prompts for them inline.
+func interactiveAliasEntries(cfg *config.Config) []interactive.CommandInfo {
+	aliases := cfg.GetAllAliases()
+	names := make([]string, 0, len(aliases))
+	for name := range aliases {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	arrow := " " + uiutil.NewSymbols().Arrow + " "
+	list := make([]interactive.CommandInfo, 0, len(names))
+	for _, name := range names {
+		alias := aliases[name]
+		command := name
+		for i := 0; i <= alias.MaxPositionalArg; i++ {
+			command += fmt.Sprintf(" <arg%d>", i+1)
+		}
+		list = append(list, interactive.CommandInfo{
+			Command:     command,
+			Description: strings.Join(alias.Commands, arrow),
+			Kind:        interactive.KindAlias,
+		})
+	}
+	return list
+}
+
+// interactiveWorkflowEntries lists configured named workflows, sorted by name.
+func interactiveWorkflowEntries(cfg *config.Config) []interactive.CommandInfo {
+	names := make([]string, 0, len(cfg.Workflows))
+	for name := range cfg.Workflows {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	arrow := " " + uiutil.NewSymbols().Arrow + " "
+	list := make([]interactive.CommandInfo, 0, len(names))
+	for _, name := range names {
+		list = append(list, interactive.CommandInfo{
+			Command:     name,
+			Description: strings.Join(cfg.Workflows[name], arrow),
+			Kind:        interactive.KindWorkflow,
+		})
+	}
 	return list
 }
 
@@ -285,7 +469,8 @@ func (c *Cmd) Interactive() {
 
 	// Create persistent UI instance to preserve state; pass already-loaded
 	// config so NewUI does not perform a second config load (Problem H fix).
-	ui := interactive.NewUI(c.gitClient, buildInteractiveCommands(c.registry), c.configManager.GetConfig(), c)
+	cfg := c.configManager.GetConfig()
+	ui := interactive.NewUI(c.gitClient, buildInteractiveCommands(c.registry, cfg), cfg, c)
 
 	for {
 		args := ui.Run()
@@ -306,14 +491,45 @@ func (c *Cmd) Interactive() {
 			continue
 		}
 
-		if err := c.Route(args[1:]); err != nil {
-			_, _ = fmt.Fprintln(c.outputWriter, "Error:", err)
+		c.runInteractiveCommand(ui, args[1:])
+	}
+}
+
+// runInteractiveCommand executes a command selected in interactive mode.
+// Its output is captured into the UI's output pane unless the command
+// prompts for input or opens an editor, in which case it gets the terminal.
+func (c *Cmd) runInteractiveCommand(ui *interactive.UI, args []string) {
+	if !c.needsTerminal(args) {
+		var execErr error
+		// Execute resolves aliases selected in the UI the same way as on the command line.
+		output, err := termio.CaptureOutput(func() { execErr = c.Execute(args) })
+		if err == nil {
+			ui.ShowOutput(interactive.CommandOutput{
+				Command: strings.Join(args, " "),
+				Output:  output,
+				Err:     execErr,
+			})
+			return
 		}
+	}
+
+	if err := c.Execute(args); err != nil {
+		_, _ = fmt.Fprintln(c.outputWriter, "Error:", err)
+	}
+
+	// Wait for user to continue
+	c.waitForContinue()
+	ui.ResetToSearchMode()
+}
 
-		// Wait for user to continue
-		c.waitForContinue()
-		ui.ResetToSearchMode()
+// SavePinnedCommands stores the commands pinned in the interactive category
+// browser in the user config, satisfying interactive.PinStore.
+func (c *Cmd) SavePinnedCommands(commands []string) error {
+	if c.configManager == nil {
+		return fmt.Errorf("no config loaded")
 	}
+	c.configManager.GetConfig().Interactive.Pinned = commands
+	return c.configManager.Save()
 }
 
 // Route routes the command to the appropriate handler based on args.
@@ -359,27 +575,36 @@ func newCommandRouter(cmd *Cmd) (*commandRouter, error) {
 	}
 
 	handlers := map[string]func([]string){
-		"help":       func(args []string) { cmd.Help(args) },
-		"add":        func(args []string) { cmd.Add(args) },
-		"branch":     func(args []string) { cmd.Branch(args) },
-		"commit":     func(args []string) { cmd.Commit(args) },
-		"log":        func(args []string) { cmd.Log(args) },
-		"pull":       func(args []string) { cmd.Pull(args) },
-		"push":       func(args []string) { cmd.Push(args) },
-		"reset":      func(args []string) { cmd.Reset(args) },
-		"clean":      func(args []string) { cmd.Clean(args) },
-		"version":    func(args []string) { cmd.Version(args) },
-		"remote":     func(args []string) { cmd.Remote(args) },
-		"rebase":     func(args []string) { cmd.Rebase(args) },
-		"stash":      func(args []string) { cmd.Stash(args) },
-		"config":     func(args []string) { cmd.Config(args) },
-		"hook":       func(args []string) { cmd.Hook(args) },
-		"tag":        func(args []string) { cmd.Tag(args) },
-		"status":     func(args []string) { cmd.Status(args) },
-		"fetch":      func(args []string) { cmd.Fetch(args) },
-		"diff":       func(args []string) { cmd.Diff(args) },
-		"restore":    func(args []string) { cmd.Restore(args) },
-		"debug-keys": func(args []string) { cmd.DebugKeys(args) },
+		"help":        func(args []string) { cmd.Help(args) },
+		"add":         func(args []string) { cmd.Add(args) },
+		"branch":      func(args []string) { cmd.Branch(args) },
+		"commit":      func(args []string) { cmd.Commit(args) },
+		"log":         func(args []string) { cmd.Log(args) },
+		"pull":        func(args []string) { cmd.Pull(args) },
+		"push":        func(args []string) { cmd.Push(args) },
+		"reset":       func(args []string) { cmd.Reset(args) },
+		"clean":       func(args []string) { cmd.Clean(args) },
+		"version":     func(args []string) { cmd.Version(args) },
+		"remote":      func(args []string) { cmd.Remote(args) },
+		"rebase":      func(args []string) { cmd.Rebase(args) },
+		"merge":       func(args []string) { cmd.Merge(args) },
+		"conflicts":   func(args []string) { cmd.Conflicts(args) },
+		"cherry-pick": func(args []string) { cmd.CherryPick(args) },
+		"revert":      func(args []string) { cmd.Revert(args) },
+		"worktree":    func(args []string) { cmd.Worktree(args) },
+		"bisect":      func(args []string) { cmd.Bisect(args) },
+		"blame":       func(args []string) { cmd.Blame(args) },
+		"show":        func(args []string) { cmd.Show(args) },
+		"submodule":   func(args []string) { cmd.Submodule(args) },
+		"stash":       func(args []string) { cmd.Stash(args) },
+		"config":      func(args []string) { cmd.Config(args) },
+		"hook":        func(args []string) { cmd.Hook(args) },
+		"tag":         func(args []string) { cmd.Tag(args) },
+		"status":      func(args []string) { cmd.Status(args) },
+		"fetch":       func(args []string) { cmd.Fetch(args) },
+		"diff":        func(args []string) { cmd.Diff(args) },
+		"restore":     func(args []string) { cmd.Restore(args) },
+		"debug-keys":  func(args []string) { cmd.DebugKeys(args) },
 		interactiveQuitCommand: func([]string) {
 			_, _ = fmt.Fprintln(cmd.outputWriter, "The 'quit' command is only available in interactive mode.")
 		},
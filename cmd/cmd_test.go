@@ -3,6 +3,8 @@ package cmd
 import (
 	"bytes"
 	"io"
+	"os"
+	"reflect"
 	"strings"
 	"testing"
 
@@ -11,6 +13,7 @@ import (
 	"github.com/bmf-san/ggc/v8/internal/git"
 	"github.com/bmf-san/ggc/v8/internal/interactive"
 	"github.com/bmf-san/ggc/v8/internal/prompt"
+	uiutil "github.com/bmf-san/ggc/v8/internal/ui"
 )
 
 // mockGitClient is a mock of git.Client.
@@ -129,6 +132,7 @@ func (m *mockGitClient) DiffHead() (string, error)   { return "", nil }
 func (m *mockGitClient) DiffWith(_ []string) (string, error) {
 	return "", nil
 }
+func (m *mockGitClient) DefaultBranch(_ string) (string, error) { return "main", nil }
 
 // Branch Operations methods
 func (m *mockGitClient) CheckoutNewBranch(_ string) error { return nil }
@@ -160,7 +164,7 @@ func (m *mockGitClient) RemoteRemove(_ string) error    { return nil }
 func (m *mockGitClient) RemoteSetURL(_, _ string) error { return nil }
 
 // Tag Operations methods
-func (m *mockGitClient) TagList(_ []string) error              { return nil }
+func (m *mockGitClient) TagList(_ []string) (string, error)    { return "", nil }
 func (m *mockGitClient) TagCreate(_, _ string) error           { return nil }
 func (m *mockGitClient) TagCreateAnnotated(_, _ string) error  { return nil }
 func (m *mockGitClient) TagDelete(_ []string) error            { return nil }
@@ -174,6 +178,75 @@ func (m *mockGitClient) GetTagCommit(_ string) (string, error) { return "abc123"
 // Log Operations methods
 func (m *mockGitClient) LogOneline(_, _ string) (string, error) { return "", nil }
 
+// Merge Operations
+func (m *mockGitClient) Merge(_ string) error       { return nil }
+func (m *mockGitClient) MergeNoFF(_ string) error   { return nil }
+func (m *mockGitClient) MergeSquash(_ string) error { return nil }
+func (m *mockGitClient) MergeFFOnly(_ string) error { return nil }
+func (m *mockGitClient) MergeAbort() error          { return nil }
+func (m *mockGitClient) MergeContinue() error       { return nil }
+
+// Cherry-pick Operations
+func (m *mockGitClient) CherryPick(_ []string) error                    { return nil }
+func (m *mockGitClient) CherryPickContinue() error                      { return nil }
+func (m *mockGitClient) CherryPickAbort() error                         { return nil }
+func (m *mockGitClient) CherryPickSkip() error                          { return nil }
+func (m *mockGitClient) LogOnelineUnpicked(_, _ string) (string, error) { return "", nil }
+
+// Revert Operations
+func (m *mockGitClient) Revert(_ []string, _ *git.RevertOptions) error { return nil }
+func (m *mockGitClient) RevertContinue() error                         { return nil }
+func (m *mockGitClient) RevertAbort() error                            { return nil }
+func (m *mockGitClient) LogRecent(_ int) (string, error)               { return "", nil }
+func (m *mockGitClient) CommitParents(_ string) ([]string, error)      { return nil, nil }
+func (m *mockGitClient) CommitFiles(_ string, _ int) (string, error)   { return "", nil }
+
+// Worktree Operations
+func (m *mockGitClient) ListWorktrees() ([]git.Worktree, error)    { return nil, nil }
+func (m *mockGitClient) AddWorktree(_, _ string) error             { return nil }
+func (m *mockGitClient) AddWorktreeNewBranch(_, _, _ string) error { return nil }
+func (m *mockGitClient) RemoveWorktree(_ string, _ bool) error     { return nil }
+func (m *mockGitClient) PruneWorktrees() error                     { return nil }
+func (m *mockGitClient) LockWorktree(_, _ string) error            { return nil }
+func (m *mockGitClient) UnlockWorktree(_ string) error             { return nil }
+func (m *mockGitClient) GitPath(name string) (string, error)       { return ".git/" + name, nil }
+func (m *mockGitClient) GetRepositoryRoot() (string, error)        { return "/repo", nil }
+
+// Bisect Operations
+func (m *mockGitClient) BisectStart(_, _ string) error            { return nil }
+func (m *mockGitClient) BisectMark(_ string) error                { return nil }
+func (m *mockGitClient) BisectRun(_ []string) error               { return nil }
+func (m *mockGitClient) BisectReset() error                       { return nil }
+func (m *mockGitClient) BisectStatus() (*git.BisectStatus, error) { return &git.BisectStatus{}, nil }
+func (m *mockGitClient) CommitDetails(_ string) (*git.CommitDetails, error) {
+	return &git.CommitDetails{}, nil
+}
+
+// Blame Operations
+func (m *mockGitClient) Blame(_, _ string) ([]git.BlameLine, error) { return nil, nil }
+func (m *mockGitClient) CommitDiff(_ string) (string, error)        { return "", nil }
+
+// Show Operations
+func (m *mockGitClient) ShowCommit(_ string) (*git.CommitInfo, error) { return &git.CommitInfo{}, nil }
+func (m *mockGitClient) CommitChildren(_ string) ([]string, error)    { return nil, nil }
+
+// Submodule Operations
+func (m *mockGitClient) ListSubmodules() ([]git.Submodule, error) { return nil, nil }
+func (m *mockGitClient) SubmoduleInit(_ []string) error           { return nil }
+func (m *mockGitClient) SubmoduleUpdate(_ []string) error         { return nil }
+func (m *mockGitClient) SubmoduleSync(_ []string) error           { return nil }
+func (m *mockGitClient) SubmoduleForeach(_ []string) error        { return nil }
+func (m *mockGitClient) SubmoduleAdd(_, _ string) error           { return nil }
+
+// Conflict Operations
+func (m *mockGitClient) ListConflicts() ([]git.Conflict, error)       { return nil, nil }
+func (m *mockGitClient) GetRepositoryPrefix() (string, error)         { return "", nil }
+func (m *mockGitClient) OperationInProgress() (git.Operation, error)  { return git.OperationNone, nil }
+func (m *mockGitClient) ContinueOperation(_ git.Operation) error      { return nil }
+func (m *mockGitClient) ResolveConflict(_ git.Conflict, _ bool) error { return nil }
+func (m *mockGitClient) MarkResolved(_ []string) error                { return nil }
+func (m *mockGitClient) RunMergeTool(_ string, _ []string) error      { return nil }
+
 // Rebase Operations methods
 func (m *mockGitClient) RebaseInteractive(_ int) error           { return nil }
 func (m *mockGitClient) RebaseInteractiveAutosquash(_ int) error { return nil }
@@ -862,3 +935,96 @@ func TestCmd_InteractiveWorkflowIntegration(t *testing.T) {
 		t.Errorf("AddToWorkflow after ClearWorkflow ID = %d, want 1", got)
 	}
 }
+
+func TestBuildInteractiveCommands_GroupsByCategory(t *testing.T) {
+	registry := commandregistry.NewRegistryWith([]commandregistry.Info{
+		{Name: "push", Summary: "Push", Category: commandregistry.CategoryRemote},
+		{Name: "add", Summary: "Add", Category: commandregistry.CategoryBasics},
+		{Name: "branch", Category: commandregistry.CategoryBranch, Subcommands: []commandregistry.SubcommandInfo{
+			{Name: "branch current", Summary: "Show current branch"},
+		}},
+	})
+
+	list := buildInteractiveCommands(registry, nil)
+
+	want := []interactive.CommandInfo{
+		{Command: "add", Description: "Add", Category: "Basics"},
+		{Command: "branch current", Description: "Show current branch", Category: "Branch"},
+		{Command: "push", Description: "Push", Category: "Remote"},
+	}
+	if !reflect.DeepEqual(list, want) {
+		t.Errorf("buildInteractiveCommands() = %+v, want %+v", list, want)
+	}
+}
+
+func TestBuildInteractiveCommands_IncludesAliasesAndWorkflows(t *testing.T) {
+	cfg := &config.Config{
+		Aliases: map[string]interface{}{
+			"st":     "status short",
+			"ship":   []interface{}{"add .", "commit {0}", "push {1}"},
+			"broken": 42,
+		},
+		Workflows: map[string][]string{
+			"sync": {"fetch", "pull"},
+		},
+	}
+	registry := commandregistry.NewRegistryWith([]commandregistry.Info{{Name: "status", Summary: "Show status"}})
+	defer uiutil.SetStyle(uiutil.CurrentStyle())
+	uiutil.SetStyle(uiutil.StyleRich)
+
+	list := buildInteractiveCommands(registry, cfg)
+
+	want := []interactive.CommandInfo{
+		{Command: "status", Description: "Show status", Kind: interactive.KindCommand},
+		{Command: "ship <arg1> <arg2>", Description: "add . → commit {0} → push {1}", Kind: interactive.KindAlias},
+		{Command: "st", Description: "status short", Kind: interactive.KindAlias},
+		{Command: "sync", Description: "fetch → pull", Kind: interactive.KindWorkflow},
+	}
+	if !reflect.DeepEqual(list, want) {
+		t.Errorf("buildInteractiveCommands() = %+v, want %+v", list, want)
+	}
+
+	uiutil.SetStyle(uiutil.StylePlain)
+	list = buildInteractiveCommands(registry, cfg)
+	if list[1].Description != "add . -> commit {0} -> push {1}" || list[3].Description != "fetch -> pull" {
+		t.Errorf("plain style descriptions = %q, %q; want ASCII arrows", list[1].Description, list[3].Description)
+	}
+}
+
+// TestRunInteractiveCommand_BareBranchFormsPrompt checks that branch
+// subcommands which prompt when their arguments are missing run on the
+// terminal instead of having their prompts captured.
+func TestRunInteractiveCommand_BareBranchFormsPrompt(t *testing.T) {
+	devNull, err := os.Open(os.DevNull)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() { _ = devNull.Close() }()
+	oldStdin := os.Stdin
+	os.Stdin = devNull
+	defer func() { os.Stdin = oldStdin }()
+
+	for _, args := range [][]string{
+		{"branch", "rename"},
+		{"branch", "move"},
+		{"branch", "set", "upstream"},
+		{"branch", "sort"},
+		{"branch", "contains"},
+	} {
+		t.Run(strings.Join(args, " "), func(t *testing.T) {
+			mockClient := &mockGitClient{}
+			cmd := NewCmd(mockClient, config.NewConfigManager(mockClient))
+			var buf bytes.Buffer
+			cmd.outputWriter = &buf
+			cmd.brancher.outputWriter = &buf
+			cmd.brancher.prompter = prompt.New(strings.NewReader(""), &buf)
+			ui := interactive.NewUI(mockClient, nil, nil, cmd)
+
+			cmd.runInteractiveCommand(ui, args)
+
+			if !strings.Contains(buf.String(), "Press Enter to continue") {
+				t.Errorf("expected %v to run on the terminal, got %q", args, buf.String())
+			}
+		})
+	}
+}
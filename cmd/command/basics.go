@@ -45,14 +45,16 @@ func basics() []Info {
 					Usage:   []string{"ggc add ."},
 				},
 				{
-					Name:    "add interactive",
-					Summary: "Add changes interactively",
-					Usage:   []string{"ggc add interactive"},
+					Name:          "add interactive",
+					Summary:       "Add changes interactively",
+					Usage:         []string{"ggc add interactive"},
+					NeedsTerminal: true,
 				},
 				{
-					Name:    "add patch",
-					Summary: "Add changes interactively (patch mode)",
-					Usage:   []string{"ggc add patch"},
+					Name:          "add patch",
+					Summary:       "Add changes interactively (patch mode)",
+					Usage:         []string{"ggc add patch"},
+					NeedsTerminal: true,
 				},
 			},
 		},
@@ -25,24 +25,109 @@ func branch() []Info {
 			},
 			Subcommands: []SubcommandInfo{
 				{Name: "branch current", Summary: "Show current branch name", Usage: []string{"ggc branch current"}},
-				{Name: "branch checkout", Summary: "Switch to an existing branch", Usage: []string{"ggc branch checkout"}},
-				{Name: "branch checkout remote", Summary: "Create and checkout a local branch from the remote", Usage: []string{"ggc branch checkout remote"}},
-				{Name: "branch create", Summary: "Create and checkout a new branch", Usage: []string{"ggc branch create feature/login"}},
+				{Name: "branch checkout", Summary: "Switch to an existing branch", Usage: []string{"ggc branch checkout"}, NeedsTerminal: true},
+				{Name: "branch checkout remote", Summary: "Create and checkout a local branch from the remote", Usage: []string{"ggc branch checkout remote"}, NeedsTerminal: true},
+				{Name: "branch create", Summary: "Create and checkout a new branch", Usage: []string{"ggc branch create feature/login"}, NeedsTerminal: true},
 				{Name: "branch delete", Summary: "Delete local branch", Usage: []string{"ggc branch delete feature/login"}, Examples: []string{
 					"ggc branch delete feature/123          # Delete a branch",
 					"ggc branch delete feature/123 --force  # Force delete a branch",
-				}},
-				{Name: "branch delete merged", Summary: "Delete local merged branch", Usage: []string{"ggc branch delete merged"}},
+				}, NeedsTerminal: true},
+				{Name: "branch delete merged", Summary: "Delete local merged branch", Usage: []string{"ggc branch delete merged"}, NeedsTerminal: true},
+				{Name: "branch rename", Summary: "Choose a branch and rename it", Usage: []string{"ggc branch rename"}, NeedsTerminal: true},
 				{Name: "branch rename <old> <new>", Summary: "Rename a branch", Usage: []string{"ggc branch rename old new"}},
+				{Name: "branch move", Summary: "Choose a branch and move it to a commit", Usage: []string{"ggc branch move"}, NeedsTerminal: true},
 				{Name: "branch move <branch> <commit>", Summary: "Move branch to specified commit", Usage: []string{"ggc branch move feature abc123"}},
+				{Name: "branch set upstream", Summary: "Choose a branch and its upstream", Usage: []string{"ggc branch set upstream"}, NeedsTerminal: true},
 				{Name: "branch set upstream <branch> <upstream>", Summary: "Set upstream for a branch", Usage: []string{"ggc branch set upstream feature origin/feature"}},
 				{Name: "branch info <branch>", Summary: "Show detailed branch information", Usage: []string{"ggc branch info feature"}},
 				{Name: "branch list verbose", Summary: "Show detailed branch listing", Usage: []string{"ggc branch list verbose"}},
 				{Name: "branch list local", Summary: "List local branches", Usage: []string{"ggc branch list local"}},
 				{Name: "branch list remote", Summary: "List remote branches", Usage: []string{"ggc branch list remote"}},
+				{Name: "branch sort", Summary: "Choose how to sort the branch list", Usage: []string{"ggc branch sort"}, NeedsTerminal: true},
 				{Name: "branch sort [date|name]", Summary: "List branches sorted by date or name", Usage: []string{"ggc branch sort date"}},
+				{Name: "branch contains", Summary: "Enter a commit and show the branches containing it", Usage: []string{"ggc branch contains"}, NeedsTerminal: true},
 				{Name: "branch contains <commit>", Summary: "Show branches containing a commit", Usage: []string{"ggc branch contains abc123"}},
 			},
 		},
+		{
+			Name:     "merge",
+			Category: CategoryBranch,
+			Summary:  "Join the history of another branch into the current branch",
+			Usage:    []string{"ggc merge [no-ff|squash|ff-only] [<branch>]", "ggc merge abort|continue"},
+			Examples: []string{
+				"ggc merge                 # Choose a local branch to merge",
+				"ggc merge feature/login   # Merge a branch, fast-forwarding when possible",
+				"ggc merge no-ff feature   # Always create a merge commit",
+				"ggc merge squash feature  # Stage the branch's changes as one change",
+				"ggc merge ff-only main    # Merge only if it is a fast-forward",
+				"ggc merge continue        # Finish a merge after resolving conflicts",
+				"ggc merge abort           # Abort an in-progress merge",
+			},
+			Subcommands: []SubcommandInfo{
+				{Name: "merge", Summary: "Choose a local branch and merge it", Usage: []string{"ggc merge"}, NeedsTerminal: true},
+				{Name: "merge <branch>", Summary: "Merge a branch into the current branch", Usage: []string{"ggc merge feature/login"}, NeedsTerminal: true},
+				{Name: "merge no-ff [<branch>]", Summary: "Merge and always create a merge commit", Usage: []string{"ggc merge no-ff feature/login"}, NeedsTerminal: true},
+				{Name: "merge squash [<branch>]", Summary: "Stage a branch's changes as one uncommitted change", Usage: []string{"ggc merge squash feature/login"}, NeedsTerminal: true},
+				{Name: "merge ff-only [<branch>]", Summary: "Merge only when the current branch can be fast-forwarded", Usage: []string{"ggc merge ff-only main"}, NeedsTerminal: true},
+				{Name: "merge continue", Summary: "Conclude a merge after resolving conflicts", Usage: []string{"ggc merge continue"}, NeedsTerminal: true},
+				{Name: "merge abort", Summary: "Abort an in-progress merge", Usage: []string{"ggc merge abort"}},
+			},
+		},
+		{
+			Name:     "conflicts",
+			Category: CategoryBranch,
+			Summary:  "Resolve conflicts of a stopped merge, rebase, cherry-pick or revert",
+			Usage:    []string{"ggc conflicts [list | tool [<path>...] | ours <path>... | theirs <path>... | resolved <path>... | continue]"},
+			Examples: []string{
+				"ggc conflicts                  # Pick conflicted files and resolve them step by step",
+				"ggc conflicts list             # List conflicted files with the kind of conflict",
+				"ggc conflicts tool             # Open all conflicted files in the merge tool",
+				"ggc conflicts theirs go.sum    # Resolve a file by taking their version",
+				"ggc conflicts resolved a.go    # Mark a hand-edited file as resolved",
+				"ggc conflicts continue         # Continue the merge, rebase, cherry-pick or revert",
+			},
+			Subcommands: []SubcommandInfo{
+				{Name: "conflicts", Summary: "Resolve conflicted files interactively", Usage: []string{"ggc conflicts"}, NeedsTerminal: true},
+				{Name: "conflicts list", Summary: "List conflicted files and conflict types", Usage: []string{"ggc conflicts list"}},
+				{Name: "conflicts tool [<path>]", Summary: "Open conflicted files in the configured merge tool", Usage: []string{"ggc conflicts tool", "ggc conflicts tool cmd/diff.go"}, NeedsTerminal: true},
+				{Name: "conflicts ours <path>", Summary: "Resolve files by keeping our version", Usage: []string{"ggc conflicts ours cmd/diff.go"}},
+				{Name: "conflicts theirs <path>", Summary: "Resolve files by taking their version", Usage: []string{"ggc conflicts theirs cmd/diff.go"}},
+				{Name: "conflicts resolved <path>", Summary: "Mark files as resolved", Usage: []string{"ggc conflicts resolved cmd/diff.go"}},
+				{Name: "conflicts continue", Summary: "Continue the stopped operation once no conflicts remain", Usage: []string{"ggc conflicts continue"}, NeedsTerminal: true},
+			},
+		},
+		{
+			Name:     "worktree",
+			Category: CategoryBranch,
+			Summary:  "Check out branches in additional working trees",
+			Usage: []string{
+				"ggc worktree [list]",
+				"ggc worktree add [new] [<branch> [<path>]]",
+				"ggc worktree remove [force] [<path>]",
+				"ggc worktree lock [<path> [<reason>]] | unlock [<path>]",
+				"ggc worktree prune",
+			},
+			Examples: []string{
+				"ggc worktree                        # List worktrees",
+				"ggc worktree add                    # Choose a new, local or remote branch to check out",
+				"ggc worktree add feature/login      # Check out a local branch in a new worktree",
+				"ggc worktree add origin/fix         # Create a local branch tracking origin/fix in a new worktree",
+				"ggc worktree add new spike ../spike # Create a branch and a worktree at a given path",
+				"ggc worktree remove                 # Choose a worktree to remove",
+				"ggc worktree lock ../spike usb      # Keep a worktree from being pruned",
+				"ggc worktree prune                  # Forget worktrees whose directory was deleted",
+			},
+			Subcommands: []SubcommandInfo{
+				{Name: "worktree list", Summary: "List worktrees and mark the current one", Usage: []string{"ggc worktree list"}},
+				{Name: "worktree add", Summary: "Choose a branch and create a worktree for it", Usage: []string{"ggc worktree add"}, NeedsTerminal: true},
+				{Name: "worktree add <branch> [<path>]", Summary: "Create a worktree for a local or remote branch", Usage: []string{"ggc worktree add feature/login", "ggc worktree add origin/fix ../fix"}},
+				{Name: "worktree add new <branch> [<path>]", Summary: "Create a worktree on a new branch from HEAD", Usage: []string{"ggc worktree add new spike"}},
+				{Name: "worktree remove [<path>]", Summary: "Remove a worktree", Usage: []string{"ggc worktree remove", "ggc worktree remove ../spike"}, NeedsTerminal: true},
+				{Name: "worktree remove force <path>", Summary: "Remove a worktree with local changes", Usage: []string{"ggc worktree remove force ../spike"}},
+				{Name: "worktree lock [<path> [<reason>]]", Summary: "Keep a worktree from being pruned", Usage: []string{"ggc worktree lock", "ggc worktree lock ../spike on usb drive"}, NeedsTerminal: true},
+				{Name: "worktree unlock [<path>]", Summary: "Unlock a worktree", Usage: []string{"ggc worktree unlock"}, NeedsTerminal: true},
+				{Name: "worktree prune", Summary: "Remove information about deleted worktrees", Usage: []string{"ggc worktree prune"}},
+			},
+		},
 	}
 }
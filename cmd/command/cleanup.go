@@ -16,7 +16,7 @@ func cleanup() []Info {
 			Subcommands: []SubcommandInfo{
 				{Name: "clean files", Summary: "Clean untracked files", Usage: []string{"ggc clean files"}},
 				{Name: "clean dirs", Summary: "Clean untracked directories", Usage: []string{"ggc clean dirs"}},
-				{Name: "clean interactive", Summary: "Clean files interactively", Usage: []string{"ggc clean interactive"}},
+				{Name: "clean interactive", Summary: "Clean files interactively", Usage: []string{"ggc clean interactive"}, NeedsTerminal: true},
 			},
 		},
 		{
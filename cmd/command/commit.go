@@ -17,6 +17,71 @@ func commit() []Info {
 				{Name: "log graph", Summary: "Show log with graph", Usage: []string{"ggc log graph"}},
 			},
 		},
+		{
+			Name:     "bisect",
+			Category: CategoryCommit,
+			Summary:  "Find the commit that introduced a bug",
+			Usage: []string{
+				"ggc bisect",
+				"ggc bisect start [<bad> [<good>]]",
+				"ggc bisect good|bad|skip",
+				"ggc bisect run <command> [<arg>...]",
+				"ggc bisect status|reset",
+			},
+			Examples: []string{
+				"ggc bisect                 # Start or continue a guided bisect from HEAD",
+				"ggc bisect start HEAD v1.2 # Bisect between HEAD (bad) and v1.2 (good)",
+				"ggc bisect bad             # The bug happens on the checked out commit",
+				"ggc bisect run go test ./pkg/...  # Let a command test each commit",
+				"ggc bisect reset           # End the bisect and return to the original branch",
+			},
+			Subcommands: []SubcommandInfo{
+				{Name: "bisect", Summary: "Start or continue a guided bisect", Usage: []string{"ggc bisect"}, NeedsTerminal: true},
+				{Name: "bisect start [<bad> [<good>]]", Summary: "Start a bisect, choosing the good commit from tags and recent commits", Usage: []string{"ggc bisect start", "ggc bisect start HEAD v1.2.0"}, NeedsTerminal: true},
+				{Name: "bisect good", Summary: "Mark the checked out commit as good", Usage: []string{"ggc bisect good"}, NeedsTerminal: true},
+				{Name: "bisect bad", Summary: "Mark the checked out commit as bad", Usage: []string{"ggc bisect bad"}, NeedsTerminal: true},
+				{Name: "bisect skip", Summary: "Skip a commit that cannot be tested", Usage: []string{"ggc bisect skip"}, NeedsTerminal: true},
+				{Name: "bisect run <command>", Summary: "Mark each commit by the exit code of a command", Usage: []string{"ggc bisect run ./test.sh"}, NeedsTerminal: true},
+				{Name: "bisect status", Summary: "Show the bisect progress or its result", Usage: []string{"ggc bisect status"}},
+				{Name: "bisect reset", Summary: "End the bisect and return to the original branch", Usage: []string{"ggc bisect reset"}},
+			},
+		},
+		{
+			Name:     "blame",
+			Category: CategoryCommit,
+			Summary:  "Show who last changed each line of a file",
+			Usage: []string{
+				"ggc blame [<commit>] [--] <file>",
+				"ggc blame interactive [<commit>] [--] <file>",
+			},
+			Examples: []string{
+				"ggc blame cmd/cmd.go              # Show the commit, author and age of each line",
+				"ggc blame v1.2.0 cmd/cmd.go       # Blame the file as it was at v1.2.0",
+				"ggc blame interactive cmd/cmd.go  # Pick a line to see its commit or the revision before it",
+			},
+			Subcommands: []SubcommandInfo{
+				{Name: "blame <file>", Summary: "Show the commit, author and age of each line, colored by age", Usage: []string{"ggc blame README.md", "ggc blame HEAD~3 README.md"}},
+				{Name: "blame interactive <file>", Summary: "Select lines to view their commit's diff or blame the revision before it", Usage: []string{"ggc blame interactive README.md"}, NeedsTerminal: true},
+			},
+		},
+		{
+			Name:     "show",
+			Category: CategoryCommit,
+			Summary:  "Show a commit with its details and changes",
+			Usage: []string{
+				"ggc show [<commit>]",
+				"ggc show interactive [<commit>]",
+			},
+			Examples: []string{
+				"ggc show                   # Show the last commit",
+				"ggc show v1.2.0            # Show the commit a tag points to",
+				"ggc show interactive HEAD  # Step through parent and child commits",
+			},
+			Subcommands: []SubcommandInfo{
+				{Name: "show [<commit>]", Summary: "Show the author, committer, refs, trailers, file stats and diff of a commit", Usage: []string{"ggc show", "ggc show HEAD~2"}},
+				{Name: "show interactive [<commit>]", Summary: "Show a commit and step to its parents or children", Usage: []string{"ggc show interactive"}, NeedsTerminal: true},
+			},
+		},
 		{
 			Name:     "commit",
 			Category: CategoryCommit,
@@ -32,9 +97,9 @@ func commit() []Info {
 			Subcommands: []SubcommandInfo{
 				{Name: "commit <message>", Summary: "Create commit with a message", Usage: []string{"ggc commit \"Add feature\""}},
 				{Name: "commit allow empty", Summary: "Create an empty commit", Usage: []string{"ggc commit allow empty"}},
-				{Name: "commit amend", Summary: "Amend previous commit (editor)", Usage: []string{"ggc commit amend"}},
+				{Name: "commit amend", Summary: "Amend previous commit (editor)", Usage: []string{"ggc commit amend"}, NeedsTerminal: true},
 				{Name: "commit amend no-edit", Summary: "Amend without editing commit message", Usage: []string{"ggc commit amend no-edit"}},
-				{Name: "commit fixup <commit>", Summary: "Create a fixup commit targeting <commit>", Usage: []string{"ggc commit fixup abc1234"}},
+				{Name: "commit fixup <commit>", Summary: "Create a fixup commit targeting <commit>", Usage: []string{"ggc commit fixup abc1234"}, NeedsTerminal: true},
 			},
 		},
 	}
@@ -8,20 +8,35 @@ func diff() []Info {
 			Category: CategoryDiff,
 			Summary:  "Inspect changes between commits, the index, and the working tree",
 			Usage: []string{
-				"ggc diff [staged|unstaged|head] [--stat|--name-only|--name-status] [<commit>|<commit1> <commit2>] [--] [<path>...]",
+				"ggc diff [staged|unstaged|head] [side|word|unified] [--collapse] [pick] [--stat|--name-only|--name-status] [<commit>|<commit1> <commit2>|<a>..<b>] [--] [<path>...]",
+				"ggc diff branch [<base>] [side|word|unified] [pick] [--stat|--name-only|--name-status] [--] [<path>...]",
 			},
 			Examples: []string{
 				"ggc diff --stat                     # Show staged + unstaged changes with summary",
 				"ggc diff staged cmd/diff.go         # Diff staged changes for a file",
 				"ggc diff abc123 def456              # Compare two commits",
+				"ggc diff main..feature              # Compare two branches",
+				"ggc diff branch                     # Show what the current branch changes relative to the default branch",
+				"ggc diff branch develop --stat      # Summarize changes since the branch left develop",
+				"ggc diff branch pick                # Choose a changed file to view",
 				"ggc diff abc123 cmd/diff.go         # Compare commit to working tree for a path",
 				"ggc diff -- cmd/deleted_file.go     # Diff a path using -- for disambiguation",
+				"ggc diff side                       # Show changes side by side",
+				"ggc diff word staged                # Highlight changed words in staged changes",
+				"ggc diff --collapse                 # List changed files with line counts",
 			},
 			Subcommands: []SubcommandInfo{
 				{Name: "diff", Summary: "Show changes (git diff HEAD)", Usage: []string{"ggc diff"}},
 				{Name: "diff unstaged", Summary: "Show unstaged changes", Usage: []string{"ggc diff unstaged"}},
 				{Name: "diff staged", Summary: "Show staged changes", Usage: []string{"ggc diff staged"}},
 				{Name: "diff head", Summary: "Alias for default diff against HEAD", Usage: []string{"ggc diff head"}},
+				{Name: "diff branch", Summary: "Show changes of the current branch since its merge-base with the default branch", Usage: []string{"ggc diff branch [<base>]"}},
+				{Name: "diff pick", Summary: "Choose a changed file and show only its diff", Usage: []string{"ggc diff pick"}, NeedsTerminal: true},
+				{Name: "diff branch pick", Summary: "Choose a file the current branch changes and show only its diff", Usage: []string{"ggc diff branch pick"}, NeedsTerminal: true},
+				{Name: "diff branch <base> pick", Summary: "Choose a file changed since the branch left <base> and show only its diff", Usage: []string{"ggc diff branch develop pick"}, NeedsTerminal: true},
+				{Name: "diff side", Summary: "Show changes in side-by-side columns", Usage: []string{"ggc diff side"}},
+				{Name: "diff word", Summary: "Show changes with changed words highlighted", Usage: []string{"ggc diff word"}},
+				{Name: "diff unified", Summary: "Show git's unified diff regardless of diff.view", Usage: []string{"ggc diff unified"}},
 			},
 		},
 	}
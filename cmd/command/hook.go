@@ -22,7 +22,7 @@ func hook() []Info {
 				{Name: "hook enable <hook>", Summary: "Enable a hook", Usage: []string{"ggc hook enable pre-commit"}},
 				{Name: "hook disable <hook>", Summary: "Disable a hook", Usage: []string{"ggc hook disable pre-commit"}},
 				{Name: "hook uninstall <hook>", Summary: "Uninstall an existing hook", Usage: []string{"ggc hook uninstall pre-commit"}},
-				{Name: "hook edit <hook>", Summary: "Edit a hook's contents", Usage: []string{"ggc hook edit pre-commit"}},
+				{Name: "hook edit <hook>", Summary: "Edit a hook's contents", Usage: []string{"ggc hook edit pre-commit"}, NeedsTerminal: true},
 			},
 		},
 	}
@@ -17,13 +17,54 @@ func rebase() []Info {
 				"ggc rebase skip         # Skip current patch and continue",
 			},
 			Subcommands: []SubcommandInfo{
-				{Name: "rebase interactive", Summary: "Interactive rebase", Usage: []string{"ggc rebase interactive"}},
-				{Name: "rebase autosquash", Summary: "Interactive rebase with --autosquash", Usage: []string{"ggc rebase autosquash"}},
+				{Name: "rebase interactive", Summary: "Interactive rebase", Usage: []string{"ggc rebase interactive"}, NeedsTerminal: true},
+				{Name: "rebase autosquash", Summary: "Interactive rebase with --autosquash", Usage: []string{"ggc rebase autosquash"}, NeedsTerminal: true},
 				{Name: "rebase <upstream>", Summary: "Rebase current branch onto <upstream>", Usage: []string{"ggc rebase main"}},
-				{Name: "rebase continue", Summary: "Continue an in-progress rebase", Usage: []string{"ggc rebase continue"}},
+				{Name: "rebase continue", Summary: "Continue an in-progress rebase", Usage: []string{"ggc rebase continue"}, NeedsTerminal: true},
 				{Name: "rebase abort", Summary: "Abort an in-progress rebase", Usage: []string{"ggc rebase abort"}},
 				{Name: "rebase skip", Summary: "Skip current patch and continue", Usage: []string{"ggc rebase skip"}},
 			},
 		},
+		{
+			Name:     "cherry-pick",
+			Category: CategoryRebase,
+			Summary:  "Apply commits from another branch to the current branch",
+			Usage:    []string{"ggc cherry-pick [<commit>... | continue | abort | skip]"},
+			Examples: []string{
+				"ggc cherry-pick                 # Pick a branch, then select its commits to apply",
+				"ggc cherry-pick 1a2b3c4         # Apply a single commit",
+				"ggc cherry-pick 1a2b3c4 5d6e7f8 # Apply several commits in order",
+				"ggc cherry-pick v1.2.0..fix     # Apply a range of commits",
+				"ggc cherry-pick continue        # Continue after resolving conflicts",
+				"ggc cherry-pick abort           # Cancel and restore the branch",
+			},
+			Subcommands: []SubcommandInfo{
+				{Name: "cherry-pick", Summary: "Select commits from another branch to apply", Usage: []string{"ggc cherry-pick"}, NeedsTerminal: true},
+				{Name: "cherry-pick <commit>", Summary: "Apply commits or ranges such as a..b", Usage: []string{"ggc cherry-pick 1a2b3c4", "ggc cherry-pick v1.2.0..fix"}, NeedsTerminal: true},
+				{Name: "cherry-pick continue", Summary: "Continue an in-progress cherry-pick", Usage: []string{"ggc cherry-pick continue"}, NeedsTerminal: true},
+				{Name: "cherry-pick abort", Summary: "Abort an in-progress cherry-pick", Usage: []string{"ggc cherry-pick abort"}},
+				{Name: "cherry-pick skip", Summary: "Skip the current commit and continue", Usage: []string{"ggc cherry-pick skip"}, NeedsTerminal: true},
+			},
+		},
+		{
+			Name:     "revert",
+			Category: CategoryRebase,
+			Summary:  "Create commits that undo earlier commits",
+			Usage:    []string{"ggc revert [[no-commit] <commit>... | continue | abort]"},
+			Examples: []string{
+				"ggc revert                      # Pick a recent commit, review its files and revert it",
+				"ggc revert 1a2b3c4              # Revert a commit; merge commits ask which parent to keep",
+				"ggc revert no-commit 1a2b3c4    # Stage the reverting changes without committing",
+				"ggc revert continue             # Continue after resolving conflicts",
+				"ggc revert abort                # Cancel and restore the branch",
+			},
+			Subcommands: []SubcommandInfo{
+				{Name: "revert", Summary: "Select a recent commit to revert", Usage: []string{"ggc revert"}, NeedsTerminal: true},
+				{Name: "revert <commit>", Summary: "Revert commits, choosing the mainline of a merge", Usage: []string{"ggc revert 1a2b3c4"}, NeedsTerminal: true},
+				{Name: "revert no-commit <commit>", Summary: "Stage the reverting changes without committing", Usage: []string{"ggc revert no-commit 1a2b3c4"}, NeedsTerminal: true},
+				{Name: "revert continue", Summary: "Continue an in-progress revert", Usage: []string{"ggc revert continue"}, NeedsTerminal: true},
+				{Name: "revert abort", Summary: "Abort an in-progress revert", Usage: []string{"ggc revert abort"}},
+			},
+		},
 	}
 }
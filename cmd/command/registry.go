@@ -42,6 +42,49 @@ func (r *Registry) Find(name string) (Info, bool) {
 	return Info{}, false
 }
 
+// MatchSubcommand returns the subcommand whose name best describes args.
+// Placeholder words such as <file> or [date|name] match any single argument,
+// and a trailing placeholder absorbs any remaining arguments.
+func (r *Registry) MatchSubcommand(args []string) (SubcommandInfo, bool) {
+	var best SubcommandInfo
+	bestScore := -1
+	for i := range r.commands {
+		for j := range r.commands[i].Subcommands {
+			sub := &r.commands[i].Subcommands[j]
+			if score, ok := matchSubcommandWords(strings.Fields(sub.Name), args); ok && score > bestScore {
+				best, bestScore = sub.clone(), score
+			}
+		}
+	}
+	return best, bestScore >= 0
+}
+
+// matchSubcommandWords reports whether args fit the words of a subcommand name
+// and returns the number of literal words matched.
+func matchSubcommandWords(words, args []string) (int, bool) {
+	if len(words) == 0 || len(args) < len(words) {
+		return 0, false
+	}
+	if len(args) > len(words) && !isPlaceholderWord(words[len(words)-1]) {
+		return 0, false
+	}
+	literals := 0
+	for i, word := range words {
+		if isPlaceholderWord(word) {
+			continue
+		}
+		if !strings.EqualFold(word, args[i]) {
+			return 0, false
+		}
+		literals++
+	}
+	return literals, true
+}
+
+func isPlaceholderWord(word string) bool {
+	return strings.HasPrefix(word, "<") || strings.HasPrefix(word, "[")
+}
+
 // VisibleCommands returns non-hidden commands.
 func (r *Registry) VisibleCommands() []Info {
 	var out []Info
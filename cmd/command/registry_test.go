@@ -401,3 +401,36 @@ func TestOrderedCategories(t *testing.T) {
 		t.Errorf("last category = %q, want %q", cats[len(cats)-1], CategoryUtility)
 	}
 }
+
+func TestRegistry_MatchSubcommand(t *testing.T) {
+	t.Parallel()
+	reg := NewRegistry()
+
+	cases := []struct {
+		args          []string
+		wantName      string
+		wantMatch     bool
+		needsTerminal bool
+	}{
+		{args: []string{"branch", "checkout"}, wantName: "branch checkout", wantMatch: true, needsTerminal: true},
+		{args: []string{"branch", "checkout", "remote"}, wantName: "branch checkout remote", wantMatch: true, needsTerminal: true},
+		{args: []string{"branch", "delete", "feature"}, wantMatch: false},
+		{args: []string{"commit", "amend"}, wantName: "commit amend", wantMatch: true, needsTerminal: true},
+		{args: []string{"commit", "fix", "the", "typo"}, wantName: "commit <message>", wantMatch: true},
+		{args: []string{"hook", "edit", "pre-commit"}, wantName: "hook edit <hook>", wantMatch: true, needsTerminal: true},
+		{args: []string{"status"}, wantName: "status", wantMatch: true},
+		{args: []string{"version"}, wantMatch: false},
+	}
+	for _, tc := range cases {
+		sub, ok := reg.MatchSubcommand(tc.args)
+		if ok != tc.wantMatch {
+			t.Fatalf("MatchSubcommand(%v) matched = %v, want %v (got %q)", tc.args, ok, tc.wantMatch, sub.Name)
+		}
+		if !ok {
+			continue
+		}
+		if sub.Name != tc.wantName || sub.NeedsTerminal != tc.needsTerminal {
+			t.Errorf("MatchSubcommand(%v) = {%q, NeedsTerminal: %v}, want {%q, %v}", tc.args, sub.Name, sub.NeedsTerminal, tc.wantName, tc.needsTerminal)
+		}
+	}
+}
@@ -57,5 +57,32 @@ func remote() []Info {
 				{Name: "remote set-url <name> <url>", Summary: "Change remote URL", Usage: []string{"ggc remote set-url origin git@github.com:user/new.git"}},
 			},
 		},
+		{
+			Name:     "submodule",
+			Category: CategoryRemote,
+			Summary:  "Manage submodules",
+			Usage: []string{
+				"ggc submodule [status]",
+				"ggc submodule init [<path>...] | update [<path>...] | sync [<path>...]",
+				"ggc submodule foreach <command>",
+				"ggc submodule add <url> [<path>]",
+			},
+			Examples: []string{
+				"ggc submodule                          # Show which submodules are out of date, modified or uninitialized",
+				"ggc submodule init                     # Register the submodules listed in .gitmodules",
+				"ggc submodule update                   # Check out the recorded commits, nested submodules included",
+				"ggc submodule sync                     # Apply URLs changed in .gitmodules",
+				"ggc submodule foreach 'git fetch'      # Run a command in each submodule",
+				"ggc submodule add ../lib.git libs/lib  # Add a repository as a submodule",
+			},
+			Subcommands: []SubcommandInfo{
+				{Name: "submodule status", Summary: "Show the commit and state of each submodule", Usage: []string{"ggc submodule", "ggc submodule status"}},
+				{Name: "submodule init [<path>...]", Summary: "Register submodules in the repository configuration", Usage: []string{"ggc submodule init"}},
+				{Name: "submodule update [<path>...]", Summary: "Check out the commits recorded in the superproject", Usage: []string{"ggc submodule update", "ggc submodule update libs/lib"}},
+				{Name: "submodule sync [<path>...]", Summary: "Copy submodule URLs from .gitmodules to the configuration", Usage: []string{"ggc submodule sync"}},
+				{Name: "submodule foreach <command>", Summary: "Run a command in each checked out submodule", Usage: []string{"ggc submodule foreach 'git status --short'"}},
+				{Name: "submodule add <url> [<path>]", Summary: "Clone a repository and record it as a submodule", Usage: []string{"ggc submodule add https://github.com/user/lib.git libs/lib"}},
+			},
+		},
 	}
 }
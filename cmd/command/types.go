@@ -19,6 +19,10 @@ type SubcommandInfo struct {
 	Usage    []string
 	Examples []string
 	Hidden   bool
+	// NeedsTerminal marks subcommands that prompt for input or launch an
+	// editor, so interactive mode hands them the TTY instead of capturing
+	// their output.
+	NeedsTerminal bool
 }
 
 func (c *Info) clone() Info {
@@ -48,9 +52,10 @@ func (c *Info) clone() Info {
 
 func (s *SubcommandInfo) clone() SubcommandInfo {
 	clone := SubcommandInfo{
-		Name:    s.Name,
-		Summary: s.Summary,
-		Hidden:  s.Hidden,
+		Name:          s.Name,
+		Summary:       s.Summary,
+		Hidden:        s.Hidden,
+		NeedsTerminal: s.NeedsTerminal,
 	}
 	if len(s.Usage) > 0 {
 		clone.Usage = append([]string(nil), s.Usage...)
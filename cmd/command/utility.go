@@ -33,14 +33,16 @@ func utility() []Info {
 					Usage:   []string{"ggc debug-keys"},
 				},
 				{
-					Name:    "debug-keys raw",
-					Summary: "Capture key sequences interactively",
-					Usage:   []string{"ggc debug-keys raw"},
+					Name:          "debug-keys raw",
+					Summary:       "Capture key sequences interactively",
+					Usage:         []string{"ggc debug-keys raw"},
+					NeedsTerminal: true,
 				},
 				{
-					Name:    "debug-keys raw <file>",
-					Summary: "Capture key sequences and save them to a file",
-					Usage:   []string{"ggc debug-keys raw keys.txt"},
+					Name:          "debug-keys raw <file>",
+					Summary:       "Capture key sequences and save them to a file",
+					Usage:         []string{"ggc debug-keys raw keys.txt"},
+					NeedsTerminal: true,
 				},
 			},
 		},
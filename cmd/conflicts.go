@@ -0,0 +1,309 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/bmf-san/ggc/v8/internal/git"
+	"github.com/bmf-san/ggc/v8/internal/prompt"
+	"github.com/bmf-san/ggc/v8/internal/ui"
+)
+
+// ConflictResolver helps resolve the conflicts of a stopped merge, rebase,
+// cherry-pick or revert.
+type ConflictResolver struct {
+	gitClient    git.ConflictOps
+	outputWriter io.Writer
+	helper       *Helper
+	prompter     prompt.Prompter
+	// mergeTool is the default.merge-tool setting; empty lets git pick
+	// its merge.tool.
+	mergeTool string
+}
+
+// NewConflictResolver creates a new ConflictResolver instance.
+func NewConflictResolver(client git.ConflictOps) *ConflictResolver {
+	output := os.Stdout
+	helper := NewHelper()
+	helper.outputWriter = output
+	return &ConflictResolver{
+		gitClient:    client,
+		outputWriter: output,
+		helper:       helper,
+		prompter:     prompt.New(os.Stdin, output),
+	}
+}
+
+// Conflicts executes conflict resolution commands. Without arguments it
+// starts the interactive assistant.
+func (r *ConflictResolver) Conflicts(args []string) {
+	if len(args) == 0 {
+		r.runAssistant()
+		return
+	}
+
+	switch args[0] {
+	case "list":
+		r.listConflicts()
+	case "tool":
+		r.resolveWith(args[1:], true, r.openInTool)
+	case "ours":
+		r.resolveWith(args[1:], false, func(c []git.Conflict) { r.takeSide(c, false) })
+	case "theirs":
+		r.resolveWith(args[1:], false, func(c []git.Conflict) { r.takeSide(c, true) })
+	case "resolved":
+		r.resolveWith(args[1:], false, r.markResolved)
+	case "continue":
+		r.continueOperation()
+	default:
+		r.helper.ShowConflictsHelp()
+	}
+}
+
+// load returns the operation in progress and its conflicts.
+func (r *ConflictResolver) load() (git.Operation, []git.Conflict, bool) {
+	op, err := r.gitClient.OperationInProgress()
+	if err != nil {
+		WriteError(r.outputWriter, err)
+		return git.OperationNone, nil, false
+	}
+	conflicts, err := r.gitClient.ListConflicts()
+	if err != nil {
+		WriteError(r.outputWriter, err)
+		return git.OperationNone, nil, false
+	}
+	return op, conflicts, true
+}
+
+func (r *ConflictResolver) listConflicts() {
+	op, conflicts, ok := r.load()
+	if !ok {
+		return
+	}
+	if len(conflicts) == 0 {
+		r.writeNoConflicts(op)
+		return
+	}
+	r.writeConflicts(op, conflicts)
+}
+
+func (r *ConflictResolver) writeNoConflicts(op git.Operation) {
+	if !op.CanContinue() {
+		WriteLine(r.outputWriter, "No conflicts.")
+		return
+	}
+	WriteLinef(r.outputWriter, "No conflicts remain; run 'ggc conflicts continue' to continue the %s.", op)
+}
+
+// writeConflicts prints a numbered list of conflicts with their type.
+func (r *ConflictResolver) writeConflicts(op git.Operation, conflicts []git.Conflict) {
+	colors := ui.NewANSIColors()
+	heading := fmt.Sprintf("%d conflicted file%s", len(conflicts), ui.Pluralize(len(conflicts)))
+	if op != git.OperationNone {
+		heading = fmt.Sprintf("%s in progress, %s", op, heading)
+	}
+	WriteLinef(r.outputWriter, "%s%s:%s", colors.Bold+colors.Cyan, heading, colors.Reset)
+	for i, c := range conflicts {
+		WriteLinef(r.outputWriter, "  [%s%d%s] %s%-15s%s %s", colors.Bold+colors.Yellow, i+1, colors.Reset,
+			colors.Red, c.Description(), colors.Reset, c.Path)
+	}
+	if op == git.OperationRebase {
+		WriteLine(r.outputWriter, "During a rebase, ours is the branch being rebased onto and theirs is your commit.")
+	}
+}
+
+// resolveWith applies action to the conflicts named by paths, or to all
+// conflicts when paths is empty and all is set. paths are relative to the
+// current directory. It reports paths that are not conflicted instead of
+// acting on them.
+func (r *ConflictResolver) resolveWith(paths []string, all bool, action func([]git.Conflict)) {
+	if len(paths) == 0 && !all {
+		r.helper.ShowConflictsHelp()
+		return
+	}
+	op, conflicts, ok := r.load()
+	if !ok {
+		return
+	}
+	if len(conflicts) == 0 {
+		r.writeNoConflicts(op)
+		return
+	}
+	if len(paths) == 0 {
+		action(conflicts)
+		return
+	}
+
+	prefix, err := r.gitClient.GetRepositoryPrefix()
+	if err != nil {
+		WriteError(r.outputWriter, err)
+		return
+	}
+	byPath := make(map[string]git.Conflict, len(conflicts))
+	for _, c := range conflicts {
+		byPath[c.Path] = c
+	}
+	var selected []git.Conflict
+	for _, p := range paths {
+		c, found := byPath[path.Join(prefix, filepath.ToSlash(p))]
+		if !found {
+			WriteErrorf(r.outputWriter, "%s is not conflicted", p)
+			return
+		}
+		selected = append(selected, c)
+	}
+	action(selected)
+}
+
+func conflictPaths(conflicts []git.Conflict) []string {
+	paths := make([]string, len(conflicts))
+	for i, c := range conflicts {
+		paths[i] = c.Path
+	}
+	return paths
+}
+
+func (r *ConflictResolver) openInTool(conflicts []git.Conflict) {
+	if err := r.gitClient.RunMergeTool(r.mergeTool, conflictPaths(conflicts)); err != nil {
+		WriteError(r.outputWriter, err)
+	}
+}
+
+func (r *ConflictResolver) takeSide(conflicts []git.Conflict, theirs bool) {
+	side := "ours"
+	if theirs {
+		side = "theirs"
+	}
+	for _, c := range conflicts {
+		if err := r.gitClient.ResolveConflict(c, theirs); err != nil {
+			WriteError(r.outputWriter, err)
+			return
+		}
+		WriteLinef(r.outputWriter, "Resolved %s with %s", c.Path, side)
+	}
+}
+
+func (r *ConflictResolver) markResolved(conflicts []git.Conflict) {
+	paths := conflictPaths(conflicts)
+	if err := r.gitClient.MarkResolved(paths); err != nil {
+		WriteError(r.outputWriter, err)
+		return
+	}
+	WriteLinef(r.outputWriter, "Marked %d file%s resolved", len(paths), ui.Pluralize(len(paths)))
+}
+
+func (r *ConflictResolver) continueOperation() {
+	op, conflicts, ok := r.load()
+	if !ok {
+		return
+	}
+	if !op.CanContinue() {
+		WriteLine(r.outputWriter, "No merge, rebase, cherry-pick or revert in progress.")
+		return
+	}
+	if len(conflicts) > 0 {
+		WriteErrorf(r.outputWriter, "%d conflicted file%s remain; resolve them first", len(conflicts), ui.Pluralize(len(conflicts)))
+		return
+	}
+	if err := r.gitClient.ContinueOperation(op); err != nil {
+		WriteError(r.outputWriter, err)
+		return
+	}
+	WriteLinef(r.outputWriter, "Continued the %s", op)
+}
+
+// conflictActions are the actions offered for selected files.
+var conflictActions = []string{
+	"Open in merge tool",
+	"Keep ours",
+	"Take theirs",
+	"Mark resolved",
+}
+
+// runAssistant lists the conflicts and applies actions to selected files
+// until none remain, then offers to continue the operation.
+func (r *ConflictResolver) runAssistant() {
+	for {
+		op, conflicts, ok := r.load()
+		if !ok {
+			return
+		}
+		if len(conflicts) == 0 {
+			r.offerContinue(op)
+			return
+		}
+
+		r.writeConflicts(op, conflicts)
+		input, ok := ReadLine(r.prompter, r.outputWriter, "Select files by number (space separated, all: select all, Enter: quit): ")
+		if !ok || strings.TrimSpace(input) == "" {
+			return
+		}
+		selected, valid := r.parseSelection(input, conflicts)
+		if !valid {
+			continue
+		}
+
+		idx, canceled, err := r.prompter.Select("Action:", conflictActions, "Enter the number of the action: ")
+		if canceled {
+			return
+		}
+		if err != nil {
+			if !errors.Is(err, prompt.ErrInvalidSelection) {
+				WriteError(r.outputWriter, err)
+				return
+			}
+			WriteLine(r.outputWriter, "Invalid number.")
+			continue
+		}
+		switch idx {
+		case 0:
+			r.openInTool(selected)
+		case 1:
+			r.takeSide(selected, false)
+		case 2:
+			r.takeSide(selected, true)
+		case 3:
+			r.markResolved(selected)
+		}
+	}
+}
+
+func (r *ConflictResolver) parseSelection(input string, conflicts []git.Conflict) ([]git.Conflict, bool) {
+	if strings.TrimSpace(input) == "all" {
+		return conflicts, true
+	}
+	var selected []git.Conflict
+	for _, field := range strings.Fields(input) {
+		n, err := strconv.Atoi(field)
+		if err != nil || n < 1 || n > len(conflicts) {
+			WriteLinef(r.outputWriter, "Invalid number: %s", field)
+			return nil, false
+		}
+		selected = append(selected, conflicts[n-1])
+	}
+	return selected, true
+}
+
+func (r *ConflictResolver) offerContinue(op git.Operation) {
+	if !op.CanContinue() {
+		WriteLine(r.outputWriter, "No conflicts.")
+		return
+	}
+	WriteLine(r.outputWriter, "All conflicts are resolved.")
+	confirmed, canceled, err := r.prompter.Confirm(fmt.Sprintf("Continue the %s? (y/n): ", op))
+	if canceled || err != nil || !confirmed {
+		WriteLine(r.outputWriter, "Run 'ggc conflicts continue' when you are ready.")
+		return
+	}
+	if err := r.gitClient.ContinueOperation(op); err != nil {
+		WriteError(r.outputWriter, err)
+		return
+	}
+	WriteLinef(r.outputWriter, "Continued the %s", op)
+}
@@ -0,0 +1,259 @@
+package cmd
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/bmf-san/ggc/v8/internal/git"
+	"github.com/bmf-san/ggc/v8/internal/prompt"
+)
+
+// mockConflictClient implements git.ConflictOps. Resolving a conflict
+// removes it from the list, like staging the file would.
+type mockConflictClient struct {
+	op        git.Operation
+	conflicts []git.Conflict
+	calls     []string
+	continued bool
+	// prefix is the current directory relative to the top level.
+	prefix string
+}
+
+func (m *mockConflictClient) ListConflicts() ([]git.Conflict, error)      { return m.conflicts, nil }
+func (m *mockConflictClient) GetRepositoryPrefix() (string, error)        { return m.prefix, nil }
+func (m *mockConflictClient) OperationInProgress() (git.Operation, error) { return m.op, nil }
+func (m *mockConflictClient) ContinueOperation(op git.Operation) error {
+	m.calls = append(m.calls, "continue "+string(op))
+	m.continued = true
+	return nil
+}
+func (m *mockConflictClient) ResolveConflict(c git.Conflict, theirs bool) error {
+	side := "ours"
+	if theirs {
+		side = "theirs"
+	}
+	m.calls = append(m.calls, side+" "+c.Path)
+	m.resolve(c.Path)
+	return nil
+}
+func (m *mockConflictClient) MarkResolved(paths []string) error {
+	m.calls = append(m.calls, "resolved "+strings.Join(paths, " "))
+	for _, p := range paths {
+		m.resolve(p)
+	}
+	return nil
+}
+func (m *mockConflictClient) RunMergeTool(tool string, paths []string) error {
+	m.calls = append(m.calls, strings.TrimSpace("tool "+tool)+" "+strings.Join(paths, " "))
+	return nil
+}
+
+func (m *mockConflictClient) resolve(path string) {
+	for i, c := range m.conflicts {
+		if c.Path == path {
+			m.conflicts = append(m.conflicts[:i], m.conflicts[i+1:]...)
+			return
+		}
+	}
+}
+
+var _ git.ConflictOps = (*mockConflictClient)(nil)
+
+func newTestConflictResolver(client *mockConflictClient, buf *bytes.Buffer, input string) *ConflictResolver {
+	helper := NewHelper()
+	helper.outputWriter = buf
+	return &ConflictResolver{
+		gitClient:    client,
+		outputWriter: buf,
+		helper:       helper,
+		prompter:     prompt.New(strings.NewReader(input), buf),
+	}
+}
+
+func sampleConflicts() []git.Conflict {
+	return []git.Conflict{
+		{Path: "a.go", Code: "UU"},
+		{Path: "b.go", Code: "UD"},
+	}
+}
+
+func TestConflictResolver_List(t *testing.T) {
+	var buf bytes.Buffer
+	client := &mockConflictClient{op: git.OperationRebase, conflicts: sampleConflicts()}
+	newTestConflictResolver(client, &buf, "").Conflicts([]string{"list"})
+
+	out := buf.String()
+	for _, want := range []string{"rebase in progress, 2 conflicted files", "both modified", "a.go", "deleted by them", "b.go", "theirs is your commit"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("output %q missing %q", out, want)
+		}
+	}
+
+	buf.Reset()
+	client = &mockConflictClient{op: git.OperationMerge}
+	newTestConflictResolver(client, &buf, "").Conflicts([]string{"list"})
+	if !strings.Contains(buf.String(), "ggc conflicts continue") {
+		t.Errorf("expected continue hint, got %q", buf.String())
+	}
+}
+
+func TestConflictResolver_ResolvePaths(t *testing.T) {
+	tests := []struct {
+		args []string
+		want string
+	}{
+		{[]string{"ours", "a.go"}, "ours a.go"},
+		{[]string{"theirs", "b.go", "a.go"}, "theirs b.go,theirs a.go"},
+		{[]string{"resolved", "a.go"}, "resolved a.go"},
+		{[]string{"tool"}, "tool a.go b.go"},
+		{[]string{"tool", "b.go"}, "tool b.go"},
+	}
+
+	for _, tt := range tests {
+		t.Run(strings.Join(tt.args, " "), func(t *testing.T) {
+			var buf bytes.Buffer
+			client := &mockConflictClient{op: git.OperationMerge, conflicts: sampleConflicts()}
+			newTestConflictResolver(client, &buf, "").Conflicts(tt.args)
+
+			if got := strings.Join(client.calls, ","); got != tt.want {
+				t.Errorf("calls = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestConflictResolver_ResolvePathsFromSubdirectory(t *testing.T) {
+	tests := []struct {
+		args []string
+		want string
+	}{
+		{[]string{"theirs", "f"}, "theirs sub/f"},
+		{[]string{"ours", "./f", "../top.go"}, "ours sub/f,ours top.go"},
+		{[]string{"tool", "f"}, "tool sub/f"},
+	}
+
+	for _, tt := range tests {
+		t.Run(strings.Join(tt.args, " "), func(t *testing.T) {
+			var buf bytes.Buffer
+			client := &mockConflictClient{
+				op:        git.OperationMerge,
+				conflicts: []git.Conflict{{Path: "sub/f", Code: "UU"}, {Path: "top.go", Code: "UU"}},
+				prefix:    "sub/",
+			}
+			newTestConflictResolver(client, &buf, "").Conflicts(tt.args)
+
+			if got := strings.Join(client.calls, ","); got != tt.want {
+				t.Errorf("calls = %q, want %q (output %q)", got, tt.want, buf.String())
+			}
+		})
+	}
+
+	var buf bytes.Buffer
+	client := &mockConflictClient{op: git.OperationMerge, conflicts: []git.Conflict{{Path: "sub/f", Code: "UU"}}, prefix: "sub/"}
+	newTestConflictResolver(client, &buf, "").Conflicts([]string{"theirs", "sub/f"})
+	if len(client.calls) != 0 || !strings.Contains(buf.String(), "sub/f is not conflicted") {
+		t.Errorf("expected sub/f to resolve to sub/sub/f, got calls %v and output %q", client.calls, buf.String())
+	}
+}
+
+func TestConflictResolver_UsesConfiguredTool(t *testing.T) {
+	var buf bytes.Buffer
+	client := &mockConflictClient{op: git.OperationMerge, conflicts: sampleConflicts()}
+	r := newTestConflictResolver(client, &buf, "")
+	r.mergeTool = "meld"
+	r.Conflicts([]string{"tool", "a.go"})
+
+	if got := strings.Join(client.calls, ","); got != "tool meld a.go" {
+		t.Errorf("calls = %q, want %q", got, "tool meld a.go")
+	}
+}
+
+func TestConflictResolver_InvalidPaths(t *testing.T) {
+	var buf bytes.Buffer
+	client := &mockConflictClient{op: git.OperationMerge, conflicts: sampleConflicts()}
+	r := newTestConflictResolver(client, &buf, "")
+
+	r.Conflicts([]string{"ours", "a.go", "c.go"})
+	if len(client.calls) != 0 {
+		t.Fatalf("expected no changes, got %v", client.calls)
+	}
+	if !strings.Contains(buf.String(), "c.go is not conflicted") {
+		t.Errorf("unexpected output %q", buf.String())
+	}
+
+	buf.Reset()
+	r.Conflicts([]string{"theirs"})
+	if len(client.calls) != 0 || !strings.Contains(buf.String(), "ggc conflicts") {
+		t.Errorf("expected help without a path, got calls %v and output %q", client.calls, buf.String())
+	}
+}
+
+func TestConflictResolver_Continue(t *testing.T) {
+	var buf bytes.Buffer
+	client := &mockConflictClient{op: git.OperationCherryPick, conflicts: sampleConflicts()}
+	r := newTestConflictResolver(client, &buf, "")
+
+	r.Conflicts([]string{"continue"})
+	if client.continued {
+		t.Fatal("should not continue while conflicts remain")
+	}
+	if !strings.Contains(buf.String(), "2 conflicted files remain") {
+		t.Errorf("unexpected output %q", buf.String())
+	}
+
+	buf.Reset()
+	client.conflicts = nil
+	r.Conflicts([]string{"continue"})
+	if !client.continued || !strings.Contains(buf.String(), "Continued the cherry-pick") {
+		t.Errorf("expected the cherry-pick to continue, got %q", buf.String())
+	}
+
+	buf.Reset()
+	client = &mockConflictClient{}
+	newTestConflictResolver(client, &buf, "").Conflicts([]string{"continue"})
+	if client.continued || !strings.Contains(buf.String(), "No merge, rebase, cherry-pick or revert in progress.") {
+		t.Errorf("unexpected output %q", buf.String())
+	}
+}
+
+func TestConflictResolver_Assistant(t *testing.T) {
+	var buf bytes.Buffer
+	client := &mockConflictClient{op: git.OperationMerge, conflicts: sampleConflicts()}
+	// Take theirs for b.go, mark a.go resolved, then continue the merge.
+	input := "2\n3\n1\n4\ny\n"
+	newTestConflictResolver(client, &buf, input).Conflicts(nil)
+
+	if got := strings.Join(client.calls, ","); got != "theirs b.go,resolved a.go,continue merge" {
+		t.Errorf("calls = %q", got)
+	}
+	if !strings.Contains(buf.String(), "All conflicts are resolved.") {
+		t.Errorf("unexpected output %q", buf.String())
+	}
+}
+
+func TestConflictResolver_AssistantInvalidAndQuit(t *testing.T) {
+	var buf bytes.Buffer
+	client := &mockConflictClient{op: git.OperationMerge, conflicts: sampleConflicts()}
+	newTestConflictResolver(client, &buf, "9\n\n").Conflicts(nil)
+
+	if len(client.calls) != 0 {
+		t.Fatalf("expected no changes, got %v", client.calls)
+	}
+	if !strings.Contains(buf.String(), "Invalid number: 9") {
+		t.Errorf("unexpected output %q", buf.String())
+	}
+}
+
+func TestConflictResolver_AssistantDeclinesContinue(t *testing.T) {
+	var buf bytes.Buffer
+	client := &mockConflictClient{op: git.OperationRebase, conflicts: sampleConflicts()}
+	newTestConflictResolver(client, &buf, "all\n2\nn\n").Conflicts(nil)
+
+	if got := strings.Join(client.calls, ","); got != "ours a.go,ours b.go" {
+		t.Errorf("calls = %q", got)
+	}
+	if !strings.Contains(buf.String(), "Run 'ggc conflicts continue' when you are ready.") {
+		t.Errorf("unexpected output %q", buf.String())
+	}
+}
@@ -8,21 +8,40 @@ import (
 	"strings"
 
 	"github.com/bmf-san/ggc/v8/internal/git"
+	"github.com/bmf-san/ggc/v8/internal/prompt"
+	"github.com/bmf-san/ggc/v8/internal/ui"
 )
 
 // Differ handles git diff operations.
 type Differ struct {
-	gitClient    git.DiffReader
+	gitClient interface {
+		git.DiffReader
+		git.DefaultBranchReader
+	}
 	outputWriter io.Writer
 	helper       *Helper
+	pager        *pager
+	prompter     prompt.Prompter
+	// view and collapse are the diff.view and diff.collapse settings.
+	view     diffView
+	collapse []string
+	// defaultRemote is the remote whose HEAD names the default branch
+	// for ggc diff branch.
+	defaultRemote string
 }
 
 // NewDiffer creates a new Differ instance.
-func NewDiffer(client git.DiffReader) *Differ {
+func NewDiffer(client interface {
+	git.DiffReader
+	git.DefaultBranchReader
+}) *Differ {
+	output := os.Stdout
 	return &Differ{
-		gitClient:    client,
-		outputWriter: os.Stdout,
-		helper:       NewHelper(),
+		gitClient:     client,
+		outputWriter:  output,
+		helper:        NewHelper(),
+		prompter:      prompt.New(os.Stdin, output),
+		defaultRemote: "origin",
 	}
 }
 
@@ -33,6 +52,7 @@ const (
 	diffModeUnstaged
 	diffModeStaged
 	diffModeHead
+	diffModeBranch
 )
 
 func (m diffMode) String() string {
@@ -43,6 +63,8 @@ func (m diffMode) String() string {
 		return "staged"
 	case diffModeHead:
 		return "head"
+	case diffModeBranch:
+		return "branch"
 	default:
 		return "default"
 	}
@@ -55,6 +77,13 @@ type diffOptions struct {
 	stat       bool
 	nameOnly   bool
 	nameStatus bool
+	view       diffView
+	viewSet    bool
+	collapse   bool
+	pick       bool
+	// plain asks git for uncolored output. color.ui=always would otherwise
+	// add escapes to the output ggc parses.
+	plain bool
 }
 
 type diffUsageError struct {
@@ -90,14 +119,113 @@ func (d *Differ) Diff(args []string) {
 		return
 	}
 
-	gitArgs := buildDiffArgs(opts)
-	output, err := d.gitClient.DiffWith(gitArgs)
+	if opts.mode == diffModeBranch && len(opts.commits) == 0 {
+		base, err := d.gitClient.DefaultBranch(d.defaultRemote)
+		if err != nil {
+			WriteError(d.outputWriter, err)
+			return
+		}
+		opts.commits = []string{base}
+	}
+
+	if opts.pick {
+		paths, ok := d.pickFile(opts)
+		if !ok {
+			return
+		}
+		opts.paths = paths
+	}
+
+	view := d.view
+	if opts.viewSet {
+		view = opts.view
+	}
+	summary := opts.stat || opts.nameOnly || opts.nameStatus
+	opts.plain = !summary && (view != diffViewUnified || opts.collapse)
+
+	output, err := d.gitClient.DiffWith(buildDiffArgs(opts))
 	if err != nil {
 		WriteError(d.outputWriter, err)
 		return
 	}
 
-	_, _ = fmt.Fprint(d.outputWriter, output)
+	if summary {
+		d.pager.write(d.outputWriter, "diff", output)
+		return
+	}
+	d.pager.write(d.outputWriter, "diff", renderDiff(d.outputWriter, output, view, d.collapse, opts.collapse))
+}
+
+// renderDiff renders git diff output in view for w. The unified view is
+// git's own output unless listOnly asks for the file list only.
+func renderDiff(w io.Writer, output string, view diffView, collapse []string, listOnly bool) string {
+	if output == "" || (view == diffViewUnified && !listOnly) {
+		return output
+	}
+	width, _ := ui.Dimensions(w, 120, 24)
+	return newDiffRenderer(width, collapse).render(parseUnifiedDiff(output), view, listOnly)
+}
+
+// renderCommitDiff renders the uncolored diff of a commit in view. The
+// unified view is colored here since git was not asked for color.
+func renderCommitDiff(w io.Writer, diff string, view diffView, collapse []string) string {
+	if view == diffViewUnified {
+		return colorUnifiedDiff(diff)
+	}
+	return renderDiff(w, diff, view, collapse, false)
+}
+
+// pickFile lists the files changed by opts and asks which one to view. It
+// returns the paths to diff: both sides of a rename, otherwise one path.
+func (d *Differ) pickFile(opts *diffOptions) ([]string, bool) {
+	listOpts := *opts
+	listOpts.nameStatus = true
+	listOpts.plain = true
+	output, err := d.gitClient.DiffWith(buildDiffArgs(&listOpts))
+	if err != nil {
+		WriteError(d.outputWriter, err)
+		return nil, false
+	}
+
+	var items []string
+	var paths [][]string
+	sym := ui.NewSymbols()
+	for _, line := range strings.Split(strings.TrimSpace(output), "\n") {
+		fields := strings.Split(line, "\t")
+		if len(fields) < 2 || fields[0] == "" {
+			continue
+		}
+		status := fields[0][:1]
+		switch {
+		case len(fields) >= 3 && (status == "R" || status == "C"):
+			items = append(items, fmt.Sprintf("%s %s %s %s", status, fields[1], sym.Arrow, fields[2]))
+			paths = append(paths, fields[1:3])
+		default:
+			items = append(items, status+" "+fields[1])
+			paths = append(paths, fields[1:2])
+		}
+	}
+	if len(items) == 0 {
+		WriteLine(d.outputWriter, "No changes.")
+		return nil, false
+	}
+
+	if d.prompter == nil {
+		return nil, false
+	}
+	idx, canceled, err := d.prompter.Select("Changed files:", items, "Enter the number of the file to view: ")
+	if canceled {
+		return nil, false
+	}
+	if err != nil {
+		if errors.Is(err, prompt.ErrInvalidSelection) {
+			WriteLine(d.outputWriter, "Invalid number.")
+		} else {
+			WriteError(d.outputWriter, err)
+		}
+		return nil, false
+	}
+	return paths[idx], true
 }
 
 func parseDiffArgs(args []string, pathExists func(string) bool) (*diffOptions, error) {
@@ -155,8 +283,16 @@ func (s *diffParseState) consume(arg string) error {
 
 func (s *diffParseState) handleBeforeDoubleDash(arg string) error {
 	switch arg {
-	case "unstaged", "staged", "head":
+	case "unstaged", "staged", "head", "branch":
 		return s.setMode(arg)
+	case "side", "word", "unified":
+		return s.setView(arg)
+	case "--collapse":
+		s.opts.collapse = true
+		return nil
+	case "pick", "--pick":
+		s.opts.pick = true
+		return nil
 	case "--stat":
 		s.opts.stat = true
 		return nil
@@ -192,6 +328,16 @@ func (s *diffParseState) setMode(mode string) error {
 	return nil
 }
 
+func (s *diffParseState) setView(name string) error {
+	if s.opts.viewSet {
+		return newDiffUsageError("multiple diff views specified")
+	}
+
+	s.opts.view, _ = parseDiffView(name)
+	s.opts.viewSet = true
+	return nil
+}
+
 func mapMode(mode string) diffMode {
 	switch mode {
 	case "unstaged":
@@ -200,6 +346,8 @@ func mapMode(mode string) diffMode {
 		return diffModeStaged
 	case "head":
 		return diffModeHead
+	case "branch":
+		return diffModeBranch
 	default:
 		return diffModeDefault
 	}
@@ -209,6 +357,20 @@ func (s *diffParseState) validateModes() error {
 	if (s.opts.mode == diffModeStaged || s.opts.mode == diffModeUnstaged || s.opts.mode == diffModeHead) && len(s.opts.commits) > 0 {
 		return newDiffUsageError(fmt.Sprintf("%s mode does not accept commit arguments (but allows path arguments)", s.opts.mode.String()))
 	}
+	if s.opts.mode == diffModeBranch {
+		if len(s.opts.commits) > 1 {
+			return newDiffUsageError("branch mode accepts at most one base branch")
+		}
+		if len(s.opts.commits) == 1 && strings.Contains(s.opts.commits[0], "..") {
+			return newDiffUsageError("branch mode expects a base branch, not a range")
+		}
+	}
+	if len(s.opts.commits) > 1 && (strings.Contains(s.opts.commits[0], "..") || strings.Contains(s.opts.commits[1], "..")) {
+		return newDiffUsageError("a commit range cannot be combined with another commit")
+	}
+	if s.opts.pick && (s.opts.stat || s.opts.nameOnly || s.opts.nameStatus || s.opts.collapse) {
+		return newDiffUsageError("--pick cannot be combined with --stat, --name-only, --name-status or --collapse")
+	}
 	return nil
 }
 
@@ -252,7 +414,11 @@ func pathsAreValid(paths []string, pathExists func(string) bool) bool {
 }
 
 func buildDiffArgs(opts *diffOptions) []string {
-	args := append([]string(nil), modeArg(opts)...)
+	var args []string
+	if opts.plain {
+		args = append(args, "--no-color")
+	}
+	args = append(args, modeArg(opts)...)
 	args = append(args, summaryArgs(opts)...)
 	args = append(args, commitArgs(opts)...)
 
@@ -288,6 +454,11 @@ func summaryArgs(opts *diffOptions) []string {
 }
 
 func commitArgs(opts *diffOptions) []string {
+	if opts.mode == diffModeBranch && len(opts.commits) == 1 {
+		// The three-dot form diffs HEAD against its merge-base with the
+		// base branch, so changes on the base are not shown.
+		return []string{opts.commits[0] + "...HEAD"}
+	}
 	if len(opts.commits) > 0 {
 		return append([]string(nil), opts.commits...)
 	}
@@ -0,0 +1,626 @@
+package cmd
+
+import (
+	"fmt"
+	"path"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/bmf-san/ggc/v8/internal/ui"
+)
+
+// diffView selects how ggc diff presents changes.
+type diffView int
+
+const (
+	// diffViewUnified prints git's own unified diff.
+	diffViewUnified diffView = iota
+	// diffViewSide renders old and new lines in two columns.
+	diffViewSide
+	// diffViewWord merges changed line pairs and highlights changed words.
+	diffViewWord
+)
+
+func parseDiffView(name string) (diffView, bool) {
+	switch name {
+	case "", "unified":
+		return diffViewUnified, true
+	case "side":
+		return diffViewSide, true
+	case "word":
+		return diffViewWord, true
+	}
+	return diffViewUnified, false
+}
+
+// diffFile is one file section of a unified diff.
+type diffFile struct {
+	oldPath string
+	newPath string
+	status  byte // 'A'dded, 'D'eleted, 'R'enamed or 'M'odified
+	binary  bool
+	hunks   []*diffHunk
+	added   int
+	deleted int
+}
+
+type diffHunk struct {
+	header   string
+	oldStart int
+	newStart int
+	lines    []diffLine
+}
+
+type diffLine struct {
+	kind byte // ' ' context, '-' deleted, '+' added, '\\' no-newline note
+	text string
+}
+
+var hunkHeaderRe = regexp.MustCompile(`^@@ -(\d+)(?:,\d+)? \+(\d+)(?:,\d+)? @@`)
+
+// colorUnifiedDiff colors an uncolored unified diff the way git does: file
+// headers in bold, hunk headers in cyan, removed lines in red and added
+// lines in green.
+func colorUnifiedDiff(text string) string {
+	colors := ui.NewANSIColors()
+	if colors.Reset == "" {
+		return text
+	}
+	lines := strings.SplitAfter(text, "\n")
+	inHeader := false
+	for i, line := range lines {
+		body := strings.TrimSuffix(line, "\n")
+		var color string
+		switch {
+		case body == "":
+			continue
+		case strings.HasPrefix(body, "diff --git "):
+			inHeader = true
+			color = colors.Bold
+		case strings.HasPrefix(body, "@@"):
+			inHeader = false
+			color = colors.Cyan
+		case inHeader:
+			color = colors.Bold
+		case body[0] == '+':
+			color = colors.Green
+		case body[0] == '-':
+			color = colors.Red
+		}
+		if color != "" {
+			lines[i] = color + body + colors.Reset + line[len(body):]
+		}
+	}
+	return strings.Join(lines, "")
+}
+
+// parseUnifiedDiff parses the output of git diff into file sections.
+func parseUnifiedDiff(text string) []*diffFile {
+	var files []*diffFile
+	var file *diffFile
+	var hunk *diffHunk
+	for _, line := range strings.Split(strings.TrimSuffix(text, "\n"), "\n") {
+		switch {
+		case strings.HasPrefix(line, "diff --git "):
+			file = newDiffFile(strings.TrimPrefix(line, "diff --git "))
+			files = append(files, file)
+			hunk = nil
+		case file == nil:
+			continue
+		case strings.HasPrefix(line, "@@"):
+			hunk = parseHunkHeader(line)
+			file.hunks = append(file.hunks, hunk)
+		case hunk != nil:
+			file.addLine(hunk, line)
+		default:
+			file.parseHeader(line)
+		}
+	}
+	return files
+}
+
+func newDiffFile(paths string) *diffFile {
+	f := &diffFile{status: 'M'}
+	if i := strings.LastIndex(paths, " b/"); i >= 0 {
+		f.oldPath = trimDiffPath(paths[:i], "a/")
+		f.newPath = trimDiffPath(paths[i+1:], "b/")
+	} else {
+		f.oldPath, f.newPath = paths, paths
+	}
+	return f
+}
+
+func trimDiffPath(p, prefix string) string {
+	if unquoted, err := strconv.Unquote(p); err == nil {
+		p = unquoted
+	}
+	return strings.TrimPrefix(p, prefix)
+}
+
+func (f *diffFile) parseHeader(line string) {
+	switch {
+	case strings.HasPrefix(line, "new file mode"):
+		f.status = 'A'
+	case strings.HasPrefix(line, "deleted file mode"):
+		f.status = 'D'
+	case strings.HasPrefix(line, "rename from "):
+		f.oldPath = strings.TrimPrefix(line, "rename from ")
+		f.status = 'R'
+	case strings.HasPrefix(line, "rename to "):
+		f.newPath = strings.TrimPrefix(line, "rename to ")
+		f.status = 'R'
+	case strings.HasPrefix(line, "Binary files "):
+		f.binary = true
+	case strings.HasPrefix(line, "--- "):
+		if p := strings.TrimPrefix(line, "--- "); p != "/dev/null" {
+			f.oldPath = trimDiffPath(p, "a/")
+		}
+	case strings.HasPrefix(line, "+++ "):
+		if p := strings.TrimPrefix(line, "+++ "); p != "/dev/null" {
+			f.newPath = trimDiffPath(p, "b/")
+		}
+	}
+}
+
+func parseHunkHeader(line string) *diffHunk {
+	h := &diffHunk{header: line}
+	if m := hunkHeaderRe.FindStringSubmatch(line); m != nil {
+		h.oldStart, _ = strconv.Atoi(m[1])
+		h.newStart, _ = strconv.Atoi(m[2])
+	}
+	return h
+}
+
+func (f *diffFile) addLine(h *diffHunk, line string) {
+	if line == "" {
+		// Some tools strip the trailing space of empty context lines.
+		h.lines = append(h.lines, diffLine{kind: ' '})
+		return
+	}
+	kind := line[0]
+	switch kind {
+	case '-':
+		f.deleted++
+	case '+':
+		f.added++
+	case ' ', '\\':
+	default:
+		return
+	}
+	h.lines = append(h.lines, diffLine{kind: kind, text: line[1:]})
+}
+
+// displayPath returns the path shown for the file.
+func (f *diffFile) displayPath(sym *ui.Symbols) string {
+	switch f.status {
+	case 'D':
+		return f.oldPath
+	case 'R':
+		return f.oldPath + " " + sym.Arrow + " " + f.newPath
+	}
+	return f.newPath
+}
+
+// diffRow is one rendered line pair. Either side is nil when the line has
+// no counterpart; ops is set when the pair is similar enough to diff words.
+type diffRow struct {
+	old, new       *diffLine
+	oldNum, newNum int
+	ops            []wordOp
+}
+
+// hunkRows pairs the deleted and added lines of each change block so they
+// can be shown next to each other.
+func hunkRows(h *diffHunk) []diffRow {
+	var rows []diffRow
+	oldNum, newNum := h.oldStart, h.newStart
+	for i := 0; i < len(h.lines); {
+		line := &h.lines[i]
+		switch line.kind {
+		case ' ':
+			rows = append(rows, diffRow{old: line, new: line, oldNum: oldNum, newNum: newNum})
+			oldNum++
+			newNum++
+			i++
+			continue
+		case '\\':
+			rows = append(rows, diffRow{old: line})
+			i++
+			continue
+		}
+
+		var dels, adds []*diffLine
+		for ; i < len(h.lines) && h.lines[i].kind == '-'; i++ {
+			dels = append(dels, &h.lines[i])
+		}
+		for ; i < len(h.lines) && h.lines[i].kind == '+'; i++ {
+			adds = append(adds, &h.lines[i])
+		}
+		for j := 0; j < max(len(dels), len(adds)); j++ {
+			var row diffRow
+			if j < len(dels) {
+				row.old, row.oldNum = dels[j], oldNum
+				oldNum++
+			}
+			if j < len(adds) {
+				row.new, row.newNum = adds[j], newNum
+				newNum++
+			}
+			if row.old != nil && row.new != nil {
+				row.ops, _ = wordDiff(row.old.text, row.new.text)
+			}
+			rows = append(rows, row)
+		}
+	}
+	return rows
+}
+
+// wordOp is a run of tokens that is common to both lines (' '), only in
+// the old line ('-') or only in the new line ('+').
+type wordOp struct {
+	kind byte
+	text string
+}
+
+// maxWordDiffCells bounds the token comparison table of a line pair.
+const maxWordDiffCells = 250000
+
+// wordDiff compares two lines token by token. It reports false when the
+// lines share too little to make an intra-line diff readable.
+func wordDiff(a, b string) ([]wordOp, bool) {
+	ta, tb := diffTokens(a), diffTokens(b)
+	if len(ta) == 0 || len(tb) == 0 || len(ta)*len(tb) > maxWordDiffCells {
+		return nil, false
+	}
+
+	// lcs[i][j] is the length of the longest common subsequence of ta[i:]
+	// and tb[j:].
+	lcs := make([][]int, len(ta)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(tb)+1)
+	}
+	for i := len(ta) - 1; i >= 0; i-- {
+		for j := len(tb) - 1; j >= 0; j-- {
+			if ta[i] == tb[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else {
+				lcs[i][j] = max(lcs[i+1][j], lcs[i][j+1])
+			}
+		}
+	}
+
+	var ops []wordOp
+	common := 0
+	push := func(kind byte, text string) {
+		if n := len(ops); n > 0 && ops[n-1].kind == kind {
+			ops[n-1].text += text
+			return
+		}
+		ops = append(ops, wordOp{kind: kind, text: text})
+	}
+	i, j := 0, 0
+	for i < len(ta) || j < len(tb) {
+		switch {
+		case i < len(ta) && j < len(tb) && ta[i] == tb[j]:
+			common += len(strings.TrimSpace(ta[i]))
+			push(' ', ta[i])
+			i++
+			j++
+		case j == len(tb) || (i < len(ta) && lcs[i+1][j] >= lcs[i][j+1]):
+			push('-', ta[i])
+			i++
+		default:
+			push('+', tb[j])
+			j++
+		}
+	}
+
+	total := len(strings.Join(strings.Fields(a), "")) + len(strings.Join(strings.Fields(b), ""))
+	if total == 0 || common*2*10 < total*4 {
+		return nil, false
+	}
+	return ops, true
+}
+
+// diffTokens splits s into words, runs of whitespace and single
+// punctuation characters.
+func diffTokens(s string) []string {
+	var tokens []string
+	runes := []rune(s)
+	for i := 0; i < len(runes); {
+		j := i + 1
+		switch {
+		case isWordRune(runes[i]):
+			for j < len(runes) && isWordRune(runes[j]) {
+				j++
+			}
+		case unicode.IsSpace(runes[i]):
+			for j < len(runes) && unicode.IsSpace(runes[j]) {
+				j++
+			}
+		}
+		tokens = append(tokens, string(runes[i:j]))
+		i = j
+	}
+	return tokens
+}
+
+func isWordRune(r rune) bool {
+	return r == '_' || unicode.IsLetter(r) || unicode.IsDigit(r)
+}
+
+// diffRenderer renders parsed diffs for the side and word views.
+type diffRenderer struct {
+	sb       strings.Builder
+	width    int
+	colors   *ui.ANSIColors
+	sym      *ui.Symbols
+	collapse []string
+}
+
+func newDiffRenderer(width int, collapse []string) *diffRenderer {
+	return &diffRenderer{
+		width:    max(width, 40),
+		colors:   ui.NewANSIColors(),
+		sym:      ui.NewSymbols(),
+		collapse: collapse,
+	}
+}
+
+// render renders files in view. With listOnly only the file list is shown.
+func (r *diffRenderer) render(files []*diffFile, view diffView, listOnly bool) string {
+	r.renderFileList(files)
+	if listOnly {
+		return r.sb.String()
+	}
+	for _, f := range files {
+		r.renderFile(f, view)
+	}
+	return r.sb.String()
+}
+
+func (r *diffRenderer) line(format string, args ...any) {
+	fmt.Fprintf(&r.sb, format+"\n", args...)
+}
+
+func (r *diffRenderer) counts(f *diffFile) string {
+	var parts []string
+	if f.added > 0 {
+		parts = append(parts, fmt.Sprintf("%s+%d%s", r.colors.Green, f.added, r.colors.Reset))
+	}
+	if f.deleted > 0 {
+		parts = append(parts, fmt.Sprintf("%s-%d%s", r.colors.Red, f.deleted, r.colors.Reset))
+	}
+	if f.binary {
+		parts = append(parts, "(binary)")
+	}
+	return strings.Join(parts, " ")
+}
+
+func (r *diffRenderer) renderFileList(files []*diffFile) {
+	added, deleted, pathWidth := 0, 0, 0
+	for _, f := range files {
+		added += f.added
+		deleted += f.deleted
+		pathWidth = max(pathWidth, ui.DisplayWidth(f.displayPath(r.sym)))
+	}
+	r.line("%s%d file%s changed%s, %s+%d%s %s-%d%s",
+		r.colors.Bold, len(files), ui.Pluralize(len(files)), r.colors.Reset,
+		r.colors.Green, added, r.colors.Reset, r.colors.Red, deleted, r.colors.Reset)
+	for _, f := range files {
+		p := f.displayPath(r.sym)
+		r.line("  %s%c%s %s%s  %s", r.statusColor(f), f.status, r.colors.Reset,
+			p, strings.Repeat(" ", pathWidth-ui.DisplayWidth(p)), r.counts(f))
+	}
+}
+
+func (r *diffRenderer) statusColor(f *diffFile) string {
+	switch f.status {
+	case 'A':
+		return r.colors.Green
+	case 'D':
+		return r.colors.Red
+	case 'R':
+		return r.colors.Cyan
+	}
+	return r.colors.Yellow
+}
+
+// collapsed reports whether f matches one of the diff.collapse patterns,
+// by full path or base name.
+func (r *diffRenderer) collapsed(f *diffFile) bool {
+	p := f.newPath
+	if f.status == 'D' {
+		p = f.oldPath
+	}
+	for _, pattern := range r.collapse {
+		if ok, _ := path.Match(pattern, p); ok {
+			return true
+		}
+		if ok, _ := path.Match(pattern, path.Base(p)); ok {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *diffRenderer) renderFile(f *diffFile, view diffView) {
+	r.line("")
+	r.line("%s%s%s", r.colors.BrightBlack, strings.Repeat(r.sym.Rule, r.width), r.colors.Reset)
+	r.line("%s%c %s%s  %s", r.colors.Bold, f.status, f.displayPath(r.sym), r.colors.Reset, r.counts(f))
+	switch {
+	case f.binary:
+		r.line("%sBinary file not shown%s", r.colors.BrightBlack, r.colors.Reset)
+		return
+	case r.collapsed(f):
+		r.line("%sCollapsed (diff.collapse)%s", r.colors.BrightBlack, r.colors.Reset)
+		return
+	}
+
+	numWidth := 1
+	for _, h := range f.hunks {
+		for _, start := range []int{h.oldStart, h.newStart} {
+			numWidth = max(numWidth, len(strconv.Itoa(start+len(h.lines))))
+		}
+	}
+	for _, h := range f.hunks {
+		r.line("%s%s%s", r.colors.Cyan, h.header, r.colors.Reset)
+		for _, row := range hunkRows(h) {
+			if view == diffViewSide {
+				r.renderSideRow(row, numWidth)
+			} else {
+				r.renderWordRow(row, numWidth)
+			}
+		}
+	}
+}
+
+func (r *diffRenderer) lineNumber(n, numWidth int) string {
+	if n == 0 {
+		return strings.Repeat(" ", numWidth)
+	}
+	return fmt.Sprintf("%s%*d%s", r.colors.BrightBlack, numWidth, n, r.colors.Reset)
+}
+
+// renderSideRow renders a row as "num m text │ num m text", each column
+// sized to half the terminal.
+func (r *diffRenderer) renderSideRow(row diffRow, numWidth int) {
+	colWidth := max((r.width-2*numWidth-9)/2, 10)
+	if row.old != nil && row.old.kind == '\\' {
+		r.line("%s%s%s", r.colors.BrightBlack, row.old.text, r.colors.Reset)
+		return
+	}
+	var oldOps, newOps []wordOp
+	for _, op := range row.ops {
+		if op.kind != '+' {
+			oldOps = append(oldOps, op)
+		}
+		if op.kind != '-' {
+			newOps = append(newOps, op)
+		}
+	}
+	left := r.sideCell(row.old, row.oldNum, oldOps, numWidth, colWidth)
+	right := r.sideCell(row.new, row.newNum, newOps, numWidth, colWidth)
+	text := fmt.Sprintf("%s %s%s%s %s", left, r.colors.BrightBlack, r.sym.Separator, r.colors.Reset, right)
+	r.line("%s", strings.TrimRight(text, " "))
+}
+
+func (r *diffRenderer) sideCell(line *diffLine, num int, ops []wordOp, numWidth, colWidth int) string {
+	if line == nil {
+		return strings.Repeat(" ", numWidth+3+colWidth)
+	}
+	if ops == nil {
+		// Lines without a word diff are shown in the base color only.
+		ops = []wordOp{{kind: ' ', text: line.text}}
+	}
+	base, highlight := r.lineStyle(line.kind)
+	return fmt.Sprintf("%s %s%c%s %s", r.lineNumber(num, numWidth), base, line.kind, r.colors.Reset,
+		r.fitOps(ops, colWidth, base, highlight))
+}
+
+func (r *diffRenderer) lineStyle(kind byte) (base, highlight string) {
+	switch kind {
+	case '-':
+		return r.colors.Red, r.colors.Red + r.colors.Reverse
+	case '+':
+		return r.colors.Green, r.colors.Green + r.colors.Reverse
+	}
+	return "", ""
+}
+
+// fitOps renders ops into exactly colWidth columns, styling changed runs
+// with highlight and cutting overlong lines with an ellipsis.
+func (r *diffRenderer) fitOps(ops []wordOp, colWidth int, base, highlight string) string {
+	total := 0
+	for i := range ops {
+		ops[i].text = expandTabs(ops[i].text)
+		total += ui.DisplayWidth(ops[i].text)
+	}
+	limit := colWidth
+	ellipsis := ""
+	if total > colWidth {
+		ellipsis = r.sym.Ellipsis
+		limit = colWidth - ui.DisplayWidth(ellipsis)
+	}
+
+	var sb strings.Builder
+	used := 0
+	for _, op := range ops {
+		style := base
+		if op.kind != ' ' {
+			style = highlight
+		}
+		sb.WriteString(style)
+		for _, ch := range op.text {
+			w := ui.RuneWidth(ch)
+			if used+w > limit {
+				break
+			}
+			sb.WriteRune(ch)
+			used += w
+		}
+		if style != "" {
+			sb.WriteString(r.colors.Reset)
+		}
+		if used >= limit {
+			break
+		}
+	}
+	if ellipsis != "" {
+		sb.WriteString(base + ellipsis + r.colors.Reset)
+		used += ui.DisplayWidth(ellipsis)
+	}
+	sb.WriteString(strings.Repeat(" ", max(colWidth-used, 0)))
+	return sb.String()
+}
+
+// renderWordRow renders a row as "old new │ m text", merging similar line
+// pairs into one line with the changed words marked.
+func (r *diffRenderer) renderWordRow(row diffRow, numWidth int) {
+	prefix := func(oldNum, newNum int) string {
+		return fmt.Sprintf("%s %s %s%s%s", r.lineNumber(oldNum, numWidth), r.lineNumber(newNum, numWidth),
+			r.colors.BrightBlack, r.sym.Separator, r.colors.Reset)
+	}
+	switch {
+	case row.old != nil && row.old.kind == '\\':
+		r.line("%s%s%s", r.colors.BrightBlack, row.old.text, r.colors.Reset)
+	case row.ops != nil:
+		r.line("%s %s~%s %s", prefix(row.oldNum, row.newNum), r.colors.Yellow, r.colors.Reset, r.wordOps(row.ops))
+	case row.old == row.new:
+		r.line("%s   %s", prefix(row.oldNum, row.newNum), row.old.text)
+	default:
+		if row.old != nil {
+			r.line("%s %s- %s%s", prefix(row.oldNum, 0), r.colors.Red, row.old.text, r.colors.Reset)
+		}
+		if row.new != nil {
+			r.line("%s %s+ %s%s", prefix(0, row.newNum), r.colors.Green, row.new.text, r.colors.Reset)
+		}
+	}
+}
+
+// wordOps renders merged ops. Without color, changes are marked git-style
+// as [-deleted-] and {+added+}.
+func (r *diffRenderer) wordOps(ops []wordOp) string {
+	plain := r.colors.Reset == ""
+	var sb strings.Builder
+	for _, op := range ops {
+		switch {
+		case op.kind == ' ':
+			sb.WriteString(op.text)
+		case plain && op.kind == '-':
+			sb.WriteString("[-" + op.text + "-]")
+		case plain:
+			sb.WriteString("{+" + op.text + "+}")
+		default:
+			_, highlight := r.lineStyle(op.kind)
+			sb.WriteString(highlight + op.text + r.colors.Reset)
+		}
+	}
+	return sb.String()
+}
+
+func expandTabs(s string) string {
+	return strings.ReplaceAll(s, "\t", "    ")
+}
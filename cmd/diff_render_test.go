@@ -0,0 +1,219 @@
+package cmd
+
+import (
+	"strings"
+	"testing"
+	"unicode/utf8"
+
+	"github.com/bmf-san/ggc/v8/internal/ui"
+)
+
+const sampleDiff = `diff --git a/main.go b/main.go
+index 1111111..2222222 100644
+--- a/main.go
++++ b/main.go
+@@ -1,3 +1,3 @@
+ package main
+-func hello() string { return "hello" }
++func hello() string { return "hi" }
+ // end
+`
+
+func TestParseUnifiedDiff(t *testing.T) {
+	text := sampleDiff + `diff --git a/new.txt b/new.txt
+new file mode 100644
+index 0000000..3333333
+--- /dev/null
++++ b/new.txt
+@@ -0,0 +1,2 @@
++one
++two
+diff --git a/old.txt b/old.txt
+deleted file mode 100644
+--- a/old.txt
++++ /dev/null
+@@ -1 +0,0 @@
+-gone
+\ No newline at end of file
+diff --git a/a.txt b/b.txt
+similarity index 90%
+rename from a.txt
+rename to b.txt
+diff --git a/logo.png b/logo.png
+index 4444444..5555555 100644
+Binary files a/logo.png and b/logo.png differ
+`
+	files := parseUnifiedDiff(text)
+	if len(files) != 5 {
+		t.Fatalf("expected 5 files, got %d", len(files))
+	}
+
+	cases := []struct {
+		status           byte
+		oldPath, newPath string
+		added, deleted   int
+		binary           bool
+	}{
+		{'M', "main.go", "main.go", 1, 1, false},
+		{'A', "new.txt", "new.txt", 2, 0, false},
+		{'D', "old.txt", "old.txt", 0, 1, false},
+		{'R', "a.txt", "b.txt", 0, 0, false},
+		{'M', "logo.png", "logo.png", 0, 0, true},
+	}
+	for i, tc := range cases {
+		f := files[i]
+		if f.status != tc.status || f.oldPath != tc.oldPath || f.newPath != tc.newPath ||
+			f.added != tc.added || f.deleted != tc.deleted || f.binary != tc.binary {
+			t.Errorf("file %d = {%c %s %s +%d -%d binary=%v}, want {%c %s %s +%d -%d binary=%v}", i,
+				f.status, f.oldPath, f.newPath, f.added, f.deleted, f.binary,
+				tc.status, tc.oldPath, tc.newPath, tc.added, tc.deleted, tc.binary)
+		}
+	}
+
+	h := files[0].hunks[0]
+	if h.oldStart != 1 || h.newStart != 1 || len(h.lines) != 4 {
+		t.Fatalf("unexpected hunk %+v", h)
+	}
+}
+
+func TestHunkRows_PairsChangedLines(t *testing.T) {
+	h := &diffHunk{oldStart: 10, newStart: 20, lines: []diffLine{
+		{' ', "ctx"},
+		{'-', "a := 1"},
+		{'-', "b := 2"},
+		{'+', "a := 3"},
+		{' ', "ctx"},
+	}}
+	rows := hunkRows(h)
+	if len(rows) != 4 {
+		t.Fatalf("expected 4 rows, got %d", len(rows))
+	}
+	if rows[1].old.text != "a := 1" || rows[1].new.text != "a := 3" || rows[1].oldNum != 11 || rows[1].newNum != 21 {
+		t.Errorf("unexpected paired row %+v", rows[1])
+	}
+	if rows[1].ops == nil {
+		t.Errorf("expected word ops for similar lines")
+	}
+	if rows[2].old.text != "b := 2" || rows[2].new != nil || rows[2].oldNum != 12 {
+		t.Errorf("unexpected unpaired row %+v", rows[2])
+	}
+	if rows[3].oldNum != 13 || rows[3].newNum != 22 {
+		t.Errorf("context line numbers = %d/%d, want 13/22", rows[3].oldNum, rows[3].newNum)
+	}
+}
+
+func TestWordDiff(t *testing.T) {
+	ops, ok := wordDiff(`return "hello"`, `return "hi"`)
+	if !ok {
+		t.Fatalf("expected similar lines to be diffed")
+	}
+	var old, new strings.Builder
+	for _, op := range ops {
+		if op.kind != '+' {
+			old.WriteString(op.text)
+		}
+		if op.kind != '-' {
+			new.WriteString(op.text)
+		}
+	}
+	if old.String() != `return "hello"` || new.String() != `return "hi"` {
+		t.Errorf("ops do not reproduce the lines: %q / %q", old.String(), new.String())
+	}
+
+	if _, ok := wordDiff("completely different", "nothing shared here"); ok {
+		t.Errorf("expected dissimilar lines not to be diffed")
+	}
+}
+
+func TestDiffRenderer_Views(t *testing.T) {
+	defer ui.SetColor(true)
+	ui.SetColor(false)
+	files := parseUnifiedDiff(sampleDiff)
+
+	side := newDiffRenderer(100, nil).render(files, diffViewSide, false)
+	for _, line := range strings.Split(side, "\n") {
+		if w := ui.DisplayWidth(line); w > 100 {
+			t.Errorf("side line wider than 100 columns (%d): %q", w, line)
+		}
+	}
+	narrow := newDiffRenderer(60, nil).render(files, diffViewSide, false)
+	if !strings.Contains(narrow, "…") {
+		t.Errorf("expected long lines to be cut in narrow columns, got:\n%s", narrow)
+	}
+	if !strings.Contains(side, `2 - func hello() string { return "hello" }`) {
+		t.Errorf("expected old line in left column, got:\n%s", side)
+	}
+	if !strings.Contains(side, `2 + func hello() string { return "hi" }`) {
+		t.Errorf("expected new line in right column, got:\n%s", side)
+	}
+
+	word := newDiffRenderer(80, nil).render(files, diffViewWord, false)
+	if !strings.Contains(word, `~ func hello() string { return "[-hello-]{+hi+}" }`) {
+		t.Errorf("expected marked word changes, got:\n%s", word)
+	}
+
+	list := newDiffRenderer(80, nil).render(files, diffViewSide, true)
+	if strings.Contains(list, "@@") || !strings.Contains(list, "M main.go") {
+		t.Errorf("expected only the file list, got:\n%s", list)
+	}
+}
+
+func TestDiffRenderer_SideAlignsCombiningMarks(t *testing.T) {
+	defer ui.SetColor(true)
+	ui.SetColor(false)
+	diff := "diff --git a/menu.txt b/menu.txt\n--- a/menu.txt\n+++ b/menu.txt\n@@ -1,2 +1,2 @@\n" +
+		" cafe\u0301 noir\n-cafe au lait\n+the\u0301 vert\n"
+	sep := ui.NewSymbols().Separator
+	out := newDiffRenderer(60, nil).render(parseUnifiedDiff(diff), diffViewSide, false)
+	col := -1
+	for _, line := range strings.Split(out, "\n") {
+		i := strings.Index(line, sep)
+		if i < 0 {
+			continue
+		}
+		// Every rune before the separator is one column except the accent.
+		w := utf8.RuneCountInString(strings.ReplaceAll(line[:i], "\u0301", ""))
+		if col >= 0 && w != col {
+			t.Errorf("separator at column %d, want %d:\n%s", w, col, out)
+		}
+		col = w
+	}
+	if col < 0 {
+		t.Fatalf("expected side-by-side rows, got:\n%s", out)
+	}
+}
+
+func TestDiffRenderer_Collapse(t *testing.T) {
+	defer ui.SetColor(true)
+	ui.SetColor(false)
+	files := parseUnifiedDiff(strings.ReplaceAll(sampleDiff, "main.go", "go.sum"))
+
+	out := newDiffRenderer(80, []string{"*.sum"}).render(files, diffViewWord, false)
+	if !strings.Contains(out, "Collapsed (diff.collapse)") || strings.Contains(out, "@@") {
+		t.Errorf("expected go.sum to be collapsed, got:\n%s", out)
+	}
+}
+
+func TestColorUnifiedDiff(t *testing.T) {
+	defer ui.SetColor(true)
+	ui.SetColor(true)
+
+	got := colorUnifiedDiff(sampleDiff)
+	for _, want := range []string{
+		"\033[1mdiff --git a/main.go b/main.go\033[0m\n",
+		"\033[1m+++ b/main.go\033[0m\n",
+		"\033[36m@@ -1,3 +1,3 @@\033[0m\n",
+		"\033[31m-func hello() string { return \"hello\" }\033[0m\n",
+		"\033[32m+func hello() string { return \"hi\" }\033[0m\n",
+		"\n package main\n",
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("colorUnifiedDiff() is missing %q:\n%s", want, got)
+		}
+	}
+
+	ui.SetColor(false)
+	if got := colorUnifiedDiff(sampleDiff); got != sampleDiff {
+		t.Errorf("colorUnifiedDiff() without color = %q, want the diff unchanged", got)
+	}
+}
@@ -10,6 +10,8 @@ import (
 	"testing"
 
 	"github.com/bmf-san/ggc/v8/internal/git"
+	"github.com/bmf-san/ggc/v8/internal/prompt"
+	"github.com/bmf-san/ggc/v8/internal/ui"
 )
 
 // mockDiffClient implements git.DiffReader with argument capture.
@@ -17,12 +19,19 @@ type mockDiffClient struct {
 	diffArgs []string
 	output   string
 	err      error
+	// nameStatus is returned for --name-status queries when set.
+	nameStatus    string
+	defaultBranch string
+	defaultRemote string
 }
 
 func (m *mockDiffClient) Diff() (string, error)       { return "", nil }
 func (m *mockDiffClient) DiffStaged() (string, error) { return "", nil }
 func (m *mockDiffClient) DiffHead() (string, error)   { return "", nil }
 func (m *mockDiffClient) DiffWith(args []string) (string, error) {
+	if m.nameStatus != "" && slices.Contains(args, "--name-status") {
+		return m.nameStatus, nil
+	}
 	m.diffArgs = append([]string(nil), args...)
 	if m.err != nil {
 		return "", m.err
@@ -30,9 +39,18 @@ func (m *mockDiffClient) DiffWith(args []string) (string, error) {
 	return m.output, nil
 }
 
+func (m *mockDiffClient) DefaultBranch(remote string) (string, error) {
+	m.defaultRemote = remote
+	if m.defaultBranch == "" {
+		return "", errors.New("no default branch")
+	}
+	return m.defaultBranch, nil
+}
+
 var _ git.DiffReader = (*mockDiffClient)(nil)
+var _ git.DefaultBranchReader = (*mockDiffClient)(nil)
 
-func newTestDiffer(client git.DiffReader, buf *bytes.Buffer) *Differ {
+func newTestDiffer(client *mockDiffClient, buf *bytes.Buffer) *Differ {
 	helper := NewHelper()
 	helper.outputWriter = buf
 	return &Differ{gitClient: client, outputWriter: buf, helper: helper}
@@ -286,6 +304,237 @@ func TestParseDiffArgs_RepeatedDoubleDash(t *testing.T) {
 	}
 }
 
+func TestParseDiffArgs_View(t *testing.T) {
+	opts, err := parseDiffArgs([]string{"word", "staged", "--collapse"}, func(string) bool { return false })
+	if err != nil {
+		t.Fatalf("parseDiffArgs returned error: %v", err)
+	}
+
+	if !opts.viewSet || opts.view != diffViewWord {
+		t.Fatalf("expected word view, got %v (set=%v)", opts.view, opts.viewSet)
+	}
+	if opts.mode != diffModeStaged || !opts.collapse {
+		t.Fatalf("expected staged mode with --collapse, got %v collapse=%v", opts.mode, opts.collapse)
+	}
+
+	_, err = parseDiffArgs([]string{"side", "word"}, func(string) bool { return false })
+	if err == nil || !strings.Contains(err.Error(), "multiple diff views specified") {
+		t.Fatalf("expected multiple diff views error, got %v", err)
+	}
+}
+
+func TestDiffer_Diff_SideViewRendersColumns(t *testing.T) {
+	defer ui.SetColor(true)
+	ui.SetColor(false)
+
+	buf := &bytes.Buffer{}
+	mockClient := &mockDiffClient{output: sampleDiff}
+	differ := newTestDiffer(mockClient, buf)
+
+	differ.Diff([]string{"side"})
+
+	if want := []string{"--no-color", "HEAD"}; !slices.Equal(mockClient.diffArgs, want) {
+		t.Fatalf("expected git args %v, got %v", want, mockClient.diffArgs)
+	}
+	out := buf.String()
+	if !strings.Contains(out, "1 file changed, +1 -1") {
+		t.Fatalf("expected file list summary, got:\n%s", out)
+	}
+	if strings.Contains(out, "diff --git") {
+		t.Fatalf("expected rendered output instead of raw diff, got:\n%s", out)
+	}
+}
+
+// colorDiffClient returns the output git gives with color.ui=always unless
+// it is asked for --no-color.
+type colorDiffClient struct {
+	mockDiffClient
+}
+
+func (m *colorDiffClient) DiffWith(args []string) (string, error) {
+	m.diffArgs = append([]string(nil), args...)
+	if slices.Contains(args, "--no-color") {
+		return m.output, nil
+	}
+	colored := strings.ReplaceAll(m.output, "diff --git", "\x1b[1mdiff --git")
+	return strings.ReplaceAll(colored, "\n+", "\n\x1b[32m+"), nil
+}
+
+func TestDiffer_Diff_ParsedViewsIgnoreColorUI(t *testing.T) {
+	defer ui.SetColor(true)
+	ui.SetColor(false)
+
+	for _, args := range [][]string{{"side"}, {"word"}, {"unified", "--collapse"}} {
+		buf := &bytes.Buffer{}
+		client := &colorDiffClient{mockDiffClient{output: sampleDiff}}
+		differ := &Differ{gitClient: client, outputWriter: buf, helper: NewHelper()}
+
+		differ.Diff(args)
+
+		if out := buf.String(); !strings.Contains(out, "1 file changed, +1 -1") {
+			t.Errorf("Diff(%v) did not parse the diff, got:\n%s", args, out)
+		}
+	}
+
+	buf := &bytes.Buffer{}
+	client := &colorDiffClient{mockDiffClient{output: sampleDiff}}
+	differ := &Differ{gitClient: client, outputWriter: buf, helper: NewHelper()}
+	differ.Diff([]string{"unified"})
+	if slices.Contains(client.diffArgs, "--no-color") {
+		t.Errorf("unified view should keep git's colors, got args %v", client.diffArgs)
+	}
+}
+
+func TestDiffer_Diff_ConfiguredViewAndUnifiedOverride(t *testing.T) {
+	buf := &bytes.Buffer{}
+	mockClient := &mockDiffClient{output: sampleDiff}
+	differ := newTestDiffer(mockClient, buf)
+	differ.view = diffViewWord
+
+	differ.Diff([]string{"unified"})
+	if buf.String() != sampleDiff {
+		t.Fatalf("expected raw diff with unified override, got:\n%s", buf.String())
+	}
+
+	buf.Reset()
+	differ.Diff([]string{"--stat"})
+	if buf.String() != sampleDiff {
+		t.Fatalf("expected raw output for --stat, got:\n%s", buf.String())
+	}
+
+	buf.Reset()
+	differ.Diff(nil)
+	if buf.String() == sampleDiff {
+		t.Fatalf("expected diff.view to select the word view")
+	}
+}
+
+func TestDiffer_Diff_BranchUsesDefaultBranch(t *testing.T) {
+	buf := &bytes.Buffer{}
+	mockClient := &mockDiffClient{defaultBranch: "origin/main"}
+	differ := newTestDiffer(mockClient, buf)
+	differ.defaultRemote = "upstream"
+
+	differ.Diff([]string{"branch", "--stat"})
+
+	if mockClient.defaultRemote != "upstream" {
+		t.Fatalf("expected default branch of remote upstream, got %q", mockClient.defaultRemote)
+	}
+	if want := []string{"--stat", "origin/main...HEAD"}; !slices.Equal(mockClient.diffArgs, want) {
+		t.Fatalf("expected git args %v, got %v", want, mockClient.diffArgs)
+	}
+}
+
+func TestDiffer_Diff_BranchWithBase(t *testing.T) {
+	buf := &bytes.Buffer{}
+	mockClient := &mockDiffClient{}
+	differ := newTestDiffer(mockClient, buf)
+
+	differ.Diff([]string{"branch", "develop"})
+
+	if want := []string{"develop...HEAD"}; !slices.Equal(mockClient.diffArgs, want) {
+		t.Fatalf("expected git args %v, got %v", want, mockClient.diffArgs)
+	}
+	if mockClient.defaultRemote != "" {
+		t.Fatalf("did not expect a default branch lookup with an explicit base")
+	}
+}
+
+func TestDiffer_Diff_BranchWithoutDefaultBranch(t *testing.T) {
+	buf := &bytes.Buffer{}
+	mockClient := &mockDiffClient{}
+	differ := newTestDiffer(mockClient, buf)
+
+	differ.Diff([]string{"branch"})
+
+	if mockClient.diffArgs != nil {
+		t.Fatalf("expected no diff to run, got %v", mockClient.diffArgs)
+	}
+	if !strings.Contains(buf.String(), "no default branch") {
+		t.Fatalf("expected default branch error, got %q", buf.String())
+	}
+}
+
+func TestDiffer_Diff_CommitRangeSyntax(t *testing.T) {
+	buf := &bytes.Buffer{}
+	mockClient := &mockDiffClient{}
+	differ := newTestDiffer(mockClient, buf)
+
+	differ.Diff([]string{"main..feature", "--name-only"})
+
+	if want := []string{"--name-only", "main..feature"}; !slices.Equal(mockClient.diffArgs, want) {
+		t.Fatalf("expected git args %v, got %v", want, mockClient.diffArgs)
+	}
+}
+
+func TestParseDiffArgs_BranchAndRangeErrors(t *testing.T) {
+	cases := []struct {
+		args []string
+		want string
+	}{
+		{[]string{"branch", "a", "b"}, "at most one base branch"},
+		{[]string{"branch", "a..b"}, "not a range"},
+		{[]string{"a..b", "c"}, "cannot be combined with another commit"},
+		{[]string{"--pick", "--stat"}, "--pick cannot be combined"},
+		{[]string{"staged", "branch"}, "multiple diff modes specified"},
+	}
+	for _, tc := range cases {
+		_, err := parseDiffArgs(tc.args, func(string) bool { return false })
+		if err == nil || !strings.Contains(err.Error(), tc.want) {
+			t.Errorf("parseDiffArgs(%v) error = %v, want %q", tc.args, err, tc.want)
+		}
+	}
+}
+
+func TestDiffer_Diff_PickFile(t *testing.T) {
+	buf := &bytes.Buffer{}
+	mockClient := &mockDiffClient{
+		defaultBranch: "main",
+		nameStatus:    "M\tcmd/diff.go\nR090\told.go\tnew.go\n",
+		output:        "DIFF",
+	}
+	differ := newTestDiffer(mockClient, buf)
+	differ.prompter = prompt.New(strings.NewReader("2\n"), buf)
+
+	differ.Diff([]string{"branch", "--pick"})
+
+	out := buf.String()
+	if !strings.Contains(out, "[1] M cmd/diff.go") || !strings.Contains(out, "[2] R old.go") {
+		t.Fatalf("expected changed files to be listed, got %q", out)
+	}
+	if want := []string{"main...HEAD", "--", "old.go", "new.go"}; !slices.Equal(mockClient.diffArgs, want) {
+		t.Fatalf("expected git args %v, got %v", want, mockClient.diffArgs)
+	}
+	if !strings.HasSuffix(out, "DIFF") {
+		t.Fatalf("expected the picked diff to be written, got %q", out)
+	}
+}
+
+func TestParseDiffArgs_PickWord(t *testing.T) {
+	for _, args := range [][]string{{"pick"}, {"branch", "develop", "pick"}, {"staged", "--pick"}} {
+		opts, err := parseDiffArgs(args, func(string) bool { return false })
+		if err != nil || !opts.pick {
+			t.Errorf("parseDiffArgs(%v) = %+v, %v; want pick", args, opts, err)
+		}
+	}
+}
+
+func TestDiffer_Diff_PickWithoutChanges(t *testing.T) {
+	buf := &bytes.Buffer{}
+	mockClient := &mockDiffClient{}
+	differ := newTestDiffer(mockClient, buf)
+	differ.prompter = prompt.New(strings.NewReader("1\n"), buf)
+
+	differ.Diff([]string{"--pick"})
+
+	if want := []string{"--no-color", "--name-status", "HEAD"}; !slices.Equal(mockClient.diffArgs, want) {
+		t.Fatalf("expected name-status query %v, got %v", want, mockClient.diffArgs)
+	}
+	if !strings.Contains(buf.String(), "No changes.") {
+		t.Fatalf("expected no changes message, got %q", buf.String())
+	}
+}
+
 func TestDiffMode_String(t *testing.T) {
 	cases := []struct {
 		mode diffMode
@@ -295,6 +544,7 @@ func TestDiffMode_String(t *testing.T) {
 		{diffModeUnstaged, "unstaged"},
 		{diffModeStaged, "staged"},
 		{diffModeHead, "head"},
+		{diffModeBranch, "branch"},
 	}
 
 	for _, tc := range cases {
@@ -14,6 +14,12 @@ import (
 // or placeholder processing encounters an error; interactive mode and regular commands
 // do not cause Execute to return an error.
 func (c *Cmd) Execute(args []string) error {
+	// --no-color is a global option and must precede the command.
+	for len(args) > 0 && args[0] == "--no-color" {
+		c.applyColor(false)
+		args = args[1:]
+	}
+
 	if len(args) == 0 {
 		c.Interactive()
 		return nil
@@ -131,3 +137,38 @@ func (c *Cmd) processPlaceholders(alias *config.ParsedAlias, args []string, alia
 
 	return processedCommands, nil
 }
+
+// needsTerminal reports whether args run a command that prompts for input or
+// opens an editor. Aliases are checked against the commands they expand to.
+func (c *Cmd) needsTerminal(args []string) bool {
+	if len(args) == 0 || c.registry == nil {
+		return false
+	}
+	if c.configManager == nil || !c.configManager.GetConfig().IsAlias(args[0]) {
+		sub, ok := c.registry.MatchSubcommand(args)
+		return ok && sub.NeedsTerminal
+	}
+
+	alias, err := c.configManager.GetConfig().ParseAlias(args[0])
+	if err != nil {
+		return false
+	}
+	commands, err := c.processPlaceholders(alias, args[1:], args[0])
+	if err != nil {
+		return false
+	}
+	for _, command := range commands {
+		tokens := tokenize(command)
+		if len(tokens) == 0 {
+			continue
+		}
+		if alias.Type == config.SimpleAlias && len(alias.Placeholders) == 0 {
+			// Mirrors executeSimpleAlias, which forwards user arguments.
+			tokens = append([]string{tokens[0]}, args[1:]...)
+		}
+		if sub, ok := c.registry.MatchSubcommand(tokens); ok && sub.NeedsTerminal {
+			return true
+		}
+	}
+	return false
+}
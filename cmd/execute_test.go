@@ -6,6 +6,7 @@ import (
 
 	"github.com/bmf-san/ggc/v8/internal/config"
 	"github.com/bmf-san/ggc/v8/internal/testutil"
+	uiutil "github.com/bmf-san/ggc/v8/internal/ui"
 )
 
 func TestExecute_BasicCommands(t *testing.T) {
@@ -329,3 +330,80 @@ func TestExecute_InvalidAliasFormat(t *testing.T) {
 		t.Fatal("invalid alias format should return error")
 	}
 }
+
+func TestNeedsTerminal(t *testing.T) {
+	mockClient := testutil.NewMockGitClient()
+	configManager := config.NewConfigManager(mockClient)
+	_ = configManager.LoadConfig()
+	configManager.GetConfig().Aliases = map[string]interface{}{
+		"br":   "branch",
+		"fix":  "commit fixup {0}",
+		"sync": []interface{}{"fetch prune", "status"},
+	}
+	c := NewCmd(mockClient, configManager)
+
+	cases := []struct {
+		args []string
+		want bool
+	}{
+		{args: []string{"status"}, want: false},
+		{args: []string{"commit", "amend"}, want: true},
+		{args: []string{"commit", "amend", "no-edit"}, want: false},
+		{args: []string{"branch", "delete"}, want: true},
+		{args: []string{"branch", "delete", "feature"}, want: false},
+		{args: []string{"branch", "rename"}, want: true},
+		{args: []string{"branch", "rename", "old", "new"}, want: false},
+		{args: []string{"diff", "pick"}, want: true},
+		{args: []string{"diff", "branch", "pick"}, want: true},
+		{args: []string{"diff", "branch", "develop", "pick"}, want: true},
+		{args: []string{"diff", "branch", "develop"}, want: false},
+		{args: []string{"br", "checkout"}, want: true},
+		{args: []string{"fix", "abc123"}, want: true},
+		{args: []string{"sync"}, want: false},
+	}
+	for _, tc := range cases {
+		if got := c.needsTerminal(tc.args); got != tc.want {
+			t.Errorf("needsTerminal(%v) = %v, want %v", tc.args, got, tc.want)
+		}
+	}
+}
+
+func TestExecute_NoColorFlag(t *testing.T) {
+	mockClient := testutil.NewMockGitClient()
+	cm := config.NewConfigManager(mockClient)
+	_ = cm.LoadConfig()
+	c := NewCmd(mockClient, cm)
+	c.applyColor(true)
+	defer uiutil.SetColor(true)
+
+	if err := c.Execute([]string{"--no-color", "version"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if uiutil.NewANSIColors().Reset != "" {
+		t.Error("--no-color should disable color")
+	}
+}
+
+func TestResolveColorMode(t *testing.T) {
+	mockClient := testutil.NewMockGitClient()
+	cm := config.NewConfigManager(mockClient)
+	env := map[string]string{}
+	getenv := func(k string) string { return env[k] }
+
+	if got := resolveColorMode(cm, getenv); got != uiutil.ColorAuto {
+		t.Errorf("default = %v, want auto", got)
+	}
+	cm.GetConfig().UI.Color = "false"
+	if got := resolveColorMode(cm, getenv); got != uiutil.ColorNever {
+		t.Errorf("ui.color=false = %v, want never", got)
+	}
+	cm.GetConfig().UI.Color = "always"
+	if got := resolveColorMode(cm, getenv); got != uiutil.ColorAlways {
+		t.Errorf("ui.color=always = %v, want always", got)
+	}
+	cm.GetConfig().UI.Color = "never"
+	env["GGC_COLOR"] = "always"
+	if got := resolveColorMode(cm, getenv); got != uiutil.ColorAlways {
+		t.Errorf("GGC_COLOR=always = %v, want always", got)
+	}
+}
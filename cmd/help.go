@@ -261,6 +261,51 @@ func (h *Helper) ShowRebaseHelp() {
 	h.renderCommandFromRegistry("rebase", []string{"ggc rebase [interactive | <upstream> | continue | abort | skip]"}, "Rebase current branch onto another branch; supports interactive and common workflows")
 }
 
+// ShowMergeHelp shows help message for merge command.
+func (h *Helper) ShowMergeHelp() {
+	h.renderCommandFromRegistry("merge", []string{"ggc merge [no-ff | squash | ff-only] [<branch>]", "ggc merge [continue | abort]"}, "Merge another branch into the current branch")
+}
+
+// ShowCherryPickHelp shows help message for cherry-pick command.
+func (h *Helper) ShowCherryPickHelp() {
+	h.renderCommandFromRegistry("cherry-pick", nil, "Apply commits from another branch to the current branch")
+}
+
+// ShowRevertHelp shows help message for revert command.
+func (h *Helper) ShowRevertHelp() {
+	h.renderCommandFromRegistry("revert", nil, "Create commits that undo earlier commits")
+}
+
+// ShowBisectHelp shows help message for bisect command.
+func (h *Helper) ShowBisectHelp() {
+	h.renderCommandFromRegistry("bisect", nil, "Find the commit that introduced a bug")
+}
+
+// ShowBlameHelp shows help message for blame command.
+func (h *Helper) ShowBlameHelp() {
+	h.renderCommandFromRegistry("blame", nil, "Show who last changed each line of a file")
+}
+
+// ShowWorktreeHelp shows help message for worktree command.
+func (h *Helper) ShowWorktreeHelp() {
+	h.renderCommandFromRegistry("worktree", nil, "Check out branches in additional working trees")
+}
+
+// ShowShowHelp shows help message for show command.
+func (h *Helper) ShowShowHelp() {
+	h.renderCommandFromRegistry("show", nil, "Show a commit with its details and changes")
+}
+
+// ShowSubmoduleHelp shows help message for submodule command.
+func (h *Helper) ShowSubmoduleHelp() {
+	h.renderCommandFromRegistry("submodule", nil, "Manage submodules")
+}
+
+// ShowConflictsHelp shows help message for conflicts command.
+func (h *Helper) ShowConflictsHelp() {
+	h.renderCommandFromRegistry("conflicts", nil, "Resolve conflicts of a stopped merge, rebase, cherry-pick or revert")
+}
+
 // ShowResetHelp shows help message for reset command.
 func (h *Helper) ShowResetHelp() {
 	h.renderCommandFromRegistry("reset", nil, "Reset and clean")
@@ -294,7 +339,10 @@ func (h *Helper) ShowDeleteMergedBranchHelp() {
 func (h *Helper) ShowDiffHelp() {
 	h.renderCommandFromRegistry(
 		"diff",
-		[]string{"ggc diff [staged|unstaged|head] [options] [<commit> [<commit>]] [--] [<path>...]"},
+		[]string{
+			"ggc diff [staged|unstaged|head] [side|word|unified] [options] [<commit> [<commit>]|<a>..<b>] [--] [<path>...]",
+			"ggc diff branch [<base>] [side|word|unified] [options] [--] [<path>...]",
+		},
 		"Show changes between commits, the index, and the working tree",
 	)
 }
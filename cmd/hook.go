@@ -10,6 +10,7 @@ import (
 
 	"github.com/bmf-san/ggc/v8/internal/config"
 	"github.com/bmf-san/ggc/v8/internal/git"
+	"github.com/bmf-san/ggc/v8/internal/ui"
 )
 
 // Hooker handles git hook operations.
@@ -17,11 +18,17 @@ type Hooker struct {
 	outputWriter io.Writer
 	helper       *Helper
 	execCommand  func(string, ...string) *exec.Cmd
-	gitClient    git.ConfigOps
+	gitClient    interface {
+		git.ConfigOps
+		git.GitPathResolver
+	}
 }
 
 // NewHooker creates a new Hooker instance.
-func NewHooker(client git.ConfigOps) *Hooker {
+func NewHooker(client interface {
+	git.ConfigOps
+	git.GitPathResolver
+}) *Hooker {
 	return &Hooker{
 		outputWriter: os.Stdout,
 		helper:       NewHelper(),
@@ -63,9 +70,21 @@ func (h *Hooker) withName(f func(string)) func([]string) {
 	}
 }
 
+// hooksDir returns the hooks directory of the current repository. It asks
+// git so that linked worktrees, whose .git is a file, and core.hooksPath
+// work; without a git client it falls back to .git/hooks.
+func (h *Hooker) hooksDir() string {
+	if h.gitClient != nil {
+		if dir, err := h.gitClient.GitPath("hooks"); err == nil && dir != "" {
+			return dir
+		}
+	}
+	return filepath.Join(".git", "hooks")
+}
+
 // listHooks shows all available hooks and their status.
 func (h *Hooker) listHooks() {
-	hooksDir := filepath.Join(".git", "hooks")
+	hooksDir := h.hooksDir()
 
 	// Check if hooks directory exists
 	if _, err := os.Stat(hooksDir); os.IsNotExist(err) {
@@ -92,9 +111,9 @@ func (h *Hooker) listHooks() {
 		if _, err := os.Stat(hookPath); err == nil {
 			// Check if it's executable
 			if info, err := os.Stat(hookPath); err == nil && info.Mode()&0111 != 0 {
-				_, _ = fmt.Fprintf(h.outputWriter, "✓ %s (enabled)\n", hook)
+				_, _ = fmt.Fprintf(h.outputWriter, "%s %s (enabled)\n", ui.NewSymbols().Enabled, hook)
 			} else {
-				_, _ = fmt.Fprintf(h.outputWriter, "✗ %s (disabled)\n", hook)
+				_, _ = fmt.Fprintf(h.outputWriter, "%s %s (disabled)\n", ui.NewSymbols().Disabled, hook)
 			}
 		} else if _, err := os.Stat(samplePath); err == nil {
 			_, _ = fmt.Fprintf(h.outputWriter, "- %s (sample available)\n", hook)
@@ -106,7 +125,7 @@ func (h *Hooker) listHooks() {
 
 // installHook creates a new hook from sample or creates a basic template.
 func (h *Hooker) installHook(hookName string) {
-	hooksDir := filepath.Join(".git", "hooks")
+	hooksDir := h.hooksDir()
 	hookPath := filepath.Join(hooksDir, hookName)
 	samplePath := filepath.Join(hooksDir, hookName+".sample")
 
@@ -136,7 +155,7 @@ func (h *Hooker) installHook(hookName string) {
 
 // uninstallHook removes a hook.
 func (h *Hooker) uninstallHook(hookName string) {
-	hookPath := filepath.Join(".git", "hooks", hookName)
+	hookPath := filepath.Join(h.hooksDir(), hookName)
 
 	if _, err := os.Stat(hookPath); os.IsNotExist(err) {
 		_, _ = fmt.Fprintf(h.outputWriter, "Hook '%s' is not installed\n", hookName)
@@ -153,7 +172,7 @@ func (h *Hooker) uninstallHook(hookName string) {
 
 // enableHook makes a hook executable.
 func (h *Hooker) enableHook(hookName string) {
-	hookPath := filepath.Join(".git", "hooks", hookName)
+	hookPath := filepath.Join(h.hooksDir(), hookName)
 
 	if _, err := os.Stat(hookPath); os.IsNotExist(err) {
 		_, _ = fmt.Fprintf(h.outputWriter, "Hook '%s' is not installed\n", hookName)
@@ -170,7 +189,7 @@ func (h *Hooker) enableHook(hookName string) {
 
 // disableHook makes a hook non-executable.
 func (h *Hooker) disableHook(hookName string) {
-	hookPath := filepath.Join(".git", "hooks", hookName)
+	hookPath := filepath.Join(h.hooksDir(), hookName)
 
 	if _, err := os.Stat(hookPath); os.IsNotExist(err) {
 		_, _ = fmt.Fprintf(h.outputWriter, "Hook '%s' is not installed\n", hookName)
@@ -187,7 +206,7 @@ func (h *Hooker) disableHook(hookName string) {
 
 // editHook opens a hook in the default editor.
 func (h *Hooker) editHook(hookName string) {
-	hookPath := filepath.Join(".git", "hooks", hookName)
+	hookPath := filepath.Join(h.hooksDir(), hookName)
 
 	if _, err := os.Stat(hookPath); os.IsNotExist(err) {
 		_, _ = fmt.Fprintf(h.outputWriter, "Hook '%s' is not installed\n", hookName)
@@ -577,3 +577,40 @@ func TestHooker_withName_CallsHandler(t *testing.T) {
 	// Hook not found is expected output; just verify no panic
 	_ = buf.String()
 }
+
+// mockHookGitClient resolves the hooks directory the way git does in a
+// linked worktree, where .git is a file.
+type mockHookGitClient struct {
+	mockGitClient
+	hooksDir string
+}
+
+func (m *mockHookGitClient) GitPath(string) (string, error) {
+	return m.hooksDir, nil
+}
+
+func TestHooker_UsesGitHooksPath(t *testing.T) {
+	hooksDir := filepath.Join(t.TempDir(), "common", "hooks")
+	if err := os.MkdirAll(hooksDir, 0755); err != nil {
+		t.Fatalf("failed to create hooks dir: %v", err)
+	}
+
+	var buf bytes.Buffer
+	h := &Hooker{
+		outputWriter: &buf,
+		helper:       NewHelper(),
+		execCommand:  exec.Command,
+		gitClient:    &mockHookGitClient{hooksDir: hooksDir},
+	}
+	h.Hook([]string{"install", "pre-commit"})
+
+	if _, err := os.Stat(filepath.Join(hooksDir, "pre-commit")); err != nil {
+		t.Fatalf("expected the hook in the git hooks directory: %v; output %q", err, buf.String())
+	}
+
+	buf.Reset()
+	h.listHooks()
+	if !strings.Contains(buf.String(), "pre-commit (enabled)") {
+		t.Errorf("expected pre-commit to be listed as enabled, got %q", buf.String())
+	}
+}
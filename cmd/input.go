@@ -2,7 +2,9 @@
 package cmd
 
 import (
+	"errors"
 	"io"
+	"slices"
 
 	"github.com/bmf-san/ggc/v8/internal/prompt"
 )
@@ -28,3 +30,43 @@ func ReadLine(p prompt.Prompter, w io.Writer, promptText string) (string, bool)
 	}
 	return line, true
 }
+
+// localBranchLister lists local branches and names the current one.
+type localBranchLister interface {
+	GetCurrentBranch() (string, error)
+	ListLocalBranches() ([]string, error)
+}
+
+// selectOtherBranch asks which local branch other than the current one to
+// use; action completes messages such as "the branch to merge".
+func selectOtherBranch(client localBranchLister, p prompt.Prompter, w io.Writer, action string) (string, bool) {
+	branches, err := client.ListLocalBranches()
+	if err != nil {
+		WriteError(w, err)
+		return "", false
+	}
+	if current, err := client.GetCurrentBranch(); err == nil {
+		branches = slices.DeleteFunc(branches, func(b string) bool { return b == current })
+	}
+	if len(branches) == 0 {
+		WriteLinef(w, "No other local branches to %s.", action)
+		return "", false
+	}
+	if p == nil {
+		return "", false
+	}
+
+	idx, canceled, err := p.Select("Local branches:", branches, "Enter the number of the branch to "+action+": ")
+	if canceled {
+		return "", false
+	}
+	if err != nil {
+		if errors.Is(err, prompt.ErrInvalidSelection) {
+			WriteLine(w, "Invalid number.")
+		} else {
+			WriteError(w, err)
+		}
+		return "", false
+	}
+	return branches[idx], true
+}
@@ -0,0 +1,104 @@
+package cmd
+
+import (
+	"io"
+	"os"
+
+	"github.com/bmf-san/ggc/v8/internal/git"
+	"github.com/bmf-san/ggc/v8/internal/prompt"
+)
+
+// Merger handles merge operations.
+type Merger struct {
+	gitClient    git.MergeOps
+	outputWriter io.Writer
+	helper       *Helper
+	prompter     prompt.Prompter
+}
+
+// NewMerger creates a new Merger instance.
+func NewMerger(client git.MergeOps) *Merger {
+	output := os.Stdout
+	helper := NewHelper()
+	helper.outputWriter = output
+	return &Merger{
+		gitClient:    client,
+		outputWriter: output,
+		helper:       helper,
+		prompter:     prompt.New(os.Stdin, output),
+	}
+}
+
+// Merge executes git merge commands. Without a branch it asks which local
+// branch to merge.
+func (m *Merger) Merge(args []string) {
+	if len(args) == 0 {
+		m.mergeBranch(m.gitClient.Merge, "", "Merge successful")
+		return
+	}
+
+	switch args[0] {
+	case "abort":
+		m.handleMergeAbort()
+	case "continue":
+		m.handleMergeContinue()
+	case "no-ff":
+		m.mergeBranch(m.gitClient.MergeNoFF, optionalArg(args[1:]), "Merge successful")
+	case "ff-only":
+		m.mergeBranch(m.gitClient.MergeFFOnly, optionalArg(args[1:]), "Fast-forward successful")
+	case "squash":
+		m.mergeBranch(m.gitClient.MergeSquash, optionalArg(args[1:]),
+			"Squashed changes are staged; run 'ggc commit <message>' to record them")
+	default:
+		if len(args) > 1 {
+			m.helper.ShowMergeHelp()
+			return
+		}
+		m.mergeBranch(m.gitClient.Merge, args[0], "Merge successful")
+	}
+}
+
+func optionalArg(args []string) string {
+	if len(args) == 0 {
+		return ""
+	}
+	return args[0]
+}
+
+func (m *Merger) mergeBranch(merge func(string) error, branch, success string) {
+	if branch == "" {
+		var ok bool
+		if branch, ok = selectOtherBranch(m.gitClient, m.prompter, m.outputWriter, "merge"); !ok {
+			return
+		}
+	} else if !m.gitClient.RevParseVerify(branch) {
+		WriteErrorf(m.outputWriter, "unknown ref '%s'", branch)
+		return
+	}
+
+	if err := merge(branch); err != nil {
+		WriteError(m.outputWriter, err)
+		// MERGE_HEAD exists while a merge stopped on conflicts.
+		if m.gitClient.RevParseVerify("MERGE_HEAD") {
+			WriteLine(m.outputWriter, "Resolve the conflicts and run 'ggc merge continue', or run 'ggc merge abort'.")
+		}
+		return
+	}
+	WriteLine(m.outputWriter, success)
+}
+
+func (m *Merger) handleMergeAbort() {
+	if err := m.gitClient.MergeAbort(); err != nil {
+		WriteError(m.outputWriter, err)
+		return
+	}
+	WriteLine(m.outputWriter, "Merge aborted")
+}
+
+func (m *Merger) handleMergeContinue() {
+	if err := m.gitClient.MergeContinue(); err != nil {
+		WriteError(m.outputWriter, err)
+		return
+	}
+	WriteLine(m.outputWriter, "Merge successful")
+}
@@ -0,0 +1,191 @@
+package cmd
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/bmf-san/ggc/v8/internal/git"
+	"github.com/bmf-san/ggc/v8/internal/prompt"
+)
+
+// mockMergeClient implements git.MergeOps and records the merge performed.
+type mockMergeClient struct {
+	calls      []string
+	branches   []string
+	current    string
+	validRefs  map[string]bool
+	mergeErr   error
+	mergeHead  bool
+	abortErr   error
+	continueOK bool
+}
+
+func (m *mockMergeClient) record(op, branch string) error {
+	m.calls = append(m.calls, strings.TrimSpace(op+" "+branch))
+	return m.mergeErr
+}
+
+func (m *mockMergeClient) Merge(b string) error       { return m.record("merge", b) }
+func (m *mockMergeClient) MergeNoFF(b string) error   { return m.record("no-ff", b) }
+func (m *mockMergeClient) MergeSquash(b string) error { return m.record("squash", b) }
+func (m *mockMergeClient) MergeFFOnly(b string) error { return m.record("ff-only", b) }
+func (m *mockMergeClient) MergeAbort() error {
+	m.calls = append(m.calls, "abort")
+	return m.abortErr
+}
+func (m *mockMergeClient) MergeContinue() error {
+	m.calls = append(m.calls, "continue")
+	if !m.continueOK {
+		return errors.New("continue failed")
+	}
+	return nil
+}
+func (m *mockMergeClient) GetCurrentBranch() (string, error)    { return m.current, nil }
+func (m *mockMergeClient) ListLocalBranches() ([]string, error) { return m.branches, nil }
+func (m *mockMergeClient) RevParseVerify(ref string) bool {
+	if ref == "MERGE_HEAD" {
+		return m.mergeHead
+	}
+	return m.validRefs[ref]
+}
+
+var _ git.MergeOps = (*mockMergeClient)(nil)
+
+func newTestMerger(client *mockMergeClient, buf *bytes.Buffer, input string) *Merger {
+	helper := NewHelper()
+	helper.outputWriter = buf
+	return &Merger{
+		gitClient:    client,
+		outputWriter: buf,
+		helper:       helper,
+		prompter:     prompt.New(strings.NewReader(input), buf),
+	}
+}
+
+func TestMerger_Merge_Modes(t *testing.T) {
+	tests := []struct {
+		args     []string
+		wantCall string
+		wantOut  string
+	}{
+		{[]string{"feature"}, "merge feature", "Merge successful"},
+		{[]string{"no-ff", "feature"}, "no-ff feature", "Merge successful"},
+		{[]string{"ff-only", "feature"}, "ff-only feature", "Fast-forward successful"},
+		{[]string{"squash", "feature"}, "squash feature", "run 'ggc commit <message>'"},
+	}
+
+	for _, tt := range tests {
+		t.Run(strings.Join(tt.args, " "), func(t *testing.T) {
+			var buf bytes.Buffer
+			client := &mockMergeClient{validRefs: map[string]bool{"feature": true}}
+			newTestMerger(client, &buf, "").Merge(tt.args)
+
+			if len(client.calls) != 1 || client.calls[0] != tt.wantCall {
+				t.Fatalf("calls = %v, want [%s]", client.calls, tt.wantCall)
+			}
+			if !strings.Contains(buf.String(), tt.wantOut) {
+				t.Errorf("output = %q, want it to contain %q", buf.String(), tt.wantOut)
+			}
+		})
+	}
+}
+
+func TestMerger_Merge_PicksBranch(t *testing.T) {
+	var buf bytes.Buffer
+	client := &mockMergeClient{current: "main", branches: []string{"main", "feature", "fix"}}
+	newTestMerger(client, &buf, "2\n").Merge([]string{"no-ff"})
+
+	out := buf.String()
+	if strings.Contains(out, "] main") {
+		t.Errorf("current branch should not be offered, got %q", out)
+	}
+	if len(client.calls) != 1 || client.calls[0] != "no-ff fix" {
+		t.Fatalf("calls = %v, want [no-ff fix]", client.calls)
+	}
+}
+
+func TestMerger_Merge_NoOtherBranches(t *testing.T) {
+	var buf bytes.Buffer
+	client := &mockMergeClient{current: "main", branches: []string{"main"}}
+	newTestMerger(client, &buf, "").Merge(nil)
+
+	if len(client.calls) != 0 {
+		t.Fatalf("expected no merge, got %v", client.calls)
+	}
+	if !strings.Contains(buf.String(), "No other local branches to merge.") {
+		t.Errorf("unexpected output %q", buf.String())
+	}
+}
+
+func TestMerger_Merge_UnknownRef(t *testing.T) {
+	var buf bytes.Buffer
+	client := &mockMergeClient{}
+	newTestMerger(client, &buf, "").Merge([]string{"nope"})
+
+	if len(client.calls) != 0 {
+		t.Fatalf("expected no merge, got %v", client.calls)
+	}
+	if !strings.Contains(buf.String(), "unknown ref 'nope'") {
+		t.Errorf("unexpected output %q", buf.String())
+	}
+}
+
+func TestMerger_Merge_ConflictHint(t *testing.T) {
+	var buf bytes.Buffer
+	client := &mockMergeClient{
+		validRefs: map[string]bool{"feature": true},
+		mergeErr:  errors.New("merge failed"),
+		mergeHead: true,
+	}
+	newTestMerger(client, &buf, "").Merge([]string{"feature"})
+
+	out := buf.String()
+	if !strings.Contains(out, "merge failed") || !strings.Contains(out, "ggc merge continue") {
+		t.Errorf("expected error with conflict hint, got %q", out)
+	}
+
+	buf.Reset()
+	client.mergeHead = false
+	newTestMerger(client, &buf, "").Merge([]string{"ff-only", "feature"})
+	if strings.Contains(buf.String(), "ggc merge continue") {
+		t.Errorf("did not expect a conflict hint without MERGE_HEAD, got %q", buf.String())
+	}
+}
+
+func TestMerger_Merge_AbortAndContinue(t *testing.T) {
+	var buf bytes.Buffer
+	client := &mockMergeClient{continueOK: true}
+	m := newTestMerger(client, &buf, "")
+
+	m.Merge([]string{"abort"})
+	m.Merge([]string{"continue"})
+
+	if strings.Join(client.calls, ",") != "abort,continue" {
+		t.Fatalf("calls = %v", client.calls)
+	}
+	if !strings.Contains(buf.String(), "Merge aborted") || !strings.Contains(buf.String(), "Merge successful") {
+		t.Errorf("unexpected output %q", buf.String())
+	}
+
+	buf.Reset()
+	client.abortErr = errors.New("no merge in progress")
+	m.Merge([]string{"abort"})
+	if !strings.Contains(buf.String(), "no merge in progress") || strings.Contains(buf.String(), "Merge aborted") {
+		t.Errorf("unexpected output %q", buf.String())
+	}
+}
+
+func TestMerger_Merge_TooManyArgsShowsHelp(t *testing.T) {
+	var buf bytes.Buffer
+	client := &mockMergeClient{}
+	newTestMerger(client, &buf, "").Merge([]string{"a", "b"})
+
+	if len(client.calls) != 0 {
+		t.Fatalf("expected no merge, got %v", client.calls)
+	}
+	if !strings.Contains(buf.String(), "ggc merge") {
+		t.Errorf("expected merge help, got %q", buf.String())
+	}
+}
@@ -4,6 +4,8 @@ package cmd
 import (
 	"fmt"
 	"io"
+
+	"github.com/bmf-san/ggc/v8/internal/ui"
 )
 
 // WriteError writes an error message to the writer
@@ -25,3 +27,14 @@ func WriteLine(w io.Writer, msg string) {
 func WriteLinef(w io.Writer, format string, args ...any) {
 	_, _ = fmt.Fprintf(w, format+"\n", args...)
 }
+
+// writeNumberedSelection writes a heading and a numbered list for commands
+// that ask the user to pick entries by number, followed by the input prompt.
+func writeNumberedSelection(w io.Writer, heading string, items []string) {
+	colors := ui.NewANSIColors()
+	WriteLinef(w, "%s%s%s", colors.Bold+colors.Cyan, heading, colors.Reset)
+	for i, item := range items {
+		WriteLinef(w, "  [%s%d%s] %s", colors.Bold+colors.Yellow, i+1, colors.Reset, item)
+	}
+	_, _ = fmt.Fprint(w, "> ")
+}
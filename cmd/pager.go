@@ -0,0 +1,67 @@
+package cmd
+
+import (
+	"io"
+	"os"
+	"slices"
+
+	"golang.org/x/term"
+
+	"github.com/bmf-san/ggc/v8/internal/config"
+	"github.com/bmf-san/ggc/v8/internal/git"
+	"github.com/bmf-san/ggc/v8/internal/ui"
+)
+
+// pager pipes command output through the user's pager when it is written
+// to a terminal. A nil pager writes output directly.
+type pager struct {
+	enabled   bool
+	noPager   []string
+	corePager func() string
+	getenv    func(string) string
+}
+
+// newPager builds the pager from ui.pager and ui.no-pager. core.pager is
+// read only when output is actually paged.
+func newPager(cm *config.Manager, client git.ConfigOps) *pager {
+	p := &pager{
+		enabled: true,
+		getenv:  os.Getenv,
+		corePager: func() string {
+			value, _ := readCorePager(client)
+			return value
+		},
+	}
+	if cm != nil {
+		cfg := cm.GetConfig()
+		p.enabled = cfg.UI.Pager
+		p.noPager = cfg.UI.NoPager
+	}
+	return p
+}
+
+// readCorePager reads core.pager, preferring the repository's effective
+// value when the client can read it.
+func readCorePager(client git.ConfigOps) (string, error) {
+	if local, ok := client.(interface{ ConfigGet(string) (string, error) }); ok {
+		return local.ConfigGet("core.pager")
+	}
+	return client.ConfigGetGlobal("core.pager")
+}
+
+// write writes the output of command to w, through the pager when w is a
+// terminal and paging is enabled for command.
+func (p *pager) write(w io.Writer, command, output string) {
+	if p != nil && p.enabled && !slices.Contains(p.noPager, command) && isTerminalWriter(w) {
+		pagerCmd := ui.PagerCommand(p.getenv, p.corePager())
+		if !ui.IsNoopPager(pagerCmd) && ui.Page(pagerCmd, output, w) == nil {
+			return
+		}
+	}
+	_, _ = io.WriteString(w, output)
+}
+
+func isTerminalWriter(w io.Writer) bool {
+	f, ok := w.(*os.File)
+	return ok && term.IsTerminal(int(f.Fd()))
+}
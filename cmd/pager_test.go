@@ -0,0 +1,56 @@
+package cmd
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/bmf-san/ggc/v8/internal/config"
+	"github.com/bmf-san/ggc/v8/internal/testutil"
+)
+
+func TestPager_WritesDirectlyWhenNotATerminal(t *testing.T) {
+	var buf bytes.Buffer
+	p := &pager{enabled: true, getenv: func(string) string { return "" }, corePager: func() string {
+		t.Error("core.pager should not be read when output is not a terminal")
+		return ""
+	}}
+	p.write(&buf, "diff", "line\n")
+	if buf.String() != "line\n" {
+		t.Errorf("output = %q, want %q", buf.String(), "line\n")
+	}
+
+	buf.Reset()
+	var nilPager *pager
+	nilPager.write(&buf, "diff", "line\n")
+	if buf.String() != "line\n" {
+		t.Errorf("nil pager output = %q, want %q", buf.String(), "line\n")
+	}
+}
+
+func TestNewPager_UsesConfig(t *testing.T) {
+	client := testutil.NewMockGitClient()
+	cm := config.NewConfigManager(client)
+	cfg := cm.GetConfig()
+	cfg.UI.Pager = false
+	cfg.UI.NoPager = []string{"tag"}
+
+	p := newPager(cm, client)
+	if p.enabled || len(p.noPager) != 1 || p.noPager[0] != "tag" {
+		t.Errorf("newPager() = %+v, want paging disabled with tag opted out", p)
+	}
+}
+
+func TestTagger_ListWritesOutput(t *testing.T) {
+	m := &mockTagOps{listOutput: "v1.1.0\nv1.0.0\n"}
+	var buf bytes.Buffer
+	tg := &Tagger{gitClient: m, outputWriter: &buf, helper: NewHelper()}
+
+	tg.Tag([]string{"list", "v1.*"})
+
+	if buf.String() != "v1.1.0\nv1.0.0\n" {
+		t.Errorf("output = %q", buf.String())
+	}
+	if len(m.listPattern) != 1 || m.listPattern[0] != "v1.*" {
+		t.Errorf("pattern = %v, want [v1.*]", m.listPattern)
+	}
+}
@@ -0,0 +1,203 @@
+package cmd
+
+import (
+	"errors"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/bmf-san/ggc/v8/internal/git"
+	"github.com/bmf-san/ggc/v8/internal/prompt"
+)
+
+// revertPickerLimit is the number of recent commits offered by the picker.
+const revertPickerLimit = 20
+
+// Reverter handles revert operations.
+type Reverter struct {
+	gitClient    git.RevertOps
+	outputWriter io.Writer
+	helper       *Helper
+	prompter     prompt.Prompter
+}
+
+// NewReverter creates a new Reverter instance.
+func NewReverter(client git.RevertOps) *Reverter {
+	output := os.Stdout
+	helper := NewHelper()
+	helper.outputWriter = output
+	return &Reverter{
+		gitClient:    client,
+		outputWriter: output,
+		helper:       helper,
+		prompter:     prompt.New(os.Stdin, output),
+	}
+}
+
+// Revert executes git revert commands. Without commits it offers a picker
+// over recent history.
+func (r *Reverter) Revert(args []string) {
+	if len(args) == 0 {
+		r.pickRecent()
+		return
+	}
+
+	switch args[0] {
+	case "continue":
+		r.runStep(r.gitClient.RevertContinue, "Revert successful")
+	case "abort":
+		r.runStep(r.gitClient.RevertAbort, "Revert aborted")
+	case "no-commit":
+		if len(args) == 1 {
+			r.helper.ShowRevertHelp()
+			return
+		}
+		r.revertCommits(args[1:], true)
+	default:
+		r.revertCommits(args, false)
+	}
+}
+
+// revertCommits reverts commits given on the command line. A merge commit
+// can only be reverted on its own since it needs a mainline parent.
+func (r *Reverter) revertCommits(commits []string, noCommit bool) {
+	for _, commit := range commits {
+		if !r.gitClient.RevParseVerify(commit) {
+			WriteErrorf(r.outputWriter, "unknown ref '%s'", commit)
+			return
+		}
+	}
+
+	opts := &git.RevertOptions{NoCommit: noCommit}
+	for _, commit := range commits {
+		parents, err := r.gitClient.CommitParents(commit)
+		if err != nil {
+			WriteError(r.outputWriter, err)
+			return
+		}
+		if len(parents) < 2 {
+			continue
+		}
+		if len(commits) > 1 {
+			WriteErrorf(r.outputWriter, "%s is a merge commit; revert it on its own", commit)
+			return
+		}
+		mainline, ok := r.selectMainline(parents)
+		if !ok {
+			return
+		}
+		opts.Mainline = mainline
+	}
+	r.apply(commits, opts)
+}
+
+func (r *Reverter) apply(commits []string, opts *git.RevertOptions) {
+	if err := r.gitClient.Revert(commits, opts); err != nil {
+		WriteError(r.outputWriter, err)
+		// REVERT_HEAD exists while a revert stopped on conflicts.
+		if r.gitClient.RevParseVerify("REVERT_HEAD") {
+			WriteLine(r.outputWriter, "Resolve the conflicts with 'ggc conflicts' and run 'ggc revert continue', or run 'ggc revert abort'.")
+		}
+		return
+	}
+	if opts.NoCommit {
+		WriteLine(r.outputWriter, "Reverted changes are staged; run 'ggc commit <message>' to record them")
+		return
+	}
+	WriteLine(r.outputWriter, "Revert successful")
+}
+
+func (r *Reverter) runStep(step func() error, success string) {
+	if err := step(); err != nil {
+		WriteError(r.outputWriter, err)
+		return
+	}
+	WriteLine(r.outputWriter, success)
+}
+
+// selectMainline asks which parent of a merge commit to keep and returns
+// its parent number.
+func (r *Reverter) selectMainline(parents []string) (int, bool) {
+	if r.prompter == nil {
+		return 0, false
+	}
+	WriteLine(r.outputWriter, "This is a merge commit. Reverting it keeps one parent and undoes the changes merged from the others.")
+	idx, canceled, err := r.prompter.Select("Parents:", parents, "Enter the number of the parent to keep (usually 1): ")
+	if canceled {
+		return 0, false
+	}
+	if err != nil {
+		if errors.Is(err, prompt.ErrInvalidSelection) {
+			WriteLine(r.outputWriter, "Invalid number.")
+		} else {
+			WriteError(r.outputWriter, err)
+		}
+		return 0, false
+	}
+	return idx + 1, true
+}
+
+// pickRecent asks for one of the recent commits, shows what it changed and
+// reverts it after confirmation.
+func (r *Reverter) pickRecent() {
+	output, err := r.gitClient.LogRecent(revertPickerLimit)
+	if err != nil {
+		WriteError(r.outputWriter, err)
+		return
+	}
+	commits := strings.Split(strings.TrimSpace(output), "\n")
+	if len(commits) == 1 && commits[0] == "" {
+		WriteLine(r.outputWriter, "No commits to revert.")
+		return
+	}
+	if r.prompter == nil {
+		return
+	}
+
+	idx, canceled, err := r.prompter.Select("Recent commits:", commits, "Enter the number of the commit to revert: ")
+	if canceled {
+		return
+	}
+	if err != nil {
+		if errors.Is(err, prompt.ErrInvalidSelection) {
+			WriteLine(r.outputWriter, "Invalid number.")
+		} else {
+			WriteError(r.outputWriter, err)
+		}
+		return
+	}
+	selected := commits[idx]
+	commit := strings.Fields(selected)[0]
+
+	opts := &git.RevertOptions{}
+	parents, err := r.gitClient.CommitParents(commit)
+	if err != nil {
+		WriteError(r.outputWriter, err)
+		return
+	}
+	if len(parents) > 1 {
+		var ok bool
+		if opts.Mainline, ok = r.selectMainline(parents); !ok {
+			return
+		}
+	}
+	files, err := r.gitClient.CommitFiles(commit, opts.Mainline)
+	if err != nil {
+		WriteError(r.outputWriter, err)
+		return
+	}
+
+	WriteLinef(r.outputWriter, "Commit: %s", selected)
+	WriteLine(r.outputWriter, "Files:")
+	for _, line := range strings.Split(strings.TrimSpace(files), "\n") {
+		if line != "" {
+			WriteLinef(r.outputWriter, "  %s", strings.ReplaceAll(line, "\t", " "))
+		}
+	}
+	confirmed, canceled, err := r.prompter.Confirm("Revert this commit? (y/n): ")
+	if canceled || err != nil || !confirmed {
+		WriteLine(r.outputWriter, "Canceled.")
+		return
+	}
+	r.apply([]string{commit}, opts)
+}
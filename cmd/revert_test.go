@@ -0,0 +1,205 @@
+package cmd
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/bmf-san/ggc/v8/internal/git"
+	"github.com/bmf-san/ggc/v8/internal/prompt"
+)
+
+// mockRevertClient implements git.RevertOps and records the reverts
+// performed.
+type mockRevertClient struct {
+	calls      []string
+	recent     string
+	parents    map[string][]string
+	files      string
+	filesOf    string
+	validRefs  map[string]bool
+	revertErr  error
+	revertHead bool
+}
+
+func (m *mockRevertClient) Revert(commits []string, opts *git.RevertOptions) error {
+	call := "revert"
+	if opts != nil && opts.NoCommit {
+		call += " no-commit"
+	}
+	if opts != nil && opts.Mainline > 0 {
+		call += fmt.Sprintf(" -m %d", opts.Mainline)
+	}
+	m.calls = append(m.calls, call+" "+strings.Join(commits, " "))
+	return m.revertErr
+}
+func (m *mockRevertClient) RevertContinue() error {
+	m.calls = append(m.calls, "continue")
+	return nil
+}
+func (m *mockRevertClient) RevertAbort() error {
+	m.calls = append(m.calls, "abort")
+	return nil
+}
+func (m *mockRevertClient) LogRecent(int) (string, error) { return m.recent, nil }
+func (m *mockRevertClient) CommitParents(commit string) ([]string, error) {
+	return m.parents[commit], nil
+}
+func (m *mockRevertClient) CommitFiles(commit string, mainline int) (string, error) {
+	m.filesOf = fmt.Sprintf("%s^%d", commit, mainline)
+	return m.files, nil
+}
+func (m *mockRevertClient) RevParseVerify(ref string) bool {
+	if ref == "REVERT_HEAD" {
+		return m.revertHead
+	}
+	return m.validRefs[ref]
+}
+
+var _ git.RevertOps = (*mockRevertClient)(nil)
+
+func newTestReverter(client *mockRevertClient, buf *bytes.Buffer, input string) *Reverter {
+	helper := NewHelper()
+	helper.outputWriter = buf
+	return &Reverter{
+		gitClient:    client,
+		outputWriter: buf,
+		helper:       helper,
+		prompter:     prompt.New(strings.NewReader(input), buf),
+	}
+}
+
+func TestReverter_Commits(t *testing.T) {
+	tests := []struct {
+		args     []string
+		wantCall string
+		wantOut  string
+	}{
+		{[]string{"abc123"}, "revert abc123", "Revert successful"},
+		{[]string{"abc123", "def456"}, "revert abc123 def456", "Revert successful"},
+		{[]string{"no-commit", "abc123"}, "revert no-commit abc123", "run 'ggc commit <message>'"},
+	}
+
+	for _, tt := range tests {
+		t.Run(strings.Join(tt.args, " "), func(t *testing.T) {
+			var buf bytes.Buffer
+			client := &mockRevertClient{validRefs: map[string]bool{"abc123": true, "def456": true}}
+			newTestReverter(client, &buf, "").Revert(tt.args)
+
+			if len(client.calls) != 1 || client.calls[0] != tt.wantCall {
+				t.Fatalf("calls = %v, want [%s]", client.calls, tt.wantCall)
+			}
+			if !strings.Contains(buf.String(), tt.wantOut) {
+				t.Errorf("output = %q, want it to contain %q", buf.String(), tt.wantOut)
+			}
+		})
+	}
+}
+
+func TestReverter_MergeCommit(t *testing.T) {
+	parents := map[string][]string{"merge1": {"aaa1111 Main work", "bbb2222 Feature work"}}
+
+	var buf bytes.Buffer
+	client := &mockRevertClient{validRefs: map[string]bool{"merge1": true}, parents: parents}
+	newTestReverter(client, &buf, "1\n").Revert([]string{"merge1"})
+	if len(client.calls) != 1 || client.calls[0] != "revert -m 1 merge1" {
+		t.Fatalf("calls = %v, want [revert -m 1 merge1]", client.calls)
+	}
+
+	buf.Reset()
+	client = &mockRevertClient{validRefs: map[string]bool{"merge1": true, "abc123": true}, parents: parents}
+	newTestReverter(client, &buf, "").Revert([]string{"abc123", "merge1"})
+	if len(client.calls) != 0 || !strings.Contains(buf.String(), "merge1 is a merge commit") {
+		t.Errorf("expected merge commits to be refused with others, got calls %v and output %q", client.calls, buf.String())
+	}
+}
+
+func TestReverter_InvalidInput(t *testing.T) {
+	var buf bytes.Buffer
+	client := &mockRevertClient{validRefs: map[string]bool{"abc123": true}}
+	r := newTestReverter(client, &buf, "")
+
+	r.Revert([]string{"abc123", "nope"})
+	if len(client.calls) != 0 || !strings.Contains(buf.String(), "unknown ref 'nope'") {
+		t.Errorf("expected unknown ref, got calls %v and output %q", client.calls, buf.String())
+	}
+
+	buf.Reset()
+	r.Revert([]string{"no-commit"})
+	if len(client.calls) != 0 || !strings.Contains(buf.String(), "ggc revert") {
+		t.Errorf("expected help, got calls %v and output %q", client.calls, buf.String())
+	}
+}
+
+func TestReverter_ConflictHintAndSteps(t *testing.T) {
+	var buf bytes.Buffer
+	client := &mockRevertClient{
+		validRefs:  map[string]bool{"abc123": true},
+		revertErr:  errors.New("revert failed"),
+		revertHead: true,
+	}
+	r := newTestReverter(client, &buf, "")
+	r.Revert([]string{"abc123"})
+	if out := buf.String(); !strings.Contains(out, "revert failed") || !strings.Contains(out, "ggc revert continue") {
+		t.Errorf("expected error with conflict hint, got %q", out)
+	}
+
+	buf.Reset()
+	client.calls = nil
+	r.Revert([]string{"continue"})
+	r.Revert([]string{"abort"})
+	if strings.Join(client.calls, ",") != "continue,abort" {
+		t.Fatalf("calls = %v", client.calls)
+	}
+	if !strings.Contains(buf.String(), "Revert aborted") {
+		t.Errorf("unexpected output %q", buf.String())
+	}
+}
+
+func TestReverter_Picker(t *testing.T) {
+	var buf bytes.Buffer
+	client := &mockRevertClient{
+		recent: "ccc3333 Update docs\nbbb2222 Add flag\naaa1111 Fix crash\n",
+		files:  "M\tcmd/flag.go\nA\tcmd/flag_test.go\n",
+	}
+	newTestReverter(client, &buf, "2\ny\n").Revert(nil)
+
+	out := buf.String()
+	for _, want := range []string{"Commit: bbb2222 Add flag", "M cmd/flag.go", "A cmd/flag_test.go", "Revert successful"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("output %q missing %q", out, want)
+		}
+	}
+	if len(client.calls) != 1 || client.calls[0] != "revert bbb2222" {
+		t.Fatalf("calls = %v, want [revert bbb2222]", client.calls)
+	}
+}
+
+func TestReverter_PickerMergeAndDecline(t *testing.T) {
+	var buf bytes.Buffer
+	client := &mockRevertClient{
+		recent:  "mmm0000 Merge branch 'feature'\n",
+		parents: map[string][]string{"mmm0000": {"aaa1111 Main work", "bbb2222 Feature work"}},
+		files:   "M\tmain.go\n",
+	}
+	newTestReverter(client, &buf, "1\n1\nn\n").Revert(nil)
+
+	if client.filesOf != "mmm0000^1" {
+		t.Errorf("files listed against %s, want mmm0000^1", client.filesOf)
+	}
+	if len(client.calls) != 0 || !strings.Contains(buf.String(), "Canceled.") {
+		t.Errorf("expected no revert after declining, got calls %v and output %q", client.calls, buf.String())
+	}
+}
+
+func TestReverter_PickerNoCommits(t *testing.T) {
+	var buf bytes.Buffer
+	client := &mockRevertClient{}
+	newTestReverter(client, &buf, "").Revert(nil)
+
+	if !strings.Contains(buf.String(), "No commits to revert.") {
+		t.Errorf("unexpected output %q", buf.String())
+	}
+}
@@ -0,0 +1,250 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/bmf-san/ggc/v8/internal/git"
+	"github.com/bmf-san/ggc/v8/internal/prompt"
+	"github.com/bmf-san/ggc/v8/internal/ui"
+)
+
+// showDateLayout formats author and commit dates in their own time zone.
+const showDateLayout = "2006-01-02 15:04:05 -0700"
+
+// Shower handles show operations.
+type Shower struct {
+	gitClient    git.ShowOps
+	outputWriter io.Writer
+	helper       *Helper
+	pager        *pager
+	prompter     prompt.Prompter
+	// view and collapse are the diff.view and diff.collapse settings.
+	view     diffView
+	collapse []string
+	now      func() time.Time
+}
+
+// NewShower creates a new Shower instance.
+func NewShower(client git.ShowOps) *Shower {
+	output := os.Stdout
+	helper := NewHelper()
+	helper.outputWriter = output
+	return &Shower{
+		gitClient:    client,
+		outputWriter: output,
+		helper:       helper,
+		prompter:     prompt.New(os.Stdin, output),
+		now:          time.Now,
+	}
+}
+
+// Show executes show commands.
+func (s *Shower) Show(args []string) {
+	interactive := len(args) > 0 && args[0] == "interactive"
+	if interactive {
+		args = args[1:]
+	}
+	if len(args) > 1 {
+		s.helper.ShowShowHelp()
+		return
+	}
+	rev := optionalArg(args)
+	if interactive {
+		s.browse(rev)
+		return
+	}
+
+	info, ok := s.load(rev)
+	if !ok {
+		return
+	}
+	s.display(info)
+}
+
+// load reads the commit rev points to, HEAD when rev is empty.
+func (s *Shower) load(rev string) (*git.CommitInfo, bool) {
+	info, err := s.gitClient.ShowCommit(rev)
+	if err != nil {
+		WriteError(s.outputWriter, err)
+		return nil, false
+	}
+	return info, true
+}
+
+// display pages the header, stats and diff of info.
+func (s *Shower) display(info *git.CommitInfo) {
+	diff, err := s.gitClient.CommitDiff(info.Hash)
+	if err != nil {
+		WriteError(s.outputWriter, err)
+		return
+	}
+	s.pager.write(s.outputWriter, "show", s.renderHeader(info)+s.renderChanges(diff))
+}
+
+// renderHeader lays out the commit, its refs, parents, author, committer,
+// message and trailers.
+func (s *Shower) renderHeader(info *git.CommitInfo) string {
+	colors := ui.NewANSIColors()
+	now := s.now()
+
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "%scommit %s%s", colors.Yellow, info.Hash, colors.Reset)
+	if len(info.Refs) > 0 {
+		refs := make([]string, len(info.Refs))
+		for i, ref := range info.Refs {
+			refs[i] = decorateRef(colors, ref)
+		}
+		fmt.Fprintf(&sb, " %s(%s%s%s)%s", colors.Yellow, strings.Join(refs, colors.Yellow+", "), colors.Reset, colors.Yellow, colors.Reset)
+	}
+	sb.WriteString("\n")
+
+	label := func(name string) string {
+		return fmt.Sprintf("%s%-12s%s", colors.BrightBlack, name+":", colors.Reset)
+	}
+	switch len(info.Parents) {
+	case 0:
+		fmt.Fprintf(&sb, "%s(root commit)\n", label("Parent"))
+	case 1:
+		fmt.Fprintf(&sb, "%s%s\n", label("Parent"), shortHash(info.Parents[0]))
+	default:
+		short := make([]string, len(info.Parents))
+		for i, p := range info.Parents {
+			short[i] = shortHash(p)
+		}
+		fmt.Fprintf(&sb, "%s%s\n", label("Merge"), strings.Join(short, " "))
+	}
+	fmt.Fprintf(&sb, "%s%s <%s>\n", label("Author"), info.Author, info.AuthorEmail)
+	fmt.Fprintf(&sb, "%s%s (%s)\n", label("AuthorDate"), info.AuthorDate.Format(showDateLayout), blameAge(now.Sub(info.AuthorDate)))
+	fmt.Fprintf(&sb, "%s%s <%s>\n", label("Commit"), info.Committer, info.CommitterEmail)
+	fmt.Fprintf(&sb, "%s%s (%s)\n", label("CommitDate"), info.CommitDate.Format(showDateLayout), blameAge(now.Sub(info.CommitDate)))
+
+	fmt.Fprintf(&sb, "\n    %s%s%s\n", colors.Bold, info.Subject, colors.Reset)
+	if info.Body != "" {
+		sb.WriteString("\n")
+		for _, line := range strings.Split(info.Body, "\n") {
+			if line == "" {
+				sb.WriteString("\n")
+				continue
+			}
+			fmt.Fprintf(&sb, "    %s\n", line)
+		}
+	}
+	if len(info.Trailers) > 0 {
+		sb.WriteString("\n")
+		for _, t := range info.Trailers {
+			fmt.Fprintf(&sb, "    %s%s:%s %s\n", colors.Cyan, t.Key, colors.Reset, t.Value)
+		}
+	}
+	return sb.String()
+}
+
+// decorateRef colors a ref the way git log does: HEAD in cyan, tags in
+// yellow, local branches in green and remote-tracking ones in red.
+func decorateRef(colors *ui.ANSIColors, ref string) string {
+	switch {
+	case ref == "HEAD":
+		return colors.Bold + colors.Cyan + ref + colors.Reset
+	case strings.HasPrefix(ref, "HEAD -> "):
+		branch := strings.TrimPrefix(ref, "HEAD -> ")
+		return colors.Bold + colors.Cyan + "HEAD -> " + colors.Reset + colors.Bold + colors.Green + branch + colors.Reset
+	case strings.HasPrefix(ref, "tag: "):
+		return colors.Bold + colors.Yellow + ref + colors.Reset
+	case strings.Contains(ref, "/"):
+		return colors.Bold + colors.Red + ref + colors.Reset
+	}
+	return colors.Bold + colors.Green + ref + colors.Reset
+}
+
+// renderChanges renders the file stats and the diff in the diff.view
+// layout. The unified view shows the diff itself after the stats.
+func (s *Shower) renderChanges(diff string) string {
+	if diff == "" {
+		return ""
+	}
+	if s.view == diffViewUnified {
+		return "\n" + renderDiff(s.outputWriter, diff, s.view, s.collapse, true) + "\n" + colorUnifiedDiff(diff)
+	}
+	return "\n" + renderCommitDiff(s.outputWriter, diff, s.view, s.collapse)
+}
+
+// browse shows the commit rev points to and steps to its parents or
+// children until it is told to quit.
+func (s *Shower) browse(rev string) {
+	for {
+		info, ok := s.load(rev)
+		if !ok {
+			return
+		}
+		s.display(info)
+
+		children, err := s.gitClient.CommitChildren(info.Hash)
+		if err != nil {
+			WriteError(s.outputWriter, err)
+			return
+		}
+		var keys []string
+		if len(info.Parents) > 0 {
+			keys = append(keys, "p: parent")
+		}
+		if len(children) > 0 {
+			keys = append(keys, "c: child")
+		}
+		if len(keys) == 0 {
+			return
+		}
+
+		input, ok := ReadLine(s.prompter, s.outputWriter,
+			fmt.Sprintf("Step to another commit (%s, Enter: quit): ", strings.Join(keys, ", ")))
+		input = strings.TrimSpace(input)
+		if !ok || input == "" {
+			return
+		}
+		var next string
+		switch {
+		case input == "p" && len(info.Parents) > 0:
+			next, ok = s.selectCommit("Parents:", info.Parents)
+		case input == "c" && len(children) > 0:
+			next, ok = s.selectCommit("Children:", children)
+		default:
+			WriteLine(s.outputWriter, "Invalid choice.")
+			ok = false
+		}
+		if ok {
+			rev = next
+		} else {
+			rev = info.Hash
+		}
+	}
+}
+
+// selectCommit returns the only commit of commits or asks for one of them.
+func (s *Shower) selectCommit(title string, commits []string) (string, bool) {
+	if len(commits) == 1 {
+		return commits[0], true
+	}
+	items := make([]string, len(commits))
+	for i, commit := range commits {
+		items[i] = shortHash(commit)
+		if details, err := s.gitClient.CommitDetails(commit); err == nil {
+			items[i] += " " + details.Subject
+		}
+	}
+	idx, canceled, err := s.prompter.Select(title, items, "Enter the number of the commit: ")
+	if canceled {
+		return "", false
+	}
+	if err != nil {
+		if errors.Is(err, prompt.ErrInvalidSelection) {
+			WriteLine(s.outputWriter, "Invalid number.")
+		} else {
+			WriteError(s.outputWriter, err)
+		}
+		return "", false
+	}
+	return commits[idx], true
+}
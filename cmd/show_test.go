@@ -0,0 +1,206 @@
+package cmd
+
+import (
+	"bytes"
+	"errors"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/bmf-san/ggc/v8/internal/git"
+	"github.com/bmf-san/ggc/v8/internal/prompt"
+	"github.com/bmf-san/ggc/v8/internal/ui"
+)
+
+// mockShowClient serves a small history: root <- a <- merge -> b <- root,
+// and records the commits shown.
+type mockShowClient struct {
+	calls    []string
+	commits  map[string]*git.CommitInfo
+	children map[string][]string
+	err      error
+}
+
+func (m *mockShowClient) ShowCommit(rev string) (*git.CommitInfo, error) {
+	m.calls = append(m.calls, "show "+rev)
+	if m.err != nil {
+		return nil, m.err
+	}
+	if rev == "" {
+		rev = "merge"
+	}
+	return m.commits[rev], nil
+}
+func (m *mockShowClient) CommitChildren(commit string) ([]string, error) {
+	return m.children[commit], nil
+}
+func (m *mockShowClient) CommitDetails(commit string) (*git.CommitDetails, error) {
+	return &git.CommitDetails{Hash: commit, Subject: m.commits[commit].Subject}, nil
+}
+func (m *mockShowClient) CommitDiff(commit string) (string, error) {
+	return "diff --git a/" + commit + ".go b/" + commit + ".go\n--- a/" + commit + ".go\n+++ b/" + commit + ".go\n@@ -1 +1,2 @@\n one\n+two\n", nil
+}
+
+var _ git.ShowOps = (*mockShowClient)(nil)
+
+var showNow = time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)
+
+func newMockShowClient() *mockShowClient {
+	commit := func(hash, subject string, parents ...string) *git.CommitInfo {
+		return &git.CommitInfo{Hash: hash, Parents: parents, Subject: subject,
+			Author: "Jane", AuthorEmail: "jane@example.com", AuthorDate: showNow.Add(-3 * time.Hour),
+			Committer: "Bob", CommitterEmail: "bob@example.com", CommitDate: showNow.Add(-2 * time.Hour)}
+	}
+	merge := commit("merge", "Merge branch 'b'", "a", "b")
+	merge.Refs = []string{"HEAD -> main", "tag: v1.0.0", "origin/main"}
+	merge.Body = "Brings in b.\n\nSecond paragraph."
+	merge.Trailers = []git.Trailer{{Key: "Signed-off-by", Value: "Jane <jane@example.com>"}}
+	return &mockShowClient{
+		commits: map[string]*git.CommitInfo{
+			"merge": merge,
+			"a":     commit("a", "add a", "root"),
+			"b":     commit("b", "add b", "root"),
+			"root":  commit("root", "initial"),
+		},
+		children: map[string][]string{
+			"root": {"a", "b"},
+			"a":    {"merge"},
+			"b":    {"merge"},
+		},
+	}
+}
+
+func newTestShower(client *mockShowClient, buf *bytes.Buffer, input string) *Shower {
+	helper := NewHelper()
+	helper.outputWriter = buf
+	return &Shower{
+		gitClient:    client,
+		outputWriter: buf,
+		helper:       helper,
+		prompter:     prompt.New(strings.NewReader(input), buf),
+		now:          func() time.Time { return showNow },
+	}
+}
+
+func TestShower_Show(t *testing.T) {
+	defer ui.SetColor(true)
+	ui.SetColor(false)
+	var buf bytes.Buffer
+	client := newMockShowClient()
+	newTestShower(client, &buf, "").Show(nil)
+
+	if !slices.Equal(client.calls, []string{"show "}) {
+		t.Errorf("calls = %v", client.calls)
+	}
+	want := `commit merge (HEAD -> main, tag: v1.0.0, origin/main)
+Merge:      a b
+Author:     Jane <jane@example.com>
+AuthorDate: 2026-10-19 09:00:00 +0000 (3h ago)
+Commit:     Bob <bob@example.com>
+CommitDate: 2026-10-19 10:00:00 +0000 (2h ago)
+
+    Merge branch 'b'
+
+    Brings in b.
+
+    Second paragraph.
+
+    Signed-off-by: Jane <jane@example.com>
+
+1 file changed, +1 -0
+  M merge.go  +1
+
+diff --git a/merge.go b/merge.go
+`
+	if got := buf.String(); !strings.HasPrefix(got, want) {
+		t.Errorf("output =\n%s\nwant it to start with\n%s", got, want)
+	}
+}
+
+func TestShower_ShowRootCommit(t *testing.T) {
+	defer ui.SetColor(true)
+	ui.SetColor(false)
+	var buf bytes.Buffer
+	client := newMockShowClient()
+	newTestShower(client, &buf, "").Show([]string{"root"})
+
+	out := buf.String()
+	if !slices.Equal(client.calls, []string{"show root"}) || !strings.Contains(out, "Parent:     (root commit)\n") {
+		t.Errorf("calls = %v, output:\n%s", client.calls, out)
+	}
+	if strings.Contains(out, "Signed-off-by") {
+		t.Errorf("did not expect trailers:\n%s", out)
+	}
+}
+
+func TestShower_SideBySideView(t *testing.T) {
+	defer ui.SetColor(true)
+	ui.SetColor(false)
+	var buf bytes.Buffer
+	shower := newTestShower(newMockShowClient(), &buf, "")
+	shower.view = diffViewSide
+	shower.Show([]string{"a"})
+
+	out := buf.String()
+	if strings.Contains(out, "diff --git") || strings.Count(out, "1 file changed") != 1 {
+		t.Errorf("expected the rendered diff with one file list, got:\n%s", out)
+	}
+}
+
+func TestShower_Usage(t *testing.T) {
+	for _, args := range [][]string{{"a", "b"}, {"interactive", "a", "b"}} {
+		var buf bytes.Buffer
+		client := newMockShowClient()
+		newTestShower(client, &buf, "").Show(args)
+
+		if len(client.calls) != 0 || !strings.Contains(buf.String(), "ggc show") {
+			t.Errorf("%v: calls = %v, output %q", args, client.calls, buf.String())
+		}
+	}
+}
+
+func TestShower_Error(t *testing.T) {
+	var buf bytes.Buffer
+	client := newMockShowClient()
+	client.err = errors.New("unknown revision")
+	newTestShower(client, &buf, "").Show([]string{"nope"})
+
+	if !strings.Contains(buf.String(), "unknown revision") {
+		t.Errorf("expected the error, got %q", buf.String())
+	}
+}
+
+func TestShower_InteractiveStepsToParentsAndChildren(t *testing.T) {
+	var buf bytes.Buffer
+	client := newMockShowClient()
+	// Pick the second parent of the merge, then its parent, then the
+	// first of root's children, then its only child.
+	newTestShower(client, &buf, "p\n2\np\nc\n1\nc\n\n").Show([]string{"interactive"})
+
+	want := []string{"show ", "show b", "show root", "show a", "show merge"}
+	if !slices.Equal(client.calls, want) {
+		t.Errorf("calls = %v, want %v", client.calls, want)
+	}
+	out := buf.String()
+	for _, s := range []string{"[1] a add a", "[2] b add b", "(p: parent, Enter: quit)", "(c: child, Enter: quit)", "(p: parent, c: child, Enter: quit)"} {
+		if !strings.Contains(out, s) {
+			t.Errorf("output missing %q:\n%s", s, out)
+		}
+	}
+}
+
+func TestShower_InteractiveInvalidInput(t *testing.T) {
+	var buf bytes.Buffer
+	client := newMockShowClient()
+	// root has no parent, and 9 is not one of its children.
+	newTestShower(client, &buf, "p\nc\n9\n\n").Show([]string{"interactive", "root"})
+
+	out := buf.String()
+	if !strings.Contains(out, "Invalid choice.") || !strings.Contains(out, "Invalid number.") {
+		t.Errorf("expected invalid input to be reported:\n%s", out)
+	}
+	if !slices.Equal(client.calls, []string{"show root", "show root", "show root"}) {
+		t.Errorf("expected to stay on the commit, got %v", client.calls)
+	}
+}
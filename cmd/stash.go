@@ -14,6 +14,7 @@ type Stasher struct {
 	gitClient    git.StashOps
 	outputWriter io.Writer
 	helper       *Helper
+	pager        *pager
 }
 
 // NewStasher creates a new Stasher instance.
@@ -70,7 +71,7 @@ func (s *Stasher) stashList() {
 		WriteLine(s.outputWriter, "No stashes found")
 		return
 	}
-	_, _ = io.WriteString(s.outputWriter, output)
+	s.pager.write(s.outputWriter, "stash", output)
 }
 
 // stashShow shows the changes recorded in the stash
@@ -68,6 +68,44 @@ func (s *Statuser) formatAheadBehind(upstream, ahead, behind string) string {
 	}
 }
 
+// operationHints tells how to move on from each operation in progress.
+var operationHints = map[git.Operation]string{
+	git.OperationMerge:      `(resolve conflicts with "ggc conflicts", then run "ggc merge continue"; "ggc merge abort" cancels the merge)`,
+	git.OperationRebase:     `(resolve conflicts with "ggc conflicts", then run "ggc rebase continue"; "ggc rebase skip" drops the commit, "ggc rebase abort" cancels the rebase)`,
+	git.OperationCherryPick: `(resolve conflicts with "ggc conflicts", then run "ggc cherry-pick continue"; "ggc cherry-pick skip" drops the commit, "ggc cherry-pick abort" cancels the cherry-pick)`,
+	git.OperationRevert:     `(resolve conflicts with "ggc conflicts", then run "ggc revert continue"; "ggc revert abort" cancels the revert)`,
+	git.OperationBisect:     `(test the checked out commit and run "ggc bisect good", "ggc bisect bad" or "ggc bisect skip"; "ggc bisect reset" ends the bisect)`,
+}
+
+// writeOperation reports the operation in progress, if any, with a hint on
+// how to continue or abort it.
+func (s *Statuser) writeOperation() {
+	op, err := s.gitClient.OperationInProgress()
+	if err != nil || op == git.OperationNone {
+		return
+	}
+	name := string(op)
+	_, _ = fmt.Fprintf(s.outputWriter, "%s%s in progress\n", strings.ToUpper(name[:1]), name[1:])
+	if hint, ok := operationHints[op]; ok {
+		_, _ = fmt.Fprintf(s.outputWriter, "  %s\n", hint)
+	}
+}
+
+// writeSubmodules reports submodules that are out of sync with the
+// superproject or have changes of their own.
+func (s *Statuser) writeSubmodules() {
+	submodules, err := s.gitClient.ListSubmodules()
+	if err != nil {
+		return
+	}
+	summary := submoduleSummary(submodules)
+	if summary == "" {
+		return
+	}
+	_, _ = fmt.Fprintf(s.outputWriter, "Submodules: %s\n", summary)
+	_, _ = fmt.Fprintf(s.outputWriter, "  %s\n", `(run "ggc submodule" for details; "ggc submodule update" checks out the recorded commits)`)
+}
+
 // Status executes git status with the given arguments.
 func (s *Statuser) Status(args []string) {
 	if len(args) == 0 {
@@ -84,6 +122,8 @@ func (s *Statuser) Status(args []string) {
 		if upstreamStatus != "" {
 			_, _ = fmt.Fprintf(s.outputWriter, "%s\n", upstreamStatus)
 		}
+		s.writeOperation()
+		s.writeSubmodules()
 		_, _ = fmt.Fprintf(s.outputWriter, "\n")
 
 		if output, err := s.gitClient.StatusWithColor(); err != nil {
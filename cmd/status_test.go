@@ -15,6 +15,8 @@ type mockStatusInfoReader struct {
 	aheadBehindCount     string
 	statusWithColor      string
 	statusShortWithColor string
+	operation            git.Operation
+	submodules           []git.Submodule
 }
 
 func (m *mockStatusInfoReader) GetCurrentBranch() (string, error) {
@@ -42,6 +44,13 @@ func (m *mockStatusInfoReader) StatusShortWithColor() (string, error) {
 	return m.statusShortWithColor, nil
 }
 
+func (m *mockStatusInfoReader) OperationInProgress() (git.Operation, error) {
+	return m.operation, nil
+}
+func (m *mockStatusInfoReader) ListSubmodules() ([]git.Submodule, error) {
+	return m.submodules, nil
+}
+
 var _ git.StatusInfoReader = (*mockStatusInfoReader)(nil)
 
 func TestStatuser_Constructor(t *testing.T) {
@@ -443,3 +452,70 @@ func TestGetUpstreamStatus_MalformedOutput(t *testing.T) {
 		t.Errorf("expected up-to-date message for malformed output, got %q", result)
 	}
 }
+
+func TestStatuser_Status_OperationInProgress(t *testing.T) {
+	tests := []struct {
+		op   git.Operation
+		want []string
+	}{
+		{git.OperationRebase, []string{"Rebase in progress", "ggc rebase continue", "ggc rebase abort"}},
+		{git.OperationMerge, []string{"Merge in progress", "ggc conflicts", "ggc merge abort"}},
+		{git.OperationCherryPick, []string{"Cherry-pick in progress", "ggc cherry-pick continue", "ggc cherry-pick abort"}},
+		{git.OperationBisect, []string{"Bisect in progress"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.op), func(t *testing.T) {
+			buf := &bytes.Buffer{}
+			statuser := &Statuser{
+				gitClient:    &mockStatusInfoReader{operation: tt.op},
+				outputWriter: buf,
+				helper:       NewHelper(),
+			}
+			statuser.Status(nil)
+
+			out := buf.String()
+			for _, want := range tt.want {
+				if !strings.Contains(out, want) {
+					t.Errorf("output %q missing %q", out, want)
+				}
+			}
+		})
+	}
+
+	buf := &bytes.Buffer{}
+	statuser := &Statuser{gitClient: &mockStatusInfoReader{}, outputWriter: buf, helper: NewHelper()}
+	statuser.Status(nil)
+	if strings.Contains(buf.String(), "in progress") {
+		t.Errorf("did not expect an operation line, got %q", buf.String())
+	}
+}
+
+func TestStatuser_Status_Submodules(t *testing.T) {
+	buf := &bytes.Buffer{}
+	statuser := &Statuser{
+		gitClient: &mockStatusInfoReader{submodules: []git.Submodule{
+			{Path: "a", OutOfDate: true},
+			{Path: "b", OutOfDate: true, Modified: true},
+			{Path: "c"},
+			{Path: "d", Uninitialized: true},
+		}},
+		outputWriter: buf,
+		helper:       NewHelper(),
+	}
+	statuser.Status(nil)
+
+	out := buf.String()
+	for _, want := range []string{"Submodules: 2 out of date, 1 modified", "ggc submodule update"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("output %q missing %q", out, want)
+		}
+	}
+
+	buf.Reset()
+	statuser.gitClient = &mockStatusInfoReader{submodules: []git.Submodule{{Path: "c"}, {Path: "d", Uninitialized: true}}}
+	statuser.Status(nil)
+	if strings.Contains(buf.String(), "Submodules") {
+		t.Errorf("did not expect a submodule line, got %q", buf.String())
+	}
+}
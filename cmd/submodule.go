@@ -0,0 +1,188 @@
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/bmf-san/ggc/v8/internal/git"
+	"github.com/bmf-san/ggc/v8/internal/ui"
+)
+
+// SubmoduleManager handles submodule operations.
+type SubmoduleManager struct {
+	gitClient    git.SubmoduleOps
+	outputWriter io.Writer
+	helper       *Helper
+}
+
+// NewSubmoduleManager creates a new SubmoduleManager instance.
+func NewSubmoduleManager(client git.SubmoduleOps) *SubmoduleManager {
+	output := os.Stdout
+	helper := NewHelper()
+	helper.outputWriter = output
+	return &SubmoduleManager{
+		gitClient:    client,
+		outputWriter: output,
+		helper:       helper,
+	}
+}
+
+// Submodule executes git submodule commands. Without arguments it shows
+// the state of the submodules.
+func (s *SubmoduleManager) Submodule(args []string) {
+	if len(args) == 0 {
+		s.status()
+		return
+	}
+
+	switch args[0] {
+	case "status":
+		s.status()
+	case "init":
+		s.runStep(func() error { return s.gitClient.SubmoduleInit(args[1:]) }, "Initialized submodules")
+	case "update":
+		s.runStep(func() error { return s.gitClient.SubmoduleUpdate(args[1:]) }, "Checked out the recorded submodule commits")
+	case "sync":
+		s.runStep(func() error { return s.gitClient.SubmoduleSync(args[1:]) }, "Synchronized submodule URLs")
+	case "foreach":
+		if len(args) < 2 {
+			s.helper.ShowSubmoduleHelp()
+			return
+		}
+		if err := s.gitClient.SubmoduleForeach(args[1:]); err != nil {
+			WriteError(s.outputWriter, err)
+		}
+	case "add":
+		s.add(args[1:])
+	default:
+		s.helper.ShowSubmoduleHelp()
+	}
+}
+
+func (s *SubmoduleManager) runStep(step func() error, success string) {
+	if err := step(); err != nil {
+		WriteError(s.outputWriter, err)
+		return
+	}
+	WriteLine(s.outputWriter, success)
+}
+
+func (s *SubmoduleManager) add(args []string) {
+	if len(args) == 0 || len(args) > 2 {
+		s.helper.ShowSubmoduleHelp()
+		return
+	}
+	url, path := args[0], optionalArg(args[1:])
+	if err := s.gitClient.SubmoduleAdd(url, path); err != nil {
+		WriteError(s.outputWriter, err)
+		return
+	}
+	WriteLinef(s.outputWriter, "Added submodule %s; commit .gitmodules and the submodule to record it", url)
+}
+
+// status lists the submodules with the commit they check out and their
+// state, followed by the commands that bring them in sync.
+func (s *SubmoduleManager) status() {
+	submodules, err := s.gitClient.ListSubmodules()
+	if err != nil {
+		WriteError(s.outputWriter, err)
+		return
+	}
+	if len(submodules) == 0 {
+		WriteLine(s.outputWriter, "No submodules.")
+		return
+	}
+
+	colors := ui.NewANSIColors()
+	pathWidth, describeWidth := 0, 0
+	for _, sm := range submodules {
+		pathWidth = max(pathWidth, ui.DisplayWidth(sm.Path))
+		describeWidth = max(describeWidth, ui.DisplayWidth(describeSubmoduleCommit(sm)))
+	}
+	uninitialized, outOfDate := false, false
+	for _, sm := range submodules {
+		uninitialized = uninitialized || sm.Uninitialized
+		outOfDate = outOfDate || sm.OutOfDate
+		WriteLinef(s.outputWriter, "  %s  %s%s%s  %s",
+			fitWidth(sm.Path, pathWidth),
+			colors.Yellow, fitWidth(describeSubmoduleCommit(sm), describeWidth), colors.Reset,
+			submoduleState(colors, sm))
+	}
+
+	if uninitialized {
+		WriteLine(s.outputWriter, `Run "ggc submodule init" and "ggc submodule update" to check out the uninitialized submodules.`)
+	}
+	if outOfDate {
+		WriteLine(s.outputWriter, `Run "ggc submodule update" to check out the recorded commits.`)
+	}
+}
+
+// describeSubmoduleCommit returns the short commit with the ref git found
+// for it, such as "219e3fd (heads/main)". git falls back to the abbreviated
+// commit when no ref names it, which is not repeated.
+func describeSubmoduleCommit(sm git.Submodule) string {
+	if sm.Describe == "" || strings.HasPrefix(sm.Commit, sm.Describe) {
+		return shortHash(sm.Commit)
+	}
+	return shortHash(sm.Commit) + " (" + sm.Describe + ")"
+}
+
+// submoduleState describes the state of sm in color, such as "out of date
+// (recorded 5e07675), modified".
+func submoduleState(colors *ui.ANSIColors, sm git.Submodule) string {
+	if sm.Uninitialized {
+		return colors.BrightBlack + "not initialized" + colors.Reset
+	}
+	var states []string
+	if sm.Conflicted {
+		states = append(states, colors.Red+"conflict"+colors.Reset)
+	}
+	if sm.OutOfDate {
+		state := "out of date"
+		if sm.Recorded != "" {
+			state += " (recorded " + shortHash(sm.Recorded) + ")"
+		}
+		states = append(states, colors.Yellow+state+colors.Reset)
+	}
+	if sm.Modified {
+		states = append(states, colors.Red+"modified"+colors.Reset)
+	}
+	if sm.Untracked {
+		states = append(states, colors.Red+"untracked files"+colors.Reset)
+	}
+	if len(states) == 0 {
+		return colors.Green + "up to date" + colors.Reset
+	}
+	return strings.Join(states, ", ")
+}
+
+// submoduleSummary counts the submodules that are out of sync or have
+// changes, such as "1 out of date, 2 modified". It is empty when all of
+// them are in sync.
+func submoduleSummary(submodules []git.Submodule) string {
+	conflicted, outOfDate, modified := 0, 0, 0
+	for _, sm := range submodules {
+		switch {
+		case sm.Conflicted:
+			conflicted++
+		case sm.OutOfDate:
+			outOfDate++
+		}
+		if sm.Modified || sm.Untracked {
+			modified++
+		}
+	}
+	var parts []string
+	if conflicted > 0 {
+		parts = append(parts, fmt.Sprintf("%d conflicted", conflicted))
+	}
+	if outOfDate > 0 {
+		parts = append(parts, fmt.Sprintf("%d out of date", outOfDate))
+	}
+	if modified > 0 {
+		parts = append(parts, fmt.Sprintf("%d modified", modified))
+	}
+	return strings.Join(parts, ", ")
+}
@@ -0,0 +1,153 @@
+package cmd
+
+import (
+	"bytes"
+	"errors"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/bmf-san/ggc/v8/internal/git"
+	"github.com/bmf-san/ggc/v8/internal/ui"
+)
+
+// mockSubmoduleClient serves a list of submodules and records the calls.
+type mockSubmoduleClient struct {
+	calls      []string
+	submodules []git.Submodule
+	err        error
+}
+
+func (m *mockSubmoduleClient) record(call string, args []string) error {
+	m.calls = append(m.calls, strings.Join(append([]string{call}, args...), " "))
+	return m.err
+}
+
+func (m *mockSubmoduleClient) ListSubmodules() ([]git.Submodule, error) {
+	return m.submodules, m.err
+}
+func (m *mockSubmoduleClient) SubmoduleInit(paths []string) error {
+	return m.record("init", paths)
+}
+func (m *mockSubmoduleClient) SubmoduleUpdate(paths []string) error {
+	return m.record("update", paths)
+}
+func (m *mockSubmoduleClient) SubmoduleSync(paths []string) error {
+	return m.record("sync", paths)
+}
+func (m *mockSubmoduleClient) SubmoduleForeach(command []string) error {
+	return m.record("foreach", command)
+}
+func (m *mockSubmoduleClient) SubmoduleAdd(url, path string) error {
+	return m.record("add", []string{url, path})
+}
+
+var _ git.SubmoduleOps = (*mockSubmoduleClient)(nil)
+
+func newTestSubmoduleManager(client *mockSubmoduleClient, buf *bytes.Buffer) *SubmoduleManager {
+	helper := NewHelper()
+	helper.outputWriter = buf
+	return &SubmoduleManager{gitClient: client, outputWriter: buf, helper: helper}
+}
+
+func TestSubmoduleManager_Status(t *testing.T) {
+	defer ui.SetColor(true)
+	ui.SetColor(false)
+	var buf bytes.Buffer
+	client := &mockSubmoduleClient{submodules: []git.Submodule{
+		{Path: "libs/lib", Commit: "5e076753631fa568c3d073786c489df5550a0911", Recorded: "219e3fd480141f673b72eee25ababf5de4192a75",
+			Describe: "5e07675", OutOfDate: true, Modified: true},
+		{Path: "other", Commit: "219e3fd480141f673b72eee25ababf5de4192a75", Describe: "heads/main"},
+		{Path: "docs", Commit: "8d99f2170cddc496187fb10bdf4c3bab5029e40b", Uninitialized: true},
+	}}
+	newTestSubmoduleManager(client, &buf).Submodule(nil)
+
+	lines := strings.Split(strings.TrimRight(buf.String(), "\n"), "\n")
+	want := []string{
+		"  libs/lib  5e07675               out of date (recorded 219e3fd), modified",
+		"  other     219e3fd (heads/main)  up to date",
+		"  docs      8d99f21               not initialized",
+		`Run "ggc submodule init" and "ggc submodule update" to check out the uninitialized submodules.`,
+		`Run "ggc submodule update" to check out the recorded commits.`,
+	}
+	if !slices.Equal(lines, want) {
+		t.Errorf("output =\n%s\nwant\n%s", strings.Join(lines, "\n"), strings.Join(want, "\n"))
+	}
+}
+
+func TestSubmoduleManager_StatusNone(t *testing.T) {
+	var buf bytes.Buffer
+	newTestSubmoduleManager(&mockSubmoduleClient{}, &buf).Submodule([]string{"status"})
+
+	if buf.String() != "No submodules.\n" {
+		t.Errorf("output = %q", buf.String())
+	}
+}
+
+func TestSubmoduleManager_Commands(t *testing.T) {
+	tests := []struct {
+		args     []string
+		wantCall string
+		wantOut  string
+	}{
+		{[]string{"init"}, "init", "Initialized submodules"},
+		{[]string{"update", "libs/lib"}, "update libs/lib", "Checked out the recorded submodule commits"},
+		{[]string{"sync"}, "sync", "Synchronized submodule URLs"},
+		{[]string{"foreach", "git", "fetch"}, "foreach git fetch", ""},
+		{[]string{"add", "../lib.git"}, "add ../lib.git ", "Added submodule ../lib.git"},
+		{[]string{"add", "../lib.git", "libs/lib"}, "add ../lib.git libs/lib", "Added submodule ../lib.git"},
+	}
+
+	for _, tt := range tests {
+		t.Run(strings.Join(tt.args, " "), func(t *testing.T) {
+			var buf bytes.Buffer
+			client := &mockSubmoduleClient{}
+			newTestSubmoduleManager(client, &buf).Submodule(tt.args)
+
+			if !slices.Equal(client.calls, []string{tt.wantCall}) {
+				t.Errorf("calls = %q, want [%q]", client.calls, tt.wantCall)
+			}
+			if !strings.Contains(buf.String(), tt.wantOut) {
+				t.Errorf("output %q missing %q", buf.String(), tt.wantOut)
+			}
+		})
+	}
+}
+
+func TestSubmoduleManager_Usage(t *testing.T) {
+	for _, args := range [][]string{{"foreach"}, {"add"}, {"add", "a", "b", "c"}, {"unknown"}} {
+		var buf bytes.Buffer
+		client := &mockSubmoduleClient{}
+		newTestSubmoduleManager(client, &buf).Submodule(args)
+
+		if len(client.calls) != 0 || !strings.Contains(buf.String(), "ggc submodule") {
+			t.Errorf("%v: calls = %v, output %q", args, client.calls, buf.String())
+		}
+	}
+}
+
+func TestSubmoduleManager_Error(t *testing.T) {
+	var buf bytes.Buffer
+	client := &mockSubmoduleClient{err: errors.New("clone failed")}
+	newTestSubmoduleManager(client, &buf).Submodule([]string{"update"})
+
+	out := buf.String()
+	if !strings.Contains(out, "clone failed") || strings.Contains(out, "Checked out") {
+		t.Errorf("expected only the error, got %q", out)
+	}
+}
+
+func TestSubmoduleSummary(t *testing.T) {
+	got := submoduleSummary([]git.Submodule{
+		{Path: "a", Conflicted: true},
+		{Path: "b", OutOfDate: true, Untracked: true},
+		{Path: "c", Modified: true},
+		{Path: "d", Uninitialized: true},
+	})
+	if want := "1 conflicted, 1 out of date, 2 modified"; got != want {
+		t.Errorf("submoduleSummary() = %q, want %q", got, want)
+	}
+	if got := submoduleSummary([]git.Submodule{{Path: "a"}}); got != "" {
+		t.Errorf("submoduleSummary() = %q, want empty", got)
+	}
+}
@@ -20,6 +20,7 @@ type Tagger struct {
 	// defaultRemote caches the default remote name to avoid
 	// reloading configuration on each tag push.
 	defaultRemote string
+	pager         *pager
 }
 
 // NewTagger creates a new Tagger instance.
@@ -38,9 +39,7 @@ func NewTagger(client interface {
 // Tag executes git tag operations with the given arguments.
 func (t *Tagger) Tag(args []string) {
 	if len(args) == 0 {
-		if err := t.gitClient.TagList(nil); err != nil {
-			WriteError(t.outputWriter, err)
-		}
+		t.listTags(nil)
 		return
 	}
 
@@ -68,9 +67,12 @@ func (t *Tagger) Tag(args []string) {
 
 // listTags lists tags with optional pattern matching
 func (t *Tagger) listTags(args []string) {
-	if err := t.gitClient.TagList(args); err != nil {
+	output, err := t.gitClient.TagList(args)
+	if err != nil {
 		WriteError(t.outputWriter, err)
+		return
 	}
+	t.pager.write(t.outputWriter, "tag", output)
 }
 
 // createTag creates a new tag
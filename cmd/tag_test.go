@@ -36,15 +36,16 @@ type mockTagOps struct {
 	errPush      error
 	errPushAll   error
 
-	latestTag string
-	tagExists bool
-	tagCommit string
+	listOutput string
+	latestTag  string
+	tagExists  bool
+	tagCommit  string
 }
 
-func (m *mockTagOps) TagList(pattern []string) error {
+func (m *mockTagOps) TagList(pattern []string) (string, error) {
 	m.listCalled = true
 	m.listPattern = pattern
-	return m.errList
+	return m.listOutput, m.errList
 }
 func (m *mockTagOps) TagShow(name string) error {
 	m.showCalled = true
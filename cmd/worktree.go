@@ -0,0 +1,466 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/bmf-san/ggc/v8/internal/git"
+	"github.com/bmf-san/ggc/v8/internal/prompt"
+	"github.com/bmf-san/ggc/v8/internal/ui"
+)
+
+// WorktreeManager handles worktree operations.
+type WorktreeManager struct {
+	gitClient interface {
+		git.WorktreeOps
+		git.RepositoryRootReader
+		git.BranchOps
+	}
+	// brancher provides the branch pickers used by add.
+	brancher     *Brancher
+	outputWriter io.Writer
+	helper       *Helper
+	prompter     prompt.Prompter
+	// root is the worktree.root setting; empty creates worktrees next to
+	// the main worktree.
+	root string
+}
+
+// NewWorktreeManager creates a new WorktreeManager instance.
+func NewWorktreeManager(client interface {
+	git.WorktreeOps
+	git.RepositoryRootReader
+	git.BranchOps
+}) *WorktreeManager {
+	output := os.Stdout
+	helper := NewHelper()
+	helper.outputWriter = output
+	// The branch pickers read from the same prompter so that buffered
+	// input is not split between two readers of stdin.
+	p := prompt.New(os.Stdin, output)
+	brancher := NewBrancher(client)
+	brancher.prompter = p
+	return &WorktreeManager{
+		gitClient:    client,
+		brancher:     brancher,
+		outputWriter: output,
+		helper:       helper,
+		prompter:     p,
+	}
+}
+
+// Worktree executes git worktree commands. Without arguments it lists the
+// worktrees.
+func (w *WorktreeManager) Worktree(args []string) {
+	if len(args) == 0 {
+		w.list()
+		return
+	}
+
+	switch args[0] {
+	case "list":
+		w.list()
+	case "add":
+		w.add(args[1:])
+	case "remove":
+		w.remove(args[1:])
+	case "prune":
+		w.runStep(w.gitClient.PruneWorktrees, "Pruned stale worktree information")
+	case "lock":
+		w.lock(args[1:])
+	case "unlock":
+		w.unlock(args[1:])
+	default:
+		w.helper.ShowWorktreeHelp()
+	}
+}
+
+func (w *WorktreeManager) runStep(step func() error, success string) {
+	if err := step(); err != nil {
+		WriteError(w.outputWriter, err)
+		return
+	}
+	WriteLine(w.outputWriter, success)
+}
+
+func (w *WorktreeManager) load() ([]git.Worktree, bool) {
+	worktrees, err := w.gitClient.ListWorktrees()
+	if err != nil {
+		WriteError(w.outputWriter, err)
+		return nil, false
+	}
+	return worktrees, true
+}
+
+// currentPath returns the path of the worktree ggc runs in, or an empty
+// string when it cannot be determined.
+func (w *WorktreeManager) currentPath() string {
+	root, err := w.gitClient.GetRepositoryRoot()
+	if err != nil {
+		return ""
+	}
+	return filepath.Clean(root)
+}
+
+// describeWorktree returns what wt has checked out and its state, such as
+// "feature  locked (on usb drive)".
+func describeWorktree(wt git.Worktree) string {
+	var parts []string
+	switch {
+	case wt.Bare:
+		parts = append(parts, "(bare)")
+	case wt.Detached:
+		parts = append(parts, "(detached at "+shortHash(wt.Head)+")")
+	default:
+		parts = append(parts, wt.Branch)
+	}
+	if wt.Locked {
+		lock := "locked"
+		if wt.LockReason != "" {
+			lock += " (" + wt.LockReason + ")"
+		}
+		parts = append(parts, lock)
+	}
+	if wt.Prunable {
+		parts = append(parts, "prunable")
+	}
+	return strings.Join(parts, "  ")
+}
+
+func shortHash(hash string) string {
+	if len(hash) > 7 {
+		return hash[:7]
+	}
+	return hash
+}
+
+// list prints the worktrees and marks the current one.
+func (w *WorktreeManager) list() {
+	worktrees, ok := w.load()
+	if !ok {
+		return
+	}
+	width := 0
+	for _, wt := range worktrees {
+		width = max(width, len(wt.Path))
+	}
+	colors := ui.NewANSIColors()
+	current := w.currentPath()
+	for _, wt := range worktrees {
+		marker := " "
+		if filepath.Clean(wt.Path) == current {
+			marker = colors.Green + "*" + colors.Reset
+		}
+		WriteLinef(w.outputWriter, "%s %-*s  %s", marker, width, wt.Path, describeWorktree(wt))
+	}
+}
+
+// selectWorktree asks for one of the worktrees that match keep. keep is
+// also told whether a worktree is the main one.
+func (w *WorktreeManager) selectWorktree(action string, keep func(wt git.Worktree, main bool) bool) (string, bool) {
+	all, ok := w.load()
+	if !ok {
+		return "", false
+	}
+	var worktrees []git.Worktree
+	for i, wt := range all {
+		if keep(wt, i == 0) {
+			worktrees = append(worktrees, wt)
+		}
+	}
+	if len(worktrees) == 0 {
+		WriteLinef(w.outputWriter, "No worktrees to %s.", action)
+		return "", false
+	}
+	if w.prompter == nil {
+		return "", false
+	}
+	items := make([]string, len(worktrees))
+	for i, wt := range worktrees {
+		items[i] = wt.Path + "  " + describeWorktree(wt)
+	}
+	idx, canceled, err := w.prompter.Select("Worktrees:", items, "Enter the number of the worktree to "+action+": ")
+	if canceled {
+		return "", false
+	}
+	if err != nil {
+		if errors.Is(err, prompt.ErrInvalidSelection) {
+			WriteLine(w.outputWriter, "Invalid number.")
+		} else {
+			WriteError(w.outputWriter, err)
+		}
+		return "", false
+	}
+	return worktrees[idx].Path, true
+}
+
+func (w *WorktreeManager) remove(args []string) {
+	force := len(args) > 0 && args[0] == "force"
+	if force {
+		args = args[1:]
+	}
+	if len(args) > 1 {
+		w.helper.ShowWorktreeHelp()
+		return
+	}
+	path := optionalArg(args)
+	if path == "" {
+		// git refuses to remove the main worktree and the current one.
+		current := w.currentPath()
+		var ok bool
+		path, ok = w.selectWorktree("remove", func(wt git.Worktree, main bool) bool {
+			return !main && filepath.Clean(wt.Path) != current
+		})
+		if !ok {
+			return
+		}
+	}
+	if err := w.gitClient.RemoveWorktree(path, force); err != nil {
+		WriteError(w.outputWriter, err)
+		if !force {
+			WriteLinef(w.outputWriter, "To remove a worktree with local changes, run 'ggc worktree remove force %s'.", path)
+		}
+		return
+	}
+	WriteLinef(w.outputWriter, "Removed worktree %s", path)
+}
+
+func (w *WorktreeManager) lock(args []string) {
+	path := optionalArg(args)
+	reason := ""
+	if len(args) > 1 {
+		reason = strings.Join(args[1:], " ")
+	}
+	if path == "" {
+		var ok bool
+		if path, ok = w.selectWorktree("lock", func(wt git.Worktree, main bool) bool { return !main && !wt.Locked }); !ok {
+			return
+		}
+		if reason, ok = ReadLine(w.prompter, w.outputWriter, "Reason (optional): "); !ok {
+			return
+		}
+	}
+	if err := w.gitClient.LockWorktree(path, strings.TrimSpace(reason)); err != nil {
+		WriteError(w.outputWriter, err)
+		return
+	}
+	WriteLinef(w.outputWriter, "Locked worktree %s", path)
+}
+
+func (w *WorktreeManager) unlock(args []string) {
+	if len(args) > 1 {
+		w.helper.ShowWorktreeHelp()
+		return
+	}
+	path := optionalArg(args)
+	if path == "" {
+		var ok bool
+		if path, ok = w.selectWorktree("unlock", func(wt git.Worktree, _ bool) bool { return wt.Locked }); !ok {
+			return
+		}
+	}
+	if err := w.gitClient.UnlockWorktree(path); err != nil {
+		WriteError(w.outputWriter, err)
+		return
+	}
+	WriteLinef(w.outputWriter, "Unlocked worktree %s", path)
+}
+
+// worktreeSources are the kinds of branch add can check out.
+var worktreeSources = []string{
+	"New branch from the current HEAD",
+	"Existing local branch",
+	"Remote branch",
+}
+
+// add creates a worktree. Without arguments it asks what to check out and
+// where.
+func (w *WorktreeManager) add(args []string) {
+	if len(args) == 0 {
+		w.addInteractive()
+		return
+	}
+	if args[0] == "new" {
+		if len(args) < 2 || len(args) > 3 {
+			w.helper.ShowWorktreeHelp()
+			return
+		}
+		branch := strings.TrimSpace(args[1])
+		if err := w.gitClient.ValidateBranchName(branch); err != nil {
+			WriteErrorf(w.outputWriter, "invalid branch name: %v", err)
+			return
+		}
+		w.create(branch, "", optionalArg(args[2:]))
+		return
+	}
+	if len(args) > 2 {
+		w.helper.ShowWorktreeHelp()
+		return
+	}
+	w.addExisting(args[0], optionalArg(args[1:]))
+}
+
+// addExisting checks out a local branch, or creates a local branch that
+// tracks a remote branch such as origin/foo.
+func (w *WorktreeManager) addExisting(branch, path string) {
+	locals, err := w.gitClient.ListLocalBranches()
+	if err != nil {
+		WriteError(w.outputWriter, err)
+		return
+	}
+	if slices.Contains(locals, branch) {
+		w.checkout(branch, path)
+		return
+	}
+	remotes, err := w.gitClient.ListRemoteBranches()
+	if err != nil {
+		WriteError(w.outputWriter, err)
+		return
+	}
+	if slices.Contains(remotes, branch) {
+		local, valid := deriveLocalFromRemote(branch)
+		if !valid || w.gitClient.ValidateBranchName(local) != nil {
+			WriteLine(w.outputWriter, "Invalid remote branch name.")
+			return
+		}
+		w.create(local, branch, path)
+		return
+	}
+	WriteErrorf(w.outputWriter, "unknown branch '%s'; run 'ggc worktree add new %s' to create it", branch, branch)
+}
+
+func (w *WorktreeManager) addInteractive() {
+	if w.prompter == nil {
+		return
+	}
+	idx, canceled, err := w.prompter.Select("Check out:", worktreeSources, "Enter the number of what to check out: ")
+	if canceled {
+		return
+	}
+	if err != nil {
+		if errors.Is(err, prompt.ErrInvalidSelection) {
+			WriteLine(w.outputWriter, "Invalid number.")
+		} else {
+			WriteError(w.outputWriter, err)
+		}
+		return
+	}
+
+	switch idx {
+	case 0:
+		input, ok := ReadLine(w.prompter, w.outputWriter, "Enter new branch name: ")
+		if !ok {
+			return
+		}
+		branch := strings.TrimSpace(input)
+		if branch == "" {
+			WriteLine(w.outputWriter, "Canceled.")
+			return
+		}
+		if err := w.gitClient.ValidateBranchName(branch); err != nil {
+			WriteErrorf(w.outputWriter, "invalid branch name: %v", err)
+			return
+		}
+		if path, ok := w.readPath(branch); ok {
+			w.create(branch, "", path)
+		}
+	case 1:
+		branch, ok := w.brancher.pickLocalBranch("Enter the number of the branch to check out: ")
+		if !ok {
+			return
+		}
+		if path, ok := w.readPath(branch); ok {
+			w.checkout(branch, path)
+		}
+	case 2:
+		remote, local, ok := w.brancher.pickRemoteBranch("Enter the number of the branch to check out: ")
+		if !ok {
+			return
+		}
+		if path, ok := w.readPath(local); ok {
+			w.create(local, remote, path)
+		}
+	}
+}
+
+// readPath asks where to create the worktree for branch, offering the
+// default path.
+func (w *WorktreeManager) readPath(branch string) (string, bool) {
+	path, ok := w.defaultPath(branch)
+	if !ok {
+		return "", false
+	}
+	input, ok := ReadLine(w.prompter, w.outputWriter, fmt.Sprintf("Path (Enter for %s): ", path))
+	if !ok {
+		return "", false
+	}
+	if input = strings.TrimSpace(input); input != "" {
+		path = input
+	}
+	return path, true
+}
+
+// defaultPath returns where the worktree for branch goes when no path is
+// given: <root>/<repository>-<branch>, with the slashes of the branch name
+// replaced by dashes.
+func (w *WorktreeManager) defaultPath(branch string) (string, bool) {
+	worktrees, ok := w.load()
+	if !ok {
+		return "", false
+	}
+	if len(worktrees) == 0 {
+		WriteErrorf(w.outputWriter, "no main worktree found")
+		return "", false
+	}
+	main := worktrees[0].Path
+	root := w.root
+	switch {
+	case root == "":
+		root = filepath.Dir(main)
+	case root == "~" || strings.HasPrefix(root, "~/"):
+		home, err := os.UserHomeDir()
+		if err != nil {
+			WriteError(w.outputWriter, err)
+			return "", false
+		}
+		root = filepath.Join(home, root[1:])
+	case !filepath.IsAbs(root):
+		root = filepath.Join(main, root)
+	}
+	repo := strings.TrimSuffix(filepath.Base(main), ".git")
+	return filepath.Join(root, repo+"-"+strings.ReplaceAll(branch, "/", "-")), true
+}
+
+func (w *WorktreeManager) checkout(branch, path string) {
+	if path == "" {
+		var ok bool
+		if path, ok = w.defaultPath(branch); !ok {
+			return
+		}
+	}
+	if err := w.gitClient.AddWorktree(path, branch); err != nil {
+		WriteError(w.outputWriter, err)
+		return
+	}
+	WriteLinef(w.outputWriter, "Created worktree %s on %s", path, branch)
+}
+
+// create adds a worktree on a new branch started from startPoint.
+func (w *WorktreeManager) create(branch, startPoint, path string) {
+	if path == "" {
+		var ok bool
+		if path, ok = w.defaultPath(branch); !ok {
+			return
+		}
+	}
+	if err := w.gitClient.AddWorktreeNewBranch(path, branch, startPoint); err != nil {
+		WriteError(w.outputWriter, err)
+		return
+	}
+	WriteLinef(w.outputWriter, "Created worktree %s on new branch %s", path, branch)
+}
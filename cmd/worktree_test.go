@@ -0,0 +1,265 @@
+package cmd
+
+import (
+	"bytes"
+	"errors"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/bmf-san/ggc/v8/internal/git"
+	"github.com/bmf-san/ggc/v8/internal/prompt"
+)
+
+// mockWorktreeClient implements the worktree and branch operations used by
+// WorktreeManager and records the worktree commands run.
+type mockWorktreeClient struct {
+	*mockBranchGitClient
+	calls     []string
+	worktrees []git.Worktree
+	root      string
+	removeErr error
+}
+
+func (m *mockWorktreeClient) ListWorktrees() ([]git.Worktree, error) { return m.worktrees, nil }
+func (m *mockWorktreeClient) AddWorktree(path, branch string) error {
+	m.calls = append(m.calls, "add "+path+" "+branch)
+	return nil
+}
+func (m *mockWorktreeClient) AddWorktreeNewBranch(path, branch, startPoint string) error {
+	m.calls = append(m.calls, strings.TrimSpace("add -b "+branch+" "+path+" "+startPoint))
+	return nil
+}
+func (m *mockWorktreeClient) RemoveWorktree(path string, force bool) error {
+	call := "remove " + path
+	if force {
+		call = "remove --force " + path
+	}
+	m.calls = append(m.calls, call)
+	return m.removeErr
+}
+func (m *mockWorktreeClient) PruneWorktrees() error {
+	m.calls = append(m.calls, "prune")
+	return nil
+}
+func (m *mockWorktreeClient) LockWorktree(path, reason string) error {
+	m.calls = append(m.calls, strings.TrimSpace("lock "+path+" "+reason))
+	return nil
+}
+func (m *mockWorktreeClient) UnlockWorktree(path string) error {
+	m.calls = append(m.calls, "unlock "+path)
+	return nil
+}
+func (m *mockWorktreeClient) GetRepositoryRoot() (string, error) { return m.root, nil }
+
+var _ git.WorktreeOps = (*mockWorktreeClient)(nil)
+
+func newMockWorktreeClient() *mockWorktreeClient {
+	return &mockWorktreeClient{
+		mockBranchGitClient: &mockBranchGitClient{},
+		root:                "/src/app",
+		worktrees: []git.Worktree{
+			{Path: "/src/app", Head: "1111111aaaa", Branch: "main"},
+			{Path: "/src/app-feature-test", Head: "2222222bbbb", Branch: "feature/test"},
+			{Path: "/tmp/spike", Head: "3333333cccc", Detached: true, Locked: true, LockReason: "usb"},
+		},
+	}
+}
+
+func newTestWorktreeManager(client *mockWorktreeClient, buf *bytes.Buffer, input string) *WorktreeManager {
+	helper := NewHelper()
+	helper.outputWriter = buf
+	p := prompt.New(strings.NewReader(input), buf)
+	return &WorktreeManager{
+		gitClient: client,
+		brancher: &Brancher{
+			gitClient:    client,
+			prompter:     p,
+			outputWriter: buf,
+			helper:       helper,
+		},
+		outputWriter: buf,
+		helper:       helper,
+		prompter:     p,
+	}
+}
+
+func TestWorktreeManager_List(t *testing.T) {
+	var buf bytes.Buffer
+	newTestWorktreeManager(newMockWorktreeClient(), &buf, "").Worktree(nil)
+
+	lines := strings.Split(strings.TrimRight(buf.String(), "\n"), "\n")
+	if len(lines) != 3 {
+		t.Fatalf("expected 3 worktrees, got %q", buf.String())
+	}
+	if !strings.Contains(lines[0], "*") || !strings.Contains(lines[0], "/src/app ") || !strings.Contains(lines[0], "main") {
+		t.Errorf("main worktree should be marked current, got %q", lines[0])
+	}
+	if strings.Contains(lines[1], "*") {
+		t.Errorf("only the current worktree should be marked, got %q", lines[1])
+	}
+	if !strings.Contains(lines[2], "(detached at 3333333)") || !strings.Contains(lines[2], "locked (usb)") {
+		t.Errorf("detached worktree line = %q", lines[2])
+	}
+}
+
+func TestWorktreeManager_AddArgs(t *testing.T) {
+	tests := []struct {
+		name     string
+		args     []string
+		wantCall string
+	}{
+		{"local_branch", []string{"add", "feature/test"}, "add /src/app-feature-test feature/test"},
+		{"local_branch_path", []string{"add", "main", "../wt"}, "add ../wt main"},
+		{"remote_branch", []string{"add", "origin/main"}, "add -b main /src/app-main origin/main"},
+		{"new_branch", []string{"add", "new", "spike"}, "add -b spike /src/app-spike"},
+		{"new_branch_path", []string{"add", "new", "spike", "/tmp/s"}, "add -b spike /tmp/s"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			client := newMockWorktreeClient()
+			newTestWorktreeManager(client, &buf, "").Worktree(tt.args)
+
+			if !slices.Equal(client.calls, []string{tt.wantCall}) {
+				t.Errorf("calls = %v, want [%s]; output %q", client.calls, tt.wantCall, buf.String())
+			}
+			if !strings.Contains(buf.String(), "Created worktree") {
+				t.Errorf("expected a success message, got %q", buf.String())
+			}
+		})
+	}
+}
+
+func TestWorktreeManager_AddUnknownBranch(t *testing.T) {
+	var buf bytes.Buffer
+	client := newMockWorktreeClient()
+	newTestWorktreeManager(client, &buf, "").Worktree([]string{"add", "nope"})
+
+	if len(client.calls) != 0 {
+		t.Errorf("expected no worktree to be added, got %v", client.calls)
+	}
+	if !strings.Contains(buf.String(), "ggc worktree add new nope") {
+		t.Errorf("expected a hint to create the branch, got %q", buf.String())
+	}
+}
+
+func TestWorktreeManager_AddInteractive(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		wantCall string
+	}{
+		{"new_branch", "1\nspike\n\n", "add -b spike /src/app-spike"},
+		{"local_branch", "2\n2\n\n", "add /src/app-feature-test feature/test"},
+		{"remote_branch_custom_path", "3\n1\n../m\n", "add -b main ../m origin/main"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			client := newMockWorktreeClient()
+			newTestWorktreeManager(client, &buf, tt.input).Worktree([]string{"add"})
+
+			if !slices.Equal(client.calls, []string{tt.wantCall}) {
+				t.Errorf("calls = %v, want [%s]; output %q", client.calls, tt.wantCall, buf.String())
+			}
+		})
+	}
+}
+
+func TestWorktreeManager_DefaultPathUsesRoot(t *testing.T) {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		t.Skip("no home directory")
+	}
+	tests := []struct {
+		root string
+		want string
+	}{
+		{"", "/src/app-feature-x"},
+		{"/wt", "/wt/app-feature-x"},
+		{".worktrees", "/src/app/.worktrees/app-feature-x"},
+		{"~/wt", filepath.Join(home, "wt", "app-feature-x")},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.root, func(t *testing.T) {
+			var buf bytes.Buffer
+			w := newTestWorktreeManager(newMockWorktreeClient(), &buf, "")
+			w.root = tt.root
+			got, ok := w.defaultPath("feature/x")
+			if !ok || got != filepath.FromSlash(tt.want) {
+				t.Errorf("defaultPath() = %q, %v, want %q", got, ok, tt.want)
+			}
+		})
+	}
+}
+
+func TestWorktreeManager_Remove(t *testing.T) {
+	var buf bytes.Buffer
+	client := newMockWorktreeClient()
+	// The main worktree is not offered, so 1 is the feature worktree.
+	newTestWorktreeManager(client, &buf, "1\n").Worktree([]string{"remove"})
+
+	if !slices.Equal(client.calls, []string{"remove /src/app-feature-test"}) {
+		t.Errorf("calls = %v", client.calls)
+	}
+	if strings.Contains(buf.String(), "[1] /src/app ") {
+		t.Errorf("main worktree should not be offered, got %q", buf.String())
+	}
+}
+
+func TestWorktreeManager_RemoveFailureSuggestsForce(t *testing.T) {
+	var buf bytes.Buffer
+	client := newMockWorktreeClient()
+	client.removeErr = errors.New("contains modified or untracked files")
+	newTestWorktreeManager(client, &buf, "").Worktree([]string{"remove", "/tmp/spike"})
+
+	if !strings.Contains(buf.String(), "ggc worktree remove force /tmp/spike") {
+		t.Errorf("expected a force hint, got %q", buf.String())
+	}
+
+	buf.Reset()
+	client.calls = nil
+	newTestWorktreeManager(client, &buf, "").Worktree([]string{"remove", "force", "/tmp/spike"})
+	if !slices.Equal(client.calls, []string{"remove --force /tmp/spike"}) {
+		t.Errorf("calls = %v", client.calls)
+	}
+}
+
+func TestWorktreeManager_LockUnlockPrune(t *testing.T) {
+	tests := []struct {
+		args     []string
+		input    string
+		wantCall string
+	}{
+		{[]string{"lock", "/tmp/wt", "on", "usb"}, "", "lock /tmp/wt on usb"},
+		{[]string{"lock"}, "1\nreview\n", "lock /src/app-feature-test review"},
+		{[]string{"unlock"}, "1\n", "unlock /tmp/spike"},
+		{[]string{"unlock", "/tmp/wt"}, "", "unlock /tmp/wt"},
+		{[]string{"prune"}, "", "prune"},
+	}
+
+	for _, tt := range tests {
+		t.Run(strings.Join(tt.args, " "), func(t *testing.T) {
+			var buf bytes.Buffer
+			client := newMockWorktreeClient()
+			newTestWorktreeManager(client, &buf, tt.input).Worktree(tt.args)
+
+			if !slices.Equal(client.calls, []string{tt.wantCall}) {
+				t.Errorf("calls = %v, want [%s]; output %q", client.calls, tt.wantCall, buf.String())
+			}
+		})
+	}
+}
+
+func TestNewWorktreeManager_SharesPrompter(t *testing.T) {
+	w := NewWorktreeManager(newMockWorktreeClient())
+	if w.brancher.prompter != w.prompter {
+		t.Error("the branch pickers should read from the same prompter as the worktree command")
+	}
+}
@@ -32,8 +32,17 @@ type Config struct {
 	} `yaml:"default"`
 
 	UI struct {
-		Color bool `yaml:"color"`
-		Pager bool `yaml:"pager"`
+		// Color is "auto", "always" or "never"; auto colors output only when
+		// stdout is a terminal. true and false are read as auto and never.
+		Color string `yaml:"color"`
+		Pager bool   `yaml:"pager"`
+		// NoPager lists commands (diff, blame, show, tag, stash) whose output is never paged.
+		NoPager []string `yaml:"no-pager,omitempty"`
+		// Style is "auto", "rich" or "plain"; plain output uses ASCII labels
+		// instead of emoji and box drawing. auto selects plain for TERM=dumb.
+		Style string `yaml:"style,omitempty"`
+		// Theme colors the interactive UI.
+		Theme ThemeConfig `yaml:"theme,omitempty"`
 	} `yaml:"ui"`
 
 	Interactive struct {
@@ -55,8 +64,17 @@ type Config struct {
 			WorkflowCreate     string `yaml:"workflow_create"`
 			WorkflowDelete     string `yaml:"workflow_delete"`
 			SoftCancel         string `yaml:"soft_cancel"`
+			TogglePreview      string `yaml:"toggle_preview"`
+			ToggleCategoryView string `yaml:"toggle_category_view"`
 		} `yaml:"keybindings"`
 
+		// Commands binds keys (ctrl+<letter> or alt+<letter>) to a command
+		// line, alias or workflow that runs as soon as the key is pressed.
+		Commands map[string]string `yaml:"commands,omitempty"`
+
+		// Pinned lists the commands pinned in the interactive category browser.
+		Pinned []string `yaml:"pinned,omitempty"`
+
 		Contexts struct {
 			Input   KeybindingsConfig `yaml:"input,omitempty"`
 			Results KeybindingsConfig `yaml:"results,omitempty"`
@@ -83,6 +101,22 @@ type Config struct {
 	Git struct {
 		DefaultRemote string `yaml:"default-remote"`
 	} `yaml:"git"`
+
+	Diff struct {
+		// View is the default ggc diff view: "unified" (git's output),
+		// "side" or "word".
+		View string `yaml:"view,omitempty"`
+		// Collapse lists path patterns (such as go.sum or *.lock) whose
+		// changes are summarized instead of shown in the side and word views.
+		Collapse []string `yaml:"collapse,omitempty"`
+	} `yaml:"diff,omitempty"`
+
+	Worktree struct {
+		// Root is the directory ggc worktree add creates worktrees in. A
+		// relative path is resolved against the main worktree, and ~ means
+		// the home directory. Empty creates them next to the main worktree.
+		Root string `yaml:"root,omitempty"`
+	} `yaml:"worktree,omitempty"`
 }
 
 // Manager handles configuration loading, saving, and operations
@@ -116,8 +150,9 @@ func getDefaultConfig(gitClient git.ConfigOps) *Config {
 	config.Default.Editor = "vim"
 	config.Default.MergeTool = "vimdiff"
 
-	config.UI.Color = true
+	config.UI.Color = "auto"
 	config.UI.Pager = true
+	config.UI.Style = "auto"
 
 	config.Behavior.AutoPush = false
 	config.Behavior.ConfirmDestructive = "simple"
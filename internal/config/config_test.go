@@ -12,6 +12,7 @@ import (
 	"go.yaml.in/yaml/v3"
 
 	"github.com/bmf-san/ggc/v8/internal/testutil"
+	uiutil "github.com/bmf-san/ggc/v8/internal/ui"
 )
 
 // MockFileOps implements FileOps for testing
@@ -136,8 +137,8 @@ func TestGetDefaultConfig(t *testing.T) {
 	}
 
 	// Test UI defaults
-	if !config.UI.Color {
-		t.Error("Expected UI color to be true")
+	if config.UI.Color != "auto" {
+		t.Errorf("Expected UI color to be 'auto', got %s", config.UI.Color)
 	}
 	if !config.UI.Pager {
 		t.Error("Expected UI pager to be true")
@@ -265,8 +266,8 @@ git:
 	if cm.config.Default.Editor != "nano" {
 		t.Errorf("Expected editor to be 'nano', got %s", cm.config.Default.Editor)
 	}
-	if cm.config.UI.Color {
-		t.Error("Expected color to be false")
+	if cm.config.UI.Color != "false" {
+		t.Errorf("Expected color to be 'false', got %s", cm.config.UI.Color)
 	}
 	if cm.config.Behavior.AutoPush != true {
 		t.Error("Expected auto-push to be true")
@@ -319,7 +320,7 @@ func TestSave(t *testing.T) {
 	cm.configPath = configPath
 
 	cm.config.Default.Branch = "development"
-	cm.config.UI.Color = false
+	cm.config.UI.Color = "never"
 	cm.config.Aliases["test"] = "help"
 
 	err = cm.SaveWithFileOps(mockFS)
@@ -347,8 +348,8 @@ func TestSave(t *testing.T) {
 	if loadedConfig.Default.Branch != "development" {
 		t.Errorf("Expected saved branch to be 'development', got %s", loadedConfig.Default.Branch)
 	}
-	if loadedConfig.UI.Color {
-		t.Error("Expected saved color to be false")
+	if loadedConfig.UI.Color != "never" {
+		t.Errorf("Expected saved color to be 'never', got %s", loadedConfig.UI.Color)
 	}
 	if loadedConfig.Aliases["test"] != "help" {
 		t.Errorf("Expected saved alias to be 'help', got %s", loadedConfig.Aliases["test"])
@@ -394,7 +395,7 @@ func TestGetValueByPath(t *testing.T) {
 	}{
 		{"default.branch", "main"},
 		{"default.editor", "vim"},
-		{"ui.color", true},
+		{"ui.color", "auto"},
 		{"behavior.auto-push", false},
 		{"git.default-remote", "origin"},
 	}
@@ -420,7 +421,7 @@ func TestGetValueByPathErrors(t *testing.T) {
 		"nonexistent.field",
 		"default.nonexistent",
 		"aliases.nonexistent",
-		"ui.color.invalid", // trying to navigate into a bool
+		"ui.pager.invalid", // trying to navigate into a bool
 	}
 
 	for _, path := range testCases {
@@ -442,7 +443,8 @@ func TestSetValueByPath(t *testing.T) {
 	}{
 		{"default.branch", "develop", "develop"},
 		{"default.editor", "emacs", "emacs"},
-		{"ui.color", false, false},
+		{"ui.color", "always", "always"},
+		{"ui.color", false, "false"},
 		{"behavior.auto-push", true, true},
 		{"aliases.new", "new-command", "new-command"},
 	}
@@ -551,8 +553,8 @@ func TestList(t *testing.T) {
 	if list["default.branch"] != "main" {
 		t.Errorf("Expected default.branch to be 'main', got %v", list["default.branch"])
 	}
-	if list["ui.color"] != true {
-		t.Errorf("Expected ui.color to be true, got %v", list["ui.color"])
+	if list["ui.color"] != "auto" {
+		t.Errorf("Expected ui.color to be 'auto', got %v", list["ui.color"])
 	}
 
 	if !strings.Contains(stringifyAnyMap(list), "aliases") {
@@ -752,13 +754,19 @@ func TestTypeConversion(t *testing.T) {
 	}
 
 	// Test setting bool value to bool field
-	err = cm.setValueByPath(cm.config, "ui.color", false)
+	err = cm.setValueByPath(cm.config, "ui.pager", false)
 	if err != nil {
 		t.Errorf("Failed to set bool value: %v", err)
 	}
 
+	// Test setting bool value to string field
+	err = cm.setValueByPath(cm.config, "ui.color", false)
+	if err != nil || cm.config.UI.Color != "false" {
+		t.Errorf("Failed to set bool value to string field: %v, %q", err, cm.config.UI.Color)
+	}
+
 	// Test type conversion error
-	err = cm.setValueByPath(cm.config, "ui.color", "invalid_bool")
+	err = cm.setValueByPath(cm.config, "ui.pager", "invalid_bool")
 	if err == nil {
 		t.Error("Expected error when setting invalid type")
 	}
@@ -770,7 +778,7 @@ func TestConfig_Validate(t *testing.T) {
 		cfg.Default.Branch = "main"
 		cfg.Default.Editor = "vim"
 		cfg.Default.MergeTool = "meld"
-		cfg.UI.Color = true
+		cfg.UI.Color = "always"
 		cfg.UI.Pager = false
 		cfg.Behavior.AutoPush = true
 		cfg.Behavior.ConfirmDestructive = "simple"
@@ -2289,8 +2297,8 @@ func TestSyncUISettings_ColorError(t *testing.T) {
 func TestSyncUISettings_PagerError(t *testing.T) {
 	cm := newTestConfigManagerWithFailingGit("core.pager")
 	cfg := cm.GetConfig()
-	cfg.UI.Color = true  // color.ui will call ConfigSetGlobal("color.ui","true") → passes
-	cfg.UI.Pager = false // triggers the pager branch → fails
+	cfg.UI.Color = "auto" // color.ui will call ConfigSetGlobal("color.ui","auto") → passes
+	cfg.UI.Pager = false  // triggers the pager branch → fails
 	err := cm.syncUISettings(cfg)
 	if err == nil {
 		t.Error("expected error when core.pager ConfigSetGlobal fails")
@@ -2395,3 +2403,150 @@ func TestReplaceConfigFileWithOps_RenameError(t *testing.T) {
 		t.Error("expected error when rename fails")
 	}
 }
+
+func TestConfig_ValidateCommandKeys(t *testing.T) {
+	tests := []struct {
+		name     string
+		commands map[string]string
+		wantErr  string
+	}{
+		{name: "ctrl command", commands: map[string]string{"ctrl+s": "status short"}},
+		{name: "alt alias", commands: map[string]string{"alt+p": "sync"}},
+		{name: "workflow", commands: map[string]string{"M-r": "release"}},
+		{name: "placeholder", commands: map[string]string{"^b": "branch checkout <branch>"}},
+		{name: "unknown command", commands: map[string]string{"ctrl+s": "bogus"}, wantErr: "not a valid ggc command"},
+		{name: "empty command", commands: map[string]string{"ctrl+s": "  "}, wantErr: "command cannot be empty"},
+		{name: "metacharacters", commands: map[string]string{"ctrl+s": "status; rm -rf /"}, wantErr: "unsafe shell metacharacters"},
+		{name: "reserved key", commands: map[string]string{"ctrl+m": "status"}, wantErr: "reserved"},
+		{name: "plain letter", commands: map[string]string{"s": "status"}, wantErr: "unsupported command key"},
+		{name: "non-letter", commands: map[string]string{"alt+1": "status"}, wantErr: "must be a letter"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Config{
+				Aliases:   map[string]interface{}{"sync": []interface{}{"pull current", "push current"}},
+				Workflows: map[string][]string{"release": {"tag create <version>"}},
+			}
+			c.Interactive.Commands = tt.commands
+			err := c.validateCommandKeys()
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Fatalf("error = %v, want it to contain %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestConfig_ValidateUIColor(t *testing.T) {
+	tests := []struct {
+		color string
+		want  uiutil.ColorMode
+	}{
+		{"", uiutil.ColorAuto},
+		{"auto", uiutil.ColorAuto},
+		{"always", uiutil.ColorAlways},
+		{"never", uiutil.ColorNever},
+		{"true", uiutil.ColorAuto},
+		{"false", uiutil.ColorNever},
+	}
+	for _, tt := range tests {
+		cfg := &Config{}
+		cfg.UI.Color = tt.color
+		if err := cfg.validateUIColor(); err != nil {
+			t.Errorf("ui.color %q: unexpected error %v", tt.color, err)
+		}
+		if got, _ := cfg.ColorMode(); got != tt.want {
+			t.Errorf("ui.color %q: ColorMode() = %v, want %v", tt.color, got, tt.want)
+		}
+	}
+
+	cfg := &Config{}
+	cfg.UI.Color = "sometimes"
+	err := cfg.validateUIColor()
+	if err == nil || !strings.Contains(err.Error(), "ui.color") {
+		t.Errorf("ui.color \"sometimes\": expected a ui.color validation error, got %v", err)
+	}
+}
+
+func TestConfig_ValidateUINoPager(t *testing.T) {
+	cfg := &Config{}
+	cfg.UI.NoPager = []string{"diff", "blame", "show", "tag", "stash"}
+	if err := cfg.validateUINoPager(); err != nil {
+		t.Errorf("unexpected error %v", err)
+	}
+
+	cfg.UI.NoPager = []string{"diff", "stahs"}
+	err := cfg.validateUINoPager()
+	if err == nil || !strings.Contains(err.Error(), "ui.no-pager") || !strings.Contains(err.Error(), "stahs") {
+		t.Errorf("expected a ui.no-pager validation error naming stahs, got %v", err)
+	}
+}
+
+func TestConfig_ValidateUIStyle(t *testing.T) {
+	for _, style := range []string{"", "auto", "rich", "plain"} {
+		cfg := &Config{}
+		cfg.UI.Style = style
+		if err := cfg.validateUIStyle(); err != nil {
+			t.Errorf("ui.style %q: unexpected error %v", style, err)
+		}
+	}
+
+	cfg := &Config{}
+	cfg.UI.Style = "fancy"
+	err := cfg.validateUIStyle()
+	if err == nil || !strings.Contains(err.Error(), "ui.style") {
+		t.Errorf("ui.style \"fancy\": expected a ui.style validation error, got %v", err)
+	}
+}
+
+func TestConfig_ValidateUITheme(t *testing.T) {
+	cfg := &Config{}
+	if err := cfg.validateUITheme(); err != nil {
+		t.Errorf("empty theme: unexpected error %v", err)
+	}
+
+	cfg.UI.Theme.Name = "light"
+	cfg.UI.Theme.Selected = "black bg:#fdf6e3 bold"
+	cfg.UI.Theme.Branch = "33"
+	if err := cfg.validateUITheme(); err != nil {
+		t.Errorf("valid theme: unexpected error %v", err)
+	}
+	if got := cfg.UI.Theme.Overrides(); len(got) != 2 || got["selected"] != "black bg:#fdf6e3 bold" || got["branch"] != "33" {
+		t.Errorf("Overrides() = %v", got)
+	}
+
+	cfg.UI.Theme.Branch = "#zzz"
+	err := cfg.validateUITheme()
+	if err == nil || !strings.Contains(err.Error(), "ui.theme.branch") {
+		t.Errorf("expected a ui.theme.branch validation error, got %v", err)
+	}
+
+	cfg.UI.Theme.Branch = ""
+	cfg.UI.Theme.Name = "neon"
+	err = cfg.validateUITheme()
+	if err == nil || !strings.Contains(err.Error(), "ui.theme.name") {
+		t.Errorf("expected a ui.theme.name validation error, got %v", err)
+	}
+}
+
+func TestConfig_ValidateDiffView(t *testing.T) {
+	cfg := &Config{}
+	for _, view := range []string{"", "unified", "side", "word"} {
+		cfg.Diff.View = view
+		if err := cfg.validateDiffView(); err != nil {
+			t.Errorf("diff.view %q: unexpected error %v", view, err)
+		}
+	}
+
+	cfg.Diff.View = "split"
+	err := cfg.validateDiffView()
+	if err == nil || !strings.Contains(err.Error(), "diff.view") {
+		t.Errorf("expected a diff.view validation error, got %v", err)
+	}
+}
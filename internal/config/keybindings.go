@@ -29,6 +29,8 @@ func (c *Config) validateKeybindings() error {
 		"workflow_create":      c.Interactive.Keybindings.WorkflowCreate,
 		"workflow_delete":      c.Interactive.Keybindings.WorkflowDelete,
 		"soft_cancel":          c.Interactive.Keybindings.SoftCancel,
+		"toggle_preview":       c.Interactive.Keybindings.TogglePreview,
+		"toggle_category_view": c.Interactive.Keybindings.ToggleCategoryView,
 	}
 
 	for action, keyStr := range bindings {
@@ -45,6 +47,11 @@ func (c *Config) validateKeybindings() error {
 		}
 	}
 
+	// Validate keys bound directly to commands
+	if err := c.validateCommandKeys(); err != nil {
+		return err
+	}
+
 	// Validate context-specific keybindings
 	if err := c.validateContextKeybindings(); err != nil {
 		return err
@@ -82,6 +89,67 @@ func (c *Config) validateProfile() error {
 	return nil
 }
 
+// reservedCommandKeys are the Ctrl+<letter> keys that terminals send as
+// Ctrl+C, Backspace, Tab and Enter, so they cannot run commands.
+var reservedCommandKeys = map[byte]bool{'c': true, 'h': true, 'i': true, 'j': true, 'm': true}
+
+// validateCommandKeys validates keys bound directly to commands, aliases or
+// workflows in interactive mode.
+func (c *Config) validateCommandKeys() error {
+	for key, command := range c.Interactive.Commands {
+		field := "interactive.commands." + key
+		if err := ValidateCommandKey(key); err != nil {
+			return &ValidationError{Field: field, Value: key, Message: err.Error()}
+		}
+		words := strings.Fields(command)
+		if len(words) == 0 {
+			return &ValidationError{Field: field, Value: command, Message: "command cannot be empty"}
+		}
+		if _, isAlias := c.Aliases[words[0]]; isAlias {
+			continue
+		}
+		if _, isWorkflow := c.Workflows[words[0]]; isWorkflow && len(words) == 1 {
+			continue
+		}
+		cleaned := angleBracketPlaceholderRe.ReplaceAllString(command, "")
+		if err := defaultValidator.validateCommand(cleaned); err != nil {
+			return &ValidationError{Field: field, Value: command, Message: err.Error()}
+		}
+	}
+	return nil
+}
+
+// ValidateCommandKey validates a key bound to a command. Only Ctrl and Alt
+// letter combinations are accepted so that command keys never block typing.
+func ValidateCommandKey(keyStr string) error {
+	s := strings.ToLower(strings.TrimSpace(keyStr))
+	var letter string
+	isCtrl := false
+	switch {
+	case strings.HasPrefix(s, "ctrl+"):
+		letter, isCtrl = s[len("ctrl+"):], true
+	case strings.HasPrefix(s, "^"):
+		letter, isCtrl = s[1:], true
+	case strings.HasPrefix(s, "c-"):
+		letter, isCtrl = s[2:], true
+	case strings.HasPrefix(s, "alt+"):
+		letter = s[len("alt+"):]
+	case strings.HasPrefix(s, "meta+"):
+		letter = s[len("meta+"):]
+	case strings.HasPrefix(s, "m-"):
+		letter = s[2:]
+	default:
+		return fmt.Errorf("unsupported command key: %s (supported: 'ctrl+<letter>', 'alt+<letter>')", keyStr)
+	}
+	if len(letter) != 1 || letter[0] < 'a' || letter[0] > 'z' {
+		return fmt.Errorf("unsupported command key: %s (the key must be a letter)", keyStr)
+	}
+	if isCtrl && reservedCommandKeys[letter[0]] {
+		return fmt.Errorf("%s is reserved and cannot run a command", keyStr)
+	}
+	return nil
+}
+
 // validateContextKeybindings validates context-specific keybindings
 func (c *Config) validateContextKeybindings() error {
 	contexts := map[string]map[string]interface{}{
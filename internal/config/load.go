@@ -87,7 +87,7 @@ func (cm *Manager) syncFromCommandName(command string) {
 	case "init.defaultBranch":
 		cm.config.Default.Branch = value
 	case "color.ui":
-		cm.config.UI.Color = value == "true" || value == "auto"
+		cm.config.UI.Color = colorSettingFromGit(value)
 	case "core.pager":
 		cm.config.UI.Pager = value != "cat"
 	case "fetch.auto":
@@ -95,6 +95,17 @@ func (cm *Manager) syncFromCommandName(command string) {
 	}
 }
 
+// colorSettingFromGit maps git's color.ui to a ui.color value.
+func colorSettingFromGit(value string) string {
+	switch value {
+	case "true", "auto":
+		return "auto"
+	case "always":
+		return "always"
+	}
+	return "never"
+}
+
 // syncFromGitConfig imports relevant Git config values into your app config
 func (cm *Manager) syncFromGitConfig() {
 	commands := []string{
@@ -3,6 +3,7 @@ package config
 import (
 	"fmt"
 	"reflect"
+	"strconv"
 	"strings"
 )
 
@@ -185,6 +186,11 @@ func (cm *Manager) setStructField(current reflect.Value, fieldName string, value
 	}
 
 	newValue := reflect.ValueOf(value)
+	// String settings such as ui.color also take true and false, which
+	// arrive as booleans from the config command.
+	if field.Kind() == reflect.String && newValue.Kind() == reflect.Bool {
+		newValue = reflect.ValueOf(strconv.FormatBool(newValue.Bool()))
+	}
 	if !newValue.Type().ConvertibleTo(field.Type()) {
 		return fmt.Errorf("cannot convert %s to %s", newValue.Type(), field.Type())
 	}
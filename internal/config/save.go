@@ -131,11 +131,8 @@ func (cm *Manager) syncDefaultSettings(config *Config) error {
 
 // syncUISettings syncs color and pager settings
 func (cm *Manager) syncUISettings(config *Config) error {
-	colorValue := "false"
-	if config.UI.Color {
-		colorValue = "true"
-	}
-	if err := cm.gitClient.ConfigSetGlobal("color.ui", colorValue); err != nil {
+	mode, _ := config.ColorMode()
+	if err := cm.gitClient.ConfigSetGlobal("color.ui", mode.String()); err != nil {
 		return fmt.Errorf("failed to set git color: %w", err)
 	}
 
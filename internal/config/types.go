@@ -3,6 +3,8 @@ package config
 import (
 	"fmt"
 	"os"
+	"reflect"
+	"strings"
 )
 
 // TempFile interface for temporary file operations
@@ -60,6 +62,50 @@ type KeybindingsConfig struct {
 	Keybindings map[string]interface{} `yaml:"keybindings,omitempty"`
 }
 
+// ThemeConfig selects a builtin color theme and overrides individual roles.
+// Role values are color specs such as "bright-cyan bold", "214" (256-color)
+// or "#268bd2 underline" (truecolor).
+type ThemeConfig struct {
+	Name     string `yaml:"name,omitempty"`
+	Title    string `yaml:"title,omitempty"`
+	Heading  string `yaml:"heading,omitempty"`
+	Text     string `yaml:"text,omitempty"`
+	Emphasis string `yaml:"emphasis,omitempty"`
+	Muted    string `yaml:"muted,omitempty"`
+	Accent   string `yaml:"accent,omitempty"`
+	Command  string `yaml:"command,omitempty"`
+	Key      string `yaml:"key,omitempty"`
+	Selected string `yaml:"selected,omitempty"`
+	Pointer  string `yaml:"pointer,omitempty"`
+	Match    string `yaml:"match,omitempty"`
+	Input    string `yaml:"input,omitempty"`
+	Prompt   string `yaml:"prompt,omitempty"`
+	Branch   string `yaml:"branch,omitempty"`
+	Changes  string `yaml:"changes,omitempty"`
+	Remote   string `yaml:"remote,omitempty"`
+	Success  string `yaml:"success,omitempty"`
+	Warning  string `yaml:"warning,omitempty"`
+	Error    string `yaml:"error,omitempty"`
+	Alias    string `yaml:"alias,omitempty"`
+	Workflow string `yaml:"workflow,omitempty"`
+}
+
+// Overrides returns the configured role overrides keyed by role name.
+func (t ThemeConfig) Overrides() map[string]string {
+	overrides := make(map[string]string)
+	v := reflect.ValueOf(t)
+	for i := 0; i < v.NumField(); i++ {
+		role := strings.Split(v.Type().Field(i).Tag.Get("yaml"), ",")[0]
+		if role == "name" {
+			continue
+		}
+		if spec := v.Field(i).String(); spec != "" {
+			overrides[role] = spec
+		}
+	}
+	return overrides
+}
+
 // AliasType represents the type of alias
 type AliasType int
 
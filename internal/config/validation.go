@@ -4,7 +4,10 @@ import (
 	"fmt"
 	"os"
 	"os/exec"
+	"slices"
 	"strings"
+
+	uiutil "github.com/bmf-san/ggc/v8/internal/ui"
 )
 
 func (c *Config) validateBranch() error {
@@ -61,6 +64,68 @@ func (c *Config) validateConfirmDestructive() error {
 	return nil
 }
 
+// ColorMode returns the color policy selected by ui.color. true and false,
+// the values of the earlier boolean setting, select auto and never.
+func (c *Config) ColorMode() (uiutil.ColorMode, error) {
+	switch strings.ToLower(strings.TrimSpace(c.UI.Color)) {
+	case "", "true":
+		return uiutil.ColorAuto, nil
+	case "false":
+		return uiutil.ColorNever, nil
+	}
+	return uiutil.ParseColorMode(c.UI.Color)
+}
+
+func (c *Config) validateUIColor() error {
+	if _, err := c.ColorMode(); err != nil {
+		return &ValidationError{"ui.color", c.UI.Color, "must be one of: always, auto, never"}
+	}
+	return nil
+}
+
+func (c *Config) validateUIStyle() error {
+	val := c.UI.Style
+	valid := map[string]bool{"": true, "auto": true, "rich": true, "plain": true}
+	if !valid[val] {
+		return &ValidationError{"ui.style", val, "must be one of: auto, rich, plain"}
+	}
+	return nil
+}
+
+// pageableCommands are the commands whose output goes through the pager.
+var pageableCommands = []string{"diff", "blame", "show", "tag", "stash"}
+
+func (c *Config) validateUINoPager() error {
+	for _, command := range c.UI.NoPager {
+		if !slices.Contains(pageableCommands, command) {
+			return &ValidationError{"ui.no-pager", command, "must be one of: " + strings.Join(pageableCommands, ", ")}
+		}
+	}
+	return nil
+}
+
+func (c *Config) validateUITheme() error {
+	theme := c.UI.Theme
+	if _, err := uiutil.NewTheme(theme.Name, nil); err != nil {
+		return &ValidationError{"ui.theme.name", theme.Name, "must be one of: " + strings.Join(uiutil.ThemeNames(), ", ")}
+	}
+	for role, spec := range theme.Overrides() {
+		if _, err := uiutil.ParseColorSpec(spec); err != nil {
+			return &ValidationError{"ui.theme." + role, spec, err.Error()}
+		}
+	}
+	return nil
+}
+
+func (c *Config) validateDiffView() error {
+	val := c.Diff.View
+	valid := map[string]bool{"": true, "unified": true, "side": true, "word": true}
+	if !valid[val] {
+		return &ValidationError{"diff.view", val, "must be one of: unified, side, word"}
+	}
+	return nil
+}
+
 // validateGitDefaultRemote validates git default remote name format
 func (c *Config) validateGitDefaultRemote() error {
 	remote := c.Git.DefaultRemote
@@ -151,9 +216,24 @@ func (c *Config) Validate() error {
 	if err := c.validateConfirmDestructive(); err != nil {
 		return err
 	}
+	if err := c.validateUIColor(); err != nil {
+		return err
+	}
+	if err := c.validateUIStyle(); err != nil {
+		return err
+	}
+	if err := c.validateUINoPager(); err != nil {
+		return err
+	}
+	if err := c.validateUITheme(); err != nil {
+		return err
+	}
 	if err := c.validateGitDefaultRemote(); err != nil {
 		return err
 	}
+	if err := c.validateDiffView(); err != nil {
+		return err
+	}
 	if err := c.validateAliases(); err != nil {
 		return err
 	}
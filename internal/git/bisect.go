@@ -0,0 +1,197 @@
+package git
+
+import (
+	"os"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// BisectOps provides operations used by the bisect command.
+type BisectOps interface {
+	// session operations
+	BisectStart(bad, good string) error
+	BisectMark(term string) error
+	BisectRun(command []string) error
+	BisectReset() error
+	BisectStatus() (*BisectStatus, error)
+	// discovery
+	OperationReader
+	TagList(pattern []string) (string, error)
+	LogRecent(limit int) (string, error)
+	CommitDetails(commit string) (*CommitDetails, error)
+	CommitFiles(commit string, mainline int) (string, error)
+	BranchesContaining(commit string) ([]string, error)
+	RevParseVerify(ref string) bool
+}
+
+// BisectStatus describes the progress of a bisect.
+type BisectStatus struct {
+	// OriginalRef is the branch or commit checked out before the bisect
+	// started; bisect reset returns to it.
+	OriginalRef string
+	// Tested is the number of commits marked good, bad or skipped.
+	Tested int
+	// Remaining and Steps estimate the commits left to test after the
+	// current one and the steps that takes, as git prints them.
+	Remaining int
+	Steps     int
+	// FirstBad is the "<hash> <subject>" of the first bad commit once it
+	// is found.
+	FirstBad string
+	// Candidates lists the possible first bad commits when only skipped
+	// commits are left to test.
+	Candidates []string
+}
+
+// Done reports whether the bisect has found the first bad commit or cannot
+// narrow it down further.
+func (s *BisectStatus) Done() bool {
+	return s.FirstBad != "" || len(s.Candidates) > 0
+}
+
+// BisectStart starts a bisect between a bad and a good commit and checks
+// out the first commit to test. git's report of the next commit is not
+// shown; BisectStatus describes the progress instead.
+func (c *Client) BisectStart(bad, good string) error {
+	return c.runBisect("bisect start", false, "start", bad, good)
+}
+
+// BisectMark marks the checked out commit with term, one of good, bad or
+// skip, and checks out the next commit to test. git reports an error when
+// only skipped commits are left.
+func (c *Client) BisectMark(term string) error {
+	return c.runBisect("bisect "+term, false, term)
+}
+
+// BisectRun marks commits by running command on each until the first bad
+// commit is found. command exits with 0 for good, 125 for skip and other
+// codes below 128 for bad.
+func (c *Client) BisectRun(command []string) error {
+	return c.runBisect("bisect run", true, append([]string{"run"}, command...)...)
+}
+
+// BisectReset ends the bisect and checks out the original branch again.
+func (c *Client) BisectReset() error {
+	return c.runBisect("bisect reset", true, "reset")
+}
+
+// runBisect runs a bisect subcommand. Errors always reach stderr; its
+// standard output is shown only when showOutput is set.
+func (c *Client) runBisect(op string, showOutput bool, args ...string) error {
+	cmdArgs := append([]string{"bisect"}, args...)
+	cmd := c.execCommand("git", cmdArgs...)
+	cmd.Stdin = os.Stdin
+	if showOutput {
+		cmd.Stdout = os.Stdout
+	}
+	cmd.Stderr = os.Stderr
+	if err := cmd.Run(); err != nil {
+		return NewOpError(op, "git "+strings.Join(cmdArgs, " "), err)
+	}
+	return nil
+}
+
+// bisectLogCommitRe matches the "[<hash>] <subject>" part of the comments
+// git writes to the bisect log.
+var bisectLogCommitRe = regexp.MustCompile(`^\[([0-9a-f]+)\] ?(.*)$`)
+
+// BisectStatus reads the progress of the bisect in progress from its log.
+func (c *Client) BisectStatus() (*BisectStatus, error) {
+	cmd := c.execCommand("git", "bisect", "log")
+	out, err := cmd.Output()
+	if err != nil {
+		return nil, NewOpError("bisect status", "git bisect log", err)
+	}
+	status := parseBisectLog(string(out))
+
+	if path, err := c.GitPath("BISECT_START"); err == nil {
+		if start, err := os.ReadFile(path); err == nil {
+			status.OriginalRef = strings.TrimSpace(string(start))
+		}
+	}
+	if status.Done() {
+		return status, nil
+	}
+
+	args := []string{"rev-list", "--bisect-vars", "refs/bisect/bad", "--not", "--glob=refs/bisect/good-*"}
+	cmd = c.execCommand("git", args...)
+	out, err = cmd.Output()
+	if err != nil {
+		return nil, NewOpError("bisect status", "git "+strings.Join(args, " "), err)
+	}
+	vars := parseBisectVars(string(out))
+	status.Remaining = vars["bisect_nr"]
+	status.Steps = vars["bisect_steps"]
+	return status, nil
+}
+
+// parseBisectLog counts the marked commits in a bisect log and picks up the
+// result comments git adds once it stops.
+func parseBisectLog(log string) *BisectStatus {
+	status := &BisectStatus{}
+	for _, line := range strings.Split(log, "\n") {
+		switch {
+		case strings.HasPrefix(line, "git bisect good"),
+			strings.HasPrefix(line, "git bisect bad"),
+			strings.HasPrefix(line, "git bisect skip"):
+			status.Tested++
+		case strings.HasPrefix(line, "# first bad commit: "):
+			status.FirstBad = bisectLogCommit(strings.TrimPrefix(line, "# first bad commit: "))
+		case strings.HasPrefix(line, "# possible first bad commit: "):
+			status.Candidates = append(status.Candidates, bisectLogCommit(strings.TrimPrefix(line, "# possible first bad commit: ")))
+		}
+	}
+	return status
+}
+
+// bisectLogCommit turns "[<hash>] <subject>" into "<short hash> <subject>".
+func bisectLogCommit(s string) string {
+	m := bisectLogCommitRe.FindStringSubmatch(s)
+	if m == nil {
+		return s
+	}
+	hash := m[1]
+	if len(hash) > 7 {
+		hash = hash[:7]
+	}
+	return strings.TrimSpace(hash + " " + m[2])
+}
+
+// parseBisectVars parses the name=value lines of git rev-list --bisect-vars.
+func parseBisectVars(out string) map[string]int {
+	vars := make(map[string]int)
+	for _, line := range strings.Split(strings.TrimSpace(out), "\n") {
+		name, value, ok := strings.Cut(line, "=")
+		if !ok {
+			continue
+		}
+		if n, err := strconv.Atoi(value); err == nil {
+			vars[name] = n
+		}
+	}
+	return vars
+}
+
+// CommitDetails describes a commit.
+type CommitDetails struct {
+	Hash    string
+	Author  string
+	Date    string
+	Subject string
+}
+
+// CommitDetails returns the hash, author, date and subject of commit.
+func (c *Client) CommitDetails(commit string) (*CommitDetails, error) {
+	args := []string{"show", "--no-patch", "--date=short", "--format=%h%n%an <%ae>%n%ad%n%s", commit}
+	cmd := c.execCommand("git", args...)
+	out, err := cmd.Output()
+	if err != nil {
+		return nil, NewOpError("get commit details", "git "+strings.Join(args, " "), err)
+	}
+	fields := strings.SplitN(strings.TrimRight(string(out), "\n"), "\n", 4)
+	for len(fields) < 4 {
+		fields = append(fields, "")
+	}
+	return &CommitDetails{Hash: fields[0], Author: fields[1], Date: fields[2], Subject: fields[3]}, nil
+}
@@ -0,0 +1,152 @@
+package git
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"reflect"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestClient_BisectOps(t *testing.T) {
+	tests := []struct {
+		name     string
+		run      func(c *Client) error
+		wantArgs []string
+	}{
+		{"start", func(c *Client) error { return c.BisectStart("HEAD", "v1.0.0") }, []string{"git", "bisect", "start", "HEAD", "v1.0.0"}},
+		{"good", func(c *Client) error { return c.BisectMark("good") }, []string{"git", "bisect", "good"}},
+		{"skip", func(c *Client) error { return c.BisectMark("skip") }, []string{"git", "bisect", "skip"}},
+		{"run", func(c *Client) error { return c.BisectRun([]string{"go", "test", "./..."}) }, []string{"git", "bisect", "run", "go", "test", "./..."}},
+		{"reset", func(c *Client) error { return c.BisectReset() }, []string{"git", "bisect", "reset"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var gotArgs []string
+			client := &Client{
+				execCommand: func(name string, args ...string) *exec.Cmd {
+					gotArgs = append([]string{name}, args...)
+					return exec.Command("true")
+				},
+			}
+
+			if err := tt.run(client); err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+			if !slices.Equal(gotArgs, tt.wantArgs) {
+				t.Errorf("gotArgs = %v, want %v", gotArgs, tt.wantArgs)
+			}
+		})
+	}
+}
+
+func TestClient_BisectMark_Error(t *testing.T) {
+	client := &Client{
+		execCommand: func(string, ...string) *exec.Cmd {
+			return exec.Command("false")
+		},
+	}
+
+	err := client.BisectMark("bad")
+	if err == nil || !strings.Contains(err.Error(), "git bisect bad") {
+		t.Errorf("expected an error naming the command, got %v", err)
+	}
+}
+
+func TestParseBisectLog(t *testing.T) {
+	found := `# bad: [d6db140af315b58364e0724e1cc08ecc98a2a10f] c8
+# good: [618dae84dc850633a4ab62f1685f87d6abd9736e] c1
+git bisect start 'HEAD' 'v1'
+# good: [8d99f2170cddc496187fb10bdf4c3bab5029e40b] c4
+git bisect good 8d99f2170cddc496187fb10bdf4c3bab5029e40b
+# bad: [2b9fad23e4b7403524ddb67f460fc2df20441c00] c6
+git bisect bad 2b9fad23e4b7403524ddb67f460fc2df20441c00
+# first bad commit: [2b9fad23e4b7403524ddb67f460fc2df20441c00] c6
+`
+	got := parseBisectLog(found)
+	want := &BisectStatus{Tested: 2, FirstBad: "2b9fad2 c6"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("parseBisectLog(found) = %+v, want %+v", got, want)
+	}
+
+	skipped := `git bisect start 'HEAD' 'HEAD~3'
+# skip: [8d99f2170cddc496187fb10bdf4c3bab5029e40b] c4
+git bisect skip 8d99f2170cddc496187fb10bdf4c3bab5029e40b
+# only skipped commits left to test
+# possible first bad commit: [8af5d65d3a117742084f08f0ad624d4080d9a872] c5
+# possible first bad commit: [8d99f2170cddc496187fb10bdf4c3bab5029e40b] c4
+`
+	got = parseBisectLog(skipped)
+	want = &BisectStatus{Tested: 1, Candidates: []string{"8af5d65 c5", "8d99f21 c4"}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("parseBisectLog(skipped) = %+v, want %+v", got, want)
+	}
+	if !got.Done() {
+		t.Error("a bisect with only skipped commits left should be done")
+	}
+}
+
+func TestClient_BisectStatus_InProgress(t *testing.T) {
+	dir := t.TempDir()
+	startFile := filepath.Join(dir, "BISECT_START")
+	if err := os.WriteFile(startFile, []byte("main\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	var calls []string
+	client := &Client{
+		execCommand: func(name string, args ...string) *exec.Cmd {
+			calls = append(calls, strings.Join(args, " "))
+			switch args[0] {
+			case "bisect":
+				return exec.Command("printf", "%s", "git bisect start 'HEAD' 'v1'\ngit bisect good abc\n")
+			case "rev-parse":
+				return exec.Command("echo", startFile)
+			default:
+				return exec.Command("printf", "%s", "bisect_rev='abc'\nbisect_nr=3\nbisect_good=3\nbisect_bad=2\nbisect_all=7\nbisect_steps=2\n")
+			}
+		},
+	}
+
+	got, err := client.BisectStatus()
+	if err != nil {
+		t.Fatalf("BisectStatus() error = %v", err)
+	}
+	want := &BisectStatus{OriginalRef: "main", Tested: 1, Remaining: 3, Steps: 2}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("BisectStatus() = %+v, want %+v", got, want)
+	}
+	wantCalls := []string{
+		"bisect log",
+		"rev-parse --git-path BISECT_START",
+		"rev-list --bisect-vars refs/bisect/bad --not --glob=refs/bisect/good-*",
+	}
+	if !slices.Equal(calls, wantCalls) {
+		t.Errorf("calls = %v, want %v", calls, wantCalls)
+	}
+}
+
+func TestClient_CommitDetails(t *testing.T) {
+	var gotArgs []string
+	client := &Client{
+		execCommand: func(name string, args ...string) *exec.Cmd {
+			gotArgs = append([]string{name}, args...)
+			return exec.Command("printf", "%s", "2b9fad2\nJane <jane@example.com>\n2026-10-19\nfix: handle empty input\n")
+		},
+	}
+
+	got, err := client.CommitDetails("2b9fad2")
+	if err != nil {
+		t.Fatalf("CommitDetails() error = %v", err)
+	}
+	want := &CommitDetails{Hash: "2b9fad2", Author: "Jane <jane@example.com>", Date: "2026-10-19", Subject: "fix: handle empty input"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("CommitDetails() = %+v, want %+v", got, want)
+	}
+	wantArgs := []string{"git", "show", "--no-patch", "--date=short", "--format=%h%n%an <%ae>%n%ad%n%s", "2b9fad2"}
+	if !slices.Equal(gotArgs, wantArgs) {
+		t.Errorf("gotArgs = %v, want %v", gotArgs, wantArgs)
+	}
+}
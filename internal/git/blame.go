@@ -0,0 +1,146 @@
+package git
+
+import (
+	"strconv"
+	"strings"
+	"time"
+)
+
+// BlameOps provides operations used by the blame command.
+type BlameOps interface {
+	Blame(rev, path string) ([]BlameLine, error)
+	CommitDetails(commit string) (*CommitDetails, error)
+	CommitDiff(commit string) (string, error)
+}
+
+// BlameCommit describes a commit that lines of a blame are attributed to.
+type BlameCommit struct {
+	Hash       string
+	Author     string
+	AuthorMail string
+	AuthorTime time.Time
+	Summary    string
+	// PreviousHash and PreviousPath name the parent revision and the path
+	// the file had there. They are empty when the commit added the file.
+	PreviousHash string
+	PreviousPath string
+	// Boundary is set for a root commit or the oldest commit a limited
+	// blame reached.
+	Boundary bool
+}
+
+// Uncommitted reports whether the lines are changes in the working tree.
+func (c *BlameCommit) Uncommitted() bool {
+	return strings.Trim(c.Hash, "0") == ""
+}
+
+// ShortHash returns the first seven characters of the hash.
+func (c *BlameCommit) ShortHash() string {
+	if len(c.Hash) > 7 {
+		return c.Hash[:7]
+	}
+	return c.Hash
+}
+
+// BlameLine is one line of a blamed file. Lines attributed to the same
+// commit share its BlameCommit.
+type BlameLine struct {
+	Commit *BlameCommit
+	// OrigLine is the line number in the commit, Line the one in the
+	// blamed revision.
+	OrigLine int
+	Line     int
+	Text     string
+}
+
+// Blame returns who last changed each line of path at rev, or in the
+// working tree when rev is empty.
+func (c *Client) Blame(rev, path string) ([]BlameLine, error) {
+	args := []string{"blame", "--porcelain"}
+	if rev != "" {
+		args = append(args, rev)
+	}
+	args = append(args, "--", path)
+	cmd := c.execCommand("git", args...)
+	out, err := cmd.Output()
+	if err != nil {
+		return nil, NewOpError("blame", "git "+strings.Join(args, " "), err)
+	}
+	return parseBlamePorcelain(string(out)), nil
+}
+
+// parseBlamePorcelain parses git blame --porcelain output. Each line starts
+// with a "<hash> <orig line> <final line>" header; the commit's details
+// follow only the first time the commit appears, and the line's content
+// comes last, prefixed with a tab.
+func parseBlamePorcelain(out string) []BlameLine {
+	commits := make(map[string]*BlameCommit)
+	var lines []BlameLine
+	var cur *BlameLine
+	for _, line := range strings.Split(out, "\n") {
+		if text, ok := strings.CutPrefix(line, "\t"); ok {
+			if cur != nil {
+				cur.Text = text
+				lines = append(lines, *cur)
+				cur = nil
+			}
+			continue
+		}
+		if cur == nil {
+			cur = parseBlameHeader(line, commits)
+			continue
+		}
+		key, value, _ := strings.Cut(line, " ")
+		parseBlameCommitField(cur.Commit, key, value)
+	}
+	return lines
+}
+
+func parseBlameHeader(line string, commits map[string]*BlameCommit) *BlameLine {
+	fields := strings.Fields(line)
+	if len(fields) < 3 {
+		return nil
+	}
+	orig, err1 := strconv.Atoi(fields[1])
+	final, err2 := strconv.Atoi(fields[2])
+	if err1 != nil || err2 != nil {
+		return nil
+	}
+	commit, ok := commits[fields[0]]
+	if !ok {
+		commit = &BlameCommit{Hash: fields[0]}
+		commits[fields[0]] = commit
+	}
+	return &BlameLine{Commit: commit, OrigLine: orig, Line: final}
+}
+
+func parseBlameCommitField(commit *BlameCommit, key, value string) {
+	switch key {
+	case "author":
+		commit.Author = value
+	case "author-mail":
+		commit.AuthorMail = strings.Trim(value, "<>")
+	case "author-time":
+		if sec, err := strconv.ParseInt(value, 10, 64); err == nil {
+			commit.AuthorTime = time.Unix(sec, 0)
+		}
+	case "summary":
+		commit.Summary = value
+	case "previous":
+		commit.PreviousHash, commit.PreviousPath, _ = strings.Cut(value, " ")
+	case "boundary":
+		commit.Boundary = true
+	}
+}
+
+// CommitDiff returns the changes commit made, compared with its first
+// parent, without color so that it can be parsed.
+func (c *Client) CommitDiff(commit string) (string, error) {
+	args := []string{"show", "--no-color", "--format=", "--diff-merges=first-parent", commit}
+	cmd := c.execCommand("git", args...)
+	out, err := cmd.Output()
+	if err != nil {
+		return "", NewOpError("get commit diff", "git "+strings.Join(args, " "), err)
+	}
+	return string(out), nil
+}
@@ -0,0 +1,158 @@
+package git
+
+import (
+	"os/exec"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+)
+
+const blamePorcelain = `8cb4a907eb3ccf22f8484ec149cacc5ff53c7721 1 1 1
+author A
+author-mail <a@example.com>
+author-time 1700000000
+author-tz +0000
+committer A
+committer-mail <a@example.com>
+committer-time 1700000000
+committer-tz +0000
+summary one
+boundary
+filename f
+	a
+a2ca6a98f382b51570867df150a5f2436ec20d42 2 2 2
+author B
+author-mail <b@example.com>
+author-time 1700086400
+author-tz +0900
+committer B
+committer-mail <b@example.com>
+committer-time 1700086400
+committer-tz +0900
+summary two
+previous 8cb4a907eb3ccf22f8484ec149cacc5ff53c7721 old/f
+filename f
+	B
+a2ca6a98f382b51570867df150a5f2436ec20d42 3 3
+		indented
+0000000000000000000000000000000000000000 4 4 1
+author Not Committed Yet
+author-mail <not.committed.yet>
+author-time 1700172800
+author-tz +0000
+committer Not Committed Yet
+committer-mail <not.committed.yet>
+committer-time 1700172800
+committer-tz +0000
+summary Version of f from f
+previous a2ca6a98f382b51570867df150a5f2436ec20d42 f
+filename f
+	d
+`
+
+func TestParseBlamePorcelain(t *testing.T) {
+	lines := parseBlamePorcelain(blamePorcelain)
+	if len(lines) != 4 {
+		t.Fatalf("got %d lines, want 4", len(lines))
+	}
+
+	root := lines[0].Commit
+	if root.Author != "A" || root.AuthorMail != "a@example.com" || root.Summary != "one" || !root.Boundary {
+		t.Errorf("root commit = %+v", root)
+	}
+	if root.PreviousHash != "" || !root.AuthorTime.Equal(time.Unix(1700000000, 0)) {
+		t.Errorf("root commit = %+v", root)
+	}
+
+	two := lines[1].Commit
+	if lines[2].Commit != two {
+		t.Error("lines of the same commit should share it")
+	}
+	if two.ShortHash() != "a2ca6a9" || two.PreviousHash != "8cb4a907eb3ccf22f8484ec149cacc5ff53c7721" || two.PreviousPath != "old/f" {
+		t.Errorf("second commit = %+v", two)
+	}
+	if lines[2].OrigLine != 3 || lines[2].Line != 3 || lines[2].Text != "\tindented" {
+		t.Errorf("third line = %+v", lines[2])
+	}
+
+	if !lines[3].Commit.Uncommitted() || lines[1].Commit.Uncommitted() {
+		t.Error("only the working tree line should be uncommitted")
+	}
+	var texts []string
+	for _, l := range lines {
+		texts = append(texts, l.Text)
+	}
+	if !slices.Equal(texts, []string{"a", "B", "\tindented", "d"}) {
+		t.Errorf("texts = %q", texts)
+	}
+}
+
+func TestClient_Blame(t *testing.T) {
+	tests := []struct {
+		name     string
+		rev      string
+		wantArgs []string
+	}{
+		{"working_tree", "", []string{"git", "blame", "--porcelain", "--", "f"}},
+		{"revision", "HEAD~2", []string{"git", "blame", "--porcelain", "HEAD~2", "--", "f"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var gotArgs []string
+			client := &Client{
+				execCommand: func(name string, args ...string) *exec.Cmd {
+					gotArgs = append([]string{name}, args...)
+					return exec.Command("printf", "%s", blamePorcelain)
+				},
+			}
+
+			lines, err := client.Blame(tt.rev, "f")
+			if err != nil {
+				t.Fatalf("Blame() error = %v", err)
+			}
+			if len(lines) != 4 {
+				t.Errorf("got %d lines, want 4", len(lines))
+			}
+			if !slices.Equal(gotArgs, tt.wantArgs) {
+				t.Errorf("gotArgs = %v, want %v", gotArgs, tt.wantArgs)
+			}
+		})
+	}
+}
+
+func TestClient_Blame_Error(t *testing.T) {
+	client := &Client{
+		execCommand: func(string, ...string) *exec.Cmd {
+			return exec.Command("false")
+		},
+	}
+
+	_, err := client.Blame("", "missing")
+	if err == nil || !strings.Contains(err.Error(), "git blame --porcelain -- missing") {
+		t.Errorf("expected an error naming the command, got %v", err)
+	}
+}
+
+func TestClient_CommitDiff(t *testing.T) {
+	var gotArgs []string
+	client := &Client{
+		execCommand: func(name string, args ...string) *exec.Cmd {
+			gotArgs = append([]string{name}, args...)
+			return exec.Command("echo", "diff --git a/f b/f")
+		},
+	}
+
+	out, err := client.CommitDiff("a2ca6a9")
+	if err != nil {
+		t.Fatalf("CommitDiff() error = %v", err)
+	}
+	if out != "diff --git a/f b/f\n" {
+		t.Errorf("CommitDiff() = %q", out)
+	}
+	wantArgs := []string{"git", "show", "--no-color", "--format=", "--diff-merges=first-parent", "a2ca6a9"}
+	if !slices.Equal(gotArgs, wantArgs) {
+		t.Errorf("gotArgs = %v, want %v", gotArgs, wantArgs)
+	}
+}
@@ -25,6 +25,11 @@ type BranchReader interface {
 	RevParseVerify(ref string) bool
 }
 
+// DefaultBranchReader resolves the branch that work is usually based on.
+type DefaultBranchReader interface {
+	DefaultBranch(remote string) (string, error)
+}
+
 // BranchWriter provides branch mutation operations.
 type BranchWriter interface {
 	CheckoutNewBranch(name string) error
@@ -187,6 +192,26 @@ func (c *Client) ListMergedBranches() ([]string, error) {
 	return result, nil
 }
 
+// DefaultBranch returns the branch the remote's HEAD points to, such as
+// "origin/main". Without a remote HEAD it falls back to a local main or
+// master branch.
+func (c *Client) DefaultBranch(remote string) (string, error) {
+	ref := "refs/remotes/" + remote + "/HEAD"
+	cmd := c.execCommand("git", "symbolic-ref", "--quiet", "--short", ref)
+	if out, err := cmd.Output(); err == nil {
+		if branch := strings.TrimSpace(string(out)); branch != "" {
+			return branch, nil
+		}
+	}
+	for _, name := range []string{"main", "master"} {
+		if c.RevParseVerify("refs/heads/" + name) {
+			return name, nil
+		}
+	}
+	return "", NewOpError("get default branch", "git symbolic-ref --quiet --short "+ref,
+		fmt.Errorf("%s has no HEAD and there is no main or master branch", remote))
+}
+
 // RenameBranch renames a branch (git branch -m <old> <new>).
 func (c *Client) RenameBranch(old, newName string) error {
 	trimmedOld := strings.TrimSpace(old)
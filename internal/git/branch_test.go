@@ -695,3 +695,63 @@ func TestClient_ListMergedBranches(t *testing.T) {
 		})
 	}
 }
+
+func TestClient_DefaultBranch(t *testing.T) {
+	tests := []struct {
+		name    string
+		exec    func(args []string) *exec.Cmd
+		want    string
+		wantErr bool
+	}{
+		{
+			name: "remote_head",
+			exec: func(args []string) *exec.Cmd {
+				return exec.Command("echo", "origin/main")
+			},
+			want: "origin/main",
+		},
+		{
+			name: "falls_back_to_local_master",
+			exec: func(args []string) *exec.Cmd {
+				if args[0] == "rev-parse" && args[len(args)-1] == "refs/heads/master" {
+					return exec.Command("true")
+				}
+				return exec.Command("false")
+			},
+			want: "master",
+		},
+		{
+			name: "no_default_branch",
+			exec: func(args []string) *exec.Cmd {
+				return exec.Command("false")
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var firstArgs []string
+			client := &Client{
+				execCommand: func(name string, args ...string) *exec.Cmd {
+					if firstArgs == nil {
+						firstArgs = append([]string{name}, args...)
+					}
+					return tt.exec(args)
+				},
+			}
+
+			got, err := client.DefaultBranch("origin")
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("DefaultBranch() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("DefaultBranch() = %q, want %q", got, tt.want)
+			}
+			wantArgs := []string{"git", "symbolic-ref", "--quiet", "--short", "refs/remotes/origin/HEAD"}
+			if !slices.Equal(firstArgs, wantArgs) {
+				t.Errorf("first command = %v, want %v", firstArgs, wantArgs)
+			}
+		})
+	}
+}
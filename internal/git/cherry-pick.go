@@ -0,0 +1,68 @@
+package git
+
+import (
+	"fmt"
+	"os"
+	"strings"
+)
+
+// CherryPickOps provides operations used by the cherry-pick command.
+type CherryPickOps interface {
+	// sequence operations
+	CherryPick(commits []string) error
+	CherryPickContinue() error
+	CherryPickAbort() error
+	CherryPickSkip() error
+	// discovery
+	GetCurrentBranch() (string, error)
+	ListLocalBranches() ([]string, error)
+	LogOnelineUnpicked(from, to string) (string, error)
+	RevParseVerify(ref string) bool
+}
+
+// CherryPick applies commits, which may include ranges such as a..b, on top
+// of the current branch in the given order.
+func (c *Client) CherryPick(commits []string) error {
+	return c.runCherryPick("cherry-pick", commits...)
+}
+
+// CherryPickContinue continues a cherry-pick after conflicts are resolved.
+func (c *Client) CherryPickContinue() error {
+	return c.runCherryPick("cherry-pick continue", "--continue")
+}
+
+// CherryPickAbort cancels a cherry-pick and restores the branch.
+func (c *Client) CherryPickAbort() error {
+	return c.runCherryPick("cherry-pick abort", "--abort")
+}
+
+// CherryPickSkip skips the commit that stopped and continues with the rest.
+func (c *Client) CherryPickSkip() error {
+	return c.runCherryPick("cherry-pick skip", "--skip")
+}
+
+// LogOnelineUnpicked lists the commits of to as "<hash> <subject>" lines,
+// oldest first, leaving out those whose change already exists in from, such
+// as commits cherry-picked earlier. The fixed format keeps color.ui from
+// coloring the hashes.
+func (c *Client) LogOnelineUnpicked(from, to string) (string, error) {
+	args := []string{"log", "--format=%h %s", "--reverse", "--right-only", "--cherry-pick", fmt.Sprintf("%s...%s", from, to)}
+	cmd := c.execCommand("git", args...)
+	out, err := cmd.Output()
+	if err != nil {
+		return "", NewOpError("log unpicked commits", "git "+strings.Join(args, " "), err)
+	}
+	return string(out), nil
+}
+
+func (c *Client) runCherryPick(op string, args ...string) error {
+	cmdArgs := append([]string{"cherry-pick"}, args...)
+	cmd := c.execCommand("git", cmdArgs...)
+	cmd.Stdin = os.Stdin
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	if err := cmd.Run(); err != nil {
+		return NewOpError(op, "git "+strings.Join(cmdArgs, " "), err)
+	}
+	return nil
+}
@@ -0,0 +1,79 @@
+package git
+
+import (
+	"os/exec"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestClient_CherryPickOps(t *testing.T) {
+	tests := []struct {
+		name     string
+		run      func(c *Client) error
+		wantArgs []string
+	}{
+		{"commits", func(c *Client) error { return c.CherryPick([]string{"abc123", "def456"}) }, []string{"git", "cherry-pick", "abc123", "def456"}},
+		{"range", func(c *Client) error { return c.CherryPick([]string{"v1.0..v1.1"}) }, []string{"git", "cherry-pick", "v1.0..v1.1"}},
+		{"continue", func(c *Client) error { return c.CherryPickContinue() }, []string{"git", "cherry-pick", "--continue"}},
+		{"abort", func(c *Client) error { return c.CherryPickAbort() }, []string{"git", "cherry-pick", "--abort"}},
+		{"skip", func(c *Client) error { return c.CherryPickSkip() }, []string{"git", "cherry-pick", "--skip"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var gotArgs []string
+			client := &Client{
+				execCommand: func(name string, args ...string) *exec.Cmd {
+					gotArgs = append([]string{name}, args...)
+					return exec.Command("true")
+				},
+			}
+
+			if err := tt.run(client); err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+			if !slices.Equal(gotArgs, tt.wantArgs) {
+				t.Errorf("gotArgs = %v, want %v", gotArgs, tt.wantArgs)
+			}
+		})
+	}
+}
+
+func TestClient_CherryPick_Error(t *testing.T) {
+	client := &Client{
+		execCommand: func(string, ...string) *exec.Cmd {
+			return exec.Command("false")
+		},
+	}
+
+	err := client.CherryPick([]string{"abc123"})
+	if err == nil {
+		t.Fatal("expected an error")
+	}
+	if !strings.Contains(err.Error(), "git cherry-pick abc123") {
+		t.Errorf("error should name the command, got %v", err)
+	}
+}
+
+func TestClient_LogOnelineUnpicked(t *testing.T) {
+	var gotArgs []string
+	client := &Client{
+		execCommand: func(name string, args ...string) *exec.Cmd {
+			gotArgs = append([]string{name}, args...)
+			return exec.Command("echo", "abc123 Fix crash")
+		},
+	}
+
+	out, err := client.LogOnelineUnpicked("HEAD", "feature")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if strings.TrimSpace(out) != "abc123 Fix crash" {
+		t.Errorf("out = %q", out)
+	}
+	want := []string{"git", "log", "--format=%h %s", "--reverse", "--right-only", "--cherry-pick", "HEAD...feature"}
+	if !slices.Equal(gotArgs, want) {
+		t.Errorf("gotArgs = %v, want %v", gotArgs, want)
+	}
+}
@@ -0,0 +1,156 @@
+package git
+
+import (
+	"os"
+	"os/exec"
+	"strings"
+)
+
+// ConflictOps provides operations used by the conflicts command.
+type ConflictOps interface {
+	OperationReader
+	ListConflicts() ([]Conflict, error)
+	GetRepositoryPrefix() (string, error)
+	ContinueOperation(op Operation) error
+	ResolveConflict(conflict Conflict, theirs bool) error
+	MarkResolved(paths []string) error
+	RunMergeTool(tool string, paths []string) error
+}
+
+// Conflict is an unmerged path reported by git status.
+type Conflict struct {
+	// Path is relative to the top-level directory, as git status
+	// --porcelain reports it wherever it runs.
+	Path string
+	// Code is the two-letter porcelain status: the first letter describes
+	// our side, the second their side.
+	Code string
+}
+
+var conflictDescriptions = map[string]string{
+	"UU": "both modified",
+	"AA": "both added",
+	"DD": "both deleted",
+	"AU": "added by us",
+	"UA": "added by them",
+	"DU": "deleted by us",
+	"UD": "deleted by them",
+}
+
+// Description describes the conflict the way git status does, such as
+// "both modified" or "deleted by them".
+func (c Conflict) Description() string {
+	if desc, ok := conflictDescriptions[c.Code]; ok {
+		return desc
+	}
+	return c.Code
+}
+
+// HasOurs reports whether our side has a version of the file.
+func (c Conflict) HasOurs() bool {
+	return c.Code != "DD" && c.Code != "DU" && c.Code != "UA"
+}
+
+// HasTheirs reports whether their side has a version of the file.
+func (c Conflict) HasTheirs() bool {
+	return c.Code != "DD" && c.Code != "UD" && c.Code != "AU"
+}
+
+// ListConflicts lists the unmerged paths of the working tree.
+func (c *Client) ListConflicts() ([]Conflict, error) {
+	cmd := c.execCommand("git", "status", "--porcelain=v1", "-z")
+	out, err := cmd.Output()
+	if err != nil {
+		return nil, NewOpError("list conflicts", "git status --porcelain=v1 -z", err)
+	}
+	return parseConflicts(string(out)), nil
+}
+
+func parseConflicts(out string) []Conflict {
+	var conflicts []Conflict
+	entries := strings.Split(out, "\x00")
+	for i := 0; i < len(entries); i++ {
+		entry := entries[i]
+		if len(entry) < 4 {
+			continue
+		}
+		code := entry[:2]
+		if code[0] == 'R' || code[0] == 'C' {
+			// Renames and copies are followed by their original path.
+			i++
+			continue
+		}
+		if _, ok := conflictDescriptions[code]; ok {
+			conflicts = append(conflicts, Conflict{Path: entry[3:], Code: code})
+		}
+	}
+	return conflicts
+}
+
+// ResolveConflict resolves conflict by taking our or their whole file.
+// When the chosen side has no version of the file, the file is removed.
+func (c *Client) ResolveConflict(conflict Conflict, theirs bool) error {
+	side, exists := "--ours", conflict.HasOurs()
+	if theirs {
+		side, exists = "--theirs", conflict.HasTheirs()
+	}
+	if !exists {
+		return c.runConflictCommand("remove conflicted path", "rm", "--quiet", "--", conflict.Path)
+	}
+	if err := c.runConflictCommand("checkout conflict side", "checkout", side, "--", conflict.Path); err != nil {
+		return err
+	}
+	return c.MarkResolved([]string{conflict.Path})
+}
+
+// MarkResolved stages paths, including deletions, to mark them resolved.
+func (c *Client) MarkResolved(paths []string) error {
+	args := append([]string{"add", "--all", "--"}, paths...)
+	return c.runConflictCommand("mark resolved", args...)
+}
+
+// RunMergeTool opens paths in a merge tool. An empty tool uses git's
+// merge.tool setting.
+func (c *Client) RunMergeTool(tool string, paths []string) error {
+	args := []string{"mergetool", "--no-prompt"}
+	if tool != "" {
+		args = append(args, "--tool="+tool)
+	}
+	args = append(append(args, "--"), paths...)
+	cmd, err := c.topLevelCommand(args...)
+	if err != nil {
+		return err
+	}
+	cmd.Stdin = os.Stdin
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	if err := cmd.Run(); err != nil {
+		return NewOpError("run merge tool", "git "+strings.Join(args, " "), err)
+	}
+	return nil
+}
+
+func (c *Client) runConflictCommand(op string, args ...string) error {
+	cmd, err := c.topLevelCommand(args...)
+	if err != nil {
+		return err
+	}
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	if err := cmd.Run(); err != nil {
+		return NewOpError(op, "git "+strings.Join(args, " "), err)
+	}
+	return nil
+}
+
+// topLevelCommand returns a git command that runs in the top-level
+// directory, where the paths of conflicts resolve from any subdirectory.
+func (c *Client) topLevelCommand(args ...string) (*exec.Cmd, error) {
+	root, err := c.GetRepositoryRoot()
+	if err != nil {
+		return nil, err
+	}
+	cmd := c.execCommand("git", args...)
+	cmd.Dir = root
+	return cmd, nil
+}
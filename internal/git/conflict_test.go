@@ -0,0 +1,136 @@
+package git
+
+import (
+	"os/exec"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestParseConflicts(t *testing.T) {
+	out := "UU cmd/diff.go\x00M  ok.go\x00R  new.go\x00old.go\x00UD docs/a b.md\x00AA both.txt\x00?? untracked\x00"
+	got := parseConflicts(out)
+	want := []Conflict{
+		{Path: "cmd/diff.go", Code: "UU"},
+		{Path: "docs/a b.md", Code: "UD"},
+		{Path: "both.txt", Code: "AA"},
+	}
+	if !slices.Equal(got, want) {
+		t.Fatalf("parseConflicts() = %v, want %v", got, want)
+	}
+	if got[1].Description() != "deleted by them" || got[0].Description() != "both modified" {
+		t.Errorf("unexpected descriptions %q, %q", got[0].Description(), got[1].Description())
+	}
+}
+
+func TestConflict_Sides(t *testing.T) {
+	tests := []struct {
+		code              string
+		hasOurs, hasTheir bool
+	}{
+		{"UU", true, true},
+		{"AA", true, true},
+		{"DD", false, false},
+		{"AU", true, false},
+		{"UA", false, true},
+		{"DU", false, true},
+		{"UD", true, false},
+	}
+	for _, tt := range tests {
+		c := Conflict{Path: "f", Code: tt.code}
+		if c.HasOurs() != tt.hasOurs || c.HasTheirs() != tt.hasTheir {
+			t.Errorf("%s: HasOurs=%v HasTheirs=%v, want %v %v", tt.code, c.HasOurs(), c.HasTheirs(), tt.hasOurs, tt.hasTheir)
+		}
+	}
+}
+
+func TestClient_ResolveConflict(t *testing.T) {
+	tests := []struct {
+		name     string
+		conflict Conflict
+		theirs   bool
+		want     []string
+	}{
+		{
+			name:     "ours",
+			conflict: Conflict{Path: "a.go", Code: "UU"},
+			want:     []string{"git checkout --ours -- a.go", "git add --all -- a.go"},
+		},
+		{
+			name:     "theirs",
+			conflict: Conflict{Path: "a.go", Code: "UU"},
+			theirs:   true,
+			want:     []string{"git checkout --theirs -- a.go", "git add --all -- a.go"},
+		},
+		{
+			name:     "theirs_deleted",
+			conflict: Conflict{Path: "a.go", Code: "UD"},
+			theirs:   true,
+			want:     []string{"git rm --quiet -- a.go"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			root := t.TempDir()
+			var got []string
+			var cmds []*exec.Cmd
+			client := &Client{
+				execCommand: func(name string, args ...string) *exec.Cmd {
+					if args[0] == "rev-parse" {
+						return exec.Command("echo", root)
+					}
+					got = append(got, strings.Join(append([]string{name}, args...), " "))
+					cmd := exec.Command("true")
+					cmds = append(cmds, cmd)
+					return cmd
+				},
+			}
+			if err := client.ResolveConflict(tt.conflict, tt.theirs); err != nil {
+				t.Fatalf("ResolveConflict() error = %v", err)
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("commands = %v, want %v", got, tt.want)
+			}
+			for _, cmd := range cmds {
+				if cmd.Dir != root {
+					t.Errorf("%v ran in %q, want the top level %q", cmd.Args, cmd.Dir, root)
+				}
+			}
+		})
+	}
+}
+
+func TestClient_RunMergeTool(t *testing.T) {
+	root := t.TempDir()
+	var got []string
+	var cmd *exec.Cmd
+	client := &Client{
+		execCommand: func(name string, args ...string) *exec.Cmd {
+			if args[0] == "rev-parse" {
+				return exec.Command("echo", root)
+			}
+			got = append([]string{name}, args...)
+			cmd = exec.Command("true")
+			return cmd
+		},
+	}
+
+	if err := client.RunMergeTool("vimdiff", []string{"a.go", "b.go"}); err != nil {
+		t.Fatalf("RunMergeTool() error = %v", err)
+	}
+	want := []string{"git", "mergetool", "--no-prompt", "--tool=vimdiff", "--", "a.go", "b.go"}
+	if !slices.Equal(got, want) {
+		t.Errorf("args = %v, want %v", got, want)
+	}
+	if cmd.Dir != root {
+		t.Errorf("mergetool ran in %q, want the top level %q", cmd.Dir, root)
+	}
+
+	if err := client.RunMergeTool("", nil); err != nil {
+		t.Fatalf("RunMergeTool() error = %v", err)
+	}
+	if want := []string{"git", "mergetool", "--no-prompt", "--"}; !slices.Equal(got, want) {
+		t.Errorf("args = %v, want %v", got, want)
+	}
+}
@@ -7,6 +7,7 @@ import (
 // Client is a git client.
 type Client struct {
 	execCommand func(name string, arg ...string) *exec.Cmd
+	noColor     bool
 }
 
 // NewClient creates a new Client.
@@ -15,3 +16,23 @@ func NewClient() *Client {
 		execCommand: exec.Command,
 	}
 }
+
+// ColorSetter is implemented by clients whose forced-color queries can be
+// switched off, e.g. when output is piped or NO_COLOR is set.
+type ColorSetter interface {
+	SetColor(enabled bool)
+}
+
+// SetColor controls whether queries that capture colored output (status,
+// previews) ask git for color.
+func (c *Client) SetColor(enabled bool) {
+	c.noColor = !enabled
+}
+
+// colorWhen returns the value for git's --color option and color.* settings.
+func (c *Client) colorWhen() string {
+	if c.noColor {
+		return "never"
+	}
+	return "always"
+}
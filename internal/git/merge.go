@@ -0,0 +1,65 @@
+package git
+
+import (
+	"os"
+	"strings"
+)
+
+// MergeOps provides operations used by the merge command.
+type MergeOps interface {
+	// merge operations
+	Merge(branch string) error
+	MergeNoFF(branch string) error
+	MergeSquash(branch string) error
+	MergeFFOnly(branch string) error
+	MergeAbort() error
+	MergeContinue() error
+	// discovery
+	GetCurrentBranch() (string, error)
+	ListLocalBranches() ([]string, error)
+	RevParseVerify(ref string) bool
+}
+
+// Merge merges branch into the current branch, fast-forwarding when possible.
+func (c *Client) Merge(branch string) error {
+	return c.runMerge("merge", branch)
+}
+
+// MergeNoFF merges branch and always creates a merge commit.
+func (c *Client) MergeNoFF(branch string) error {
+	return c.runMerge("merge no-ff", "--no-ff", branch)
+}
+
+// MergeSquash stages the changes of branch as a single change without
+// committing them.
+func (c *Client) MergeSquash(branch string) error {
+	return c.runMerge("merge squash", "--squash", branch)
+}
+
+// MergeFFOnly merges branch only when the current branch can be
+// fast-forwarded.
+func (c *Client) MergeFFOnly(branch string) error {
+	return c.runMerge("merge ff-only", "--ff-only", branch)
+}
+
+// MergeAbort aborts an in-progress merge.
+func (c *Client) MergeAbort() error {
+	return c.runMerge("merge abort", "--abort")
+}
+
+// MergeContinue concludes an in-progress merge after conflicts are resolved.
+func (c *Client) MergeContinue() error {
+	return c.runMerge("merge continue", "--continue")
+}
+
+func (c *Client) runMerge(op string, args ...string) error {
+	cmdArgs := append([]string{"merge"}, args...)
+	cmd := c.execCommand("git", cmdArgs...)
+	cmd.Stdin = os.Stdin
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	if err := cmd.Run(); err != nil {
+		return NewOpError(op, "git "+strings.Join(cmdArgs, " "), err)
+	}
+	return nil
+}
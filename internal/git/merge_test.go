@@ -0,0 +1,58 @@
+package git
+
+import (
+	"os/exec"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestClient_MergeOps(t *testing.T) {
+	tests := []struct {
+		name     string
+		run      func(c *Client) error
+		wantArgs []string
+	}{
+		{"merge", func(c *Client) error { return c.Merge("feature") }, []string{"git", "merge", "feature"}},
+		{"no-ff", func(c *Client) error { return c.MergeNoFF("feature") }, []string{"git", "merge", "--no-ff", "feature"}},
+		{"squash", func(c *Client) error { return c.MergeSquash("feature") }, []string{"git", "merge", "--squash", "feature"}},
+		{"ff-only", func(c *Client) error { return c.MergeFFOnly("feature") }, []string{"git", "merge", "--ff-only", "feature"}},
+		{"abort", func(c *Client) error { return c.MergeAbort() }, []string{"git", "merge", "--abort"}},
+		{"continue", func(c *Client) error { return c.MergeContinue() }, []string{"git", "merge", "--continue"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var gotArgs []string
+			client := &Client{
+				execCommand: func(name string, args ...string) *exec.Cmd {
+					gotArgs = append([]string{name}, args...)
+					return exec.Command("true")
+				},
+			}
+
+			if err := tt.run(client); err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+			if !slices.Equal(gotArgs, tt.wantArgs) {
+				t.Errorf("gotArgs = %v, want %v", gotArgs, tt.wantArgs)
+			}
+		})
+	}
+}
+
+func TestClient_Merge_Error(t *testing.T) {
+	client := &Client{
+		execCommand: func(string, ...string) *exec.Cmd {
+			return exec.Command("false")
+		},
+	}
+
+	err := client.MergeNoFF("feature")
+	if err == nil {
+		t.Fatal("expected an error")
+	}
+	if !strings.Contains(err.Error(), "git merge --no-ff feature") {
+		t.Errorf("error should name the command, got %v", err)
+	}
+}
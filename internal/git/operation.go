@@ -0,0 +1,88 @@
+package git
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// Operation is a multi-step git operation that stops and waits to be
+// continued or aborted, such as a rebase stopped on conflicts or a bisect.
+type Operation string
+
+// Operations detected from the state files in the git directory.
+const (
+	OperationNone       Operation = ""
+	OperationMerge      Operation = "merge"
+	OperationRebase     Operation = "rebase"
+	OperationCherryPick Operation = "cherry-pick"
+	OperationRevert     Operation = "revert"
+	OperationBisect     Operation = "bisect"
+)
+
+// OperationReader reports the operation in progress.
+type OperationReader interface {
+	OperationInProgress() (Operation, error)
+}
+
+// CanContinue reports whether op is continued with "git <op> --continue"
+// once its conflicts are resolved.
+func (o Operation) CanContinue() bool {
+	return o != OperationNone && o != OperationBisect
+}
+
+// operationMarkers maps state files and directories in the git directory
+// to the operation they indicate, in detection order. A rebase that stops
+// while picking a commit also leaves CHERRY_PICK_HEAD behind, so the rebase
+// markers come first. A bisect can be interrupted by any of the others, so
+// it comes last.
+var operationMarkers = []struct {
+	name string
+	op   Operation
+}{
+	{"rebase-merge", OperationRebase},
+	{"rebase-apply", OperationRebase},
+	{"MERGE_HEAD", OperationMerge},
+	{"CHERRY_PICK_HEAD", OperationCherryPick},
+	{"REVERT_HEAD", OperationRevert},
+	{"BISECT_LOG", OperationBisect},
+}
+
+// GitDir returns the absolute path of the git directory of the current
+// worktree.
+func (c *Client) GitDir() (string, error) {
+	cmd := c.execCommand("git", "rev-parse", "--absolute-git-dir")
+	out, err := cmd.Output()
+	if err != nil {
+		return "", NewOpError("get git directory", "git rev-parse --absolute-git-dir", err)
+	}
+	return strings.TrimSpace(string(out)), nil
+}
+
+// OperationInProgress reports which operation, if any, is waiting to be
+// continued in the current worktree.
+func (c *Client) OperationInProgress() (Operation, error) {
+	dir, err := c.GitDir()
+	if err != nil {
+		return OperationNone, err
+	}
+	for _, marker := range operationMarkers {
+		if _, err := os.Stat(filepath.Join(dir, marker.name)); err == nil {
+			return marker.op, nil
+		}
+	}
+	return OperationNone, nil
+}
+
+// ContinueOperation continues op after its conflicts are resolved. git may
+// open an editor for the commit message.
+func (c *Client) ContinueOperation(op Operation) error {
+	cmd := c.execCommand("git", string(op), "--continue")
+	cmd.Stdin = os.Stdin
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	if err := cmd.Run(); err != nil {
+		return NewOpError(string(op)+" continue", "git "+string(op)+" --continue", err)
+	}
+	return nil
+}
@@ -0,0 +1,99 @@
+package git
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"slices"
+	"testing"
+)
+
+func TestClient_OperationInProgress(t *testing.T) {
+	tests := []struct {
+		name    string
+		markers []string
+		want    Operation
+	}{
+		{"none", nil, OperationNone},
+		{"merge", []string{"MERGE_HEAD"}, OperationMerge},
+		{"rebase", []string{"rebase-merge/"}, OperationRebase},
+		{"rebase_apply", []string{"rebase-apply/"}, OperationRebase},
+		{"rebase_stopped_on_pick", []string{"rebase-merge/", "CHERRY_PICK_HEAD"}, OperationRebase},
+		{"cherry_pick", []string{"CHERRY_PICK_HEAD"}, OperationCherryPick},
+		{"revert", []string{"REVERT_HEAD"}, OperationRevert},
+		{"bisect", []string{"BISECT_LOG"}, OperationBisect},
+		{"merge_while_bisecting", []string{"BISECT_LOG", "MERGE_HEAD"}, OperationMerge},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			for _, m := range tt.markers {
+				path := filepath.Join(dir, m)
+				var err error
+				if m[len(m)-1] == '/' {
+					err = os.Mkdir(path, 0o755)
+				} else {
+					err = os.WriteFile(path, []byte("abc\n"), 0o644)
+				}
+				if err != nil {
+					t.Fatal(err)
+				}
+			}
+			client := &Client{
+				execCommand: func(string, ...string) *exec.Cmd {
+					return exec.Command("echo", dir)
+				},
+			}
+
+			got, err := client.OperationInProgress()
+			if err != nil {
+				t.Fatalf("OperationInProgress() error = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("OperationInProgress() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestClient_OperationInProgress_Error(t *testing.T) {
+	client := &Client{
+		execCommand: func(string, ...string) *exec.Cmd {
+			return exec.Command("false")
+		},
+	}
+	if _, err := client.OperationInProgress(); err == nil {
+		t.Error("expected an error outside a repository")
+	}
+}
+
+func TestOperation_CanContinue(t *testing.T) {
+	for _, op := range []Operation{OperationMerge, OperationRebase, OperationCherryPick, OperationRevert} {
+		if !op.CanContinue() {
+			t.Errorf("%q should be continuable", op)
+		}
+	}
+	for _, op := range []Operation{OperationNone, OperationBisect} {
+		if op.CanContinue() {
+			t.Errorf("%q should not be continuable", op)
+		}
+	}
+}
+
+func TestClient_ContinueOperation(t *testing.T) {
+	var got []string
+	client := &Client{
+		execCommand: func(name string, args ...string) *exec.Cmd {
+			got = append([]string{name}, args...)
+			return exec.Command("true")
+		},
+	}
+
+	if err := client.ContinueOperation(OperationCherryPick); err != nil {
+		t.Fatalf("ContinueOperation() error = %v", err)
+	}
+	if want := []string{"git", "cherry-pick", "--continue"}; !slices.Equal(got, want) {
+		t.Errorf("args = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,77 @@
+package git
+
+import (
+	"bytes"
+	"context"
+	"strconv"
+	"strings"
+)
+
+// PreviewReader runs cheap, read-only queries used to preview commands in
+// interactive mode. Each query stops early when ctx is cancelled.
+type PreviewReader interface {
+	DiffStat(ctx context.Context, args ...string) (string, error)
+	StashSummary(ctx context.Context) (string, error)
+	BranchSummary(ctx context.Context) (string, error)
+	RecentCommits(ctx context.Context, limit int, all bool) (string, error)
+	StatusSummary(ctx context.Context) (string, error)
+}
+
+// DiffStat returns `git diff --stat` for the given diff arguments.
+func (c *Client) DiffStat(ctx context.Context, args ...string) (string, error) {
+	return c.outputContext(ctx, "diff stat", append([]string{"diff", "--stat", "--color=" + c.colorWhen()}, args...)...)
+}
+
+// StashSummary returns the stash list.
+func (c *Client) StashSummary(ctx context.Context) (string, error) {
+	return c.outputContext(ctx, "stash summary", "stash", "list")
+}
+
+// BranchSummary returns local branches with their upstream and last commit.
+func (c *Client) BranchSummary(ctx context.Context) (string, error) {
+	return c.outputContext(ctx, "branch summary", "branch", "-vv", "--color="+c.colorWhen())
+}
+
+// RecentCommits returns the last limit commits as a decorated graph,
+// across all refs when all is true.
+func (c *Client) RecentCommits(ctx context.Context, limit int, all bool) (string, error) {
+	args := []string{"log", "--oneline", "--graph", "--decorate", "--color=" + c.colorWhen(), "-n", strconv.Itoa(limit)}
+	if all {
+		args = append(args, "--all")
+	}
+	return c.outputContext(ctx, "recent commits", args...)
+}
+
+// StatusSummary returns the short status with branch information.
+func (c *Client) StatusSummary(ctx context.Context) (string, error) {
+	return c.outputContext(ctx, "status summary", "-c", "color.status="+c.colorWhen(), "status", "--short", "--branch")
+}
+
+// outputContext runs git with args and returns its stdout, killing the
+// process if ctx is cancelled before it finishes.
+func (c *Client) outputContext(ctx context.Context, op string, args ...string) (string, error) {
+	if err := ctx.Err(); err != nil {
+		return "", err
+	}
+	command := "git " + strings.Join(args, " ")
+	cmd := c.execCommand("git", args...)
+	var out bytes.Buffer
+	cmd.Stdout = &out
+	if err := cmd.Start(); err != nil {
+		return "", NewOpError(op, command, err)
+	}
+
+	done := make(chan error, 1)
+	go func() { done <- cmd.Wait() }()
+	select {
+	case err := <-done:
+		if err != nil {
+			return "", NewOpError(op, command, err)
+		}
+		return out.String(), nil
+	case <-ctx.Done():
+		_ = cmd.Process.Kill()
+		<-done
+		return "", ctx.Err()
+	}
+}
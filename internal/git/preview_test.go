@@ -0,0 +1,96 @@
+package git
+
+import (
+	"context"
+	"errors"
+	"os/exec"
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestClient_PreviewQueries(t *testing.T) {
+	cases := []struct {
+		name     string
+		run      func(*Client) (string, error)
+		wantArgs []string
+	}{
+		{
+			name:     "DiffStat",
+			run:      func(c *Client) (string, error) { return c.DiffStat(context.Background(), "--staged") },
+			wantArgs: []string{"git", "diff", "--stat", "--color=always", "--staged"},
+		},
+		{
+			name:     "StashSummary",
+			run:      func(c *Client) (string, error) { return c.StashSummary(context.Background()) },
+			wantArgs: []string{"git", "stash", "list"},
+		},
+		{
+			name:     "BranchSummary",
+			run:      func(c *Client) (string, error) { return c.BranchSummary(context.Background()) },
+			wantArgs: []string{"git", "branch", "-vv", "--color=always"},
+		},
+		{
+			name:     "RecentCommits",
+			run:      func(c *Client) (string, error) { return c.RecentCommits(context.Background(), 5, true) },
+			wantArgs: []string{"git", "log", "--oneline", "--graph", "--decorate", "--color=always", "-n", "5", "--all"},
+		},
+		{
+			name:     "StatusSummary",
+			run:      func(c *Client) (string, error) { return c.StatusSummary(context.Background()) },
+			wantArgs: []string{"git", "-c", "color.status=always", "status", "--short", "--branch"},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			var gotArgs []string
+			client := &Client{
+				execCommand: func(name string, args ...string) *exec.Cmd {
+					gotArgs = append([]string{name}, args...)
+					return helperCommand(t, "preview", nil)
+				},
+			}
+			out, err := tc.run(client)
+			if err != nil {
+				t.Fatalf("%s() error = %v", tc.name, err)
+			}
+			if out != "preview" {
+				t.Errorf("%s() = %q, want %q", tc.name, out, "preview")
+			}
+			if !slices.Equal(gotArgs, tc.wantArgs) {
+				t.Errorf("%s() args = %v, want %v", tc.name, gotArgs, tc.wantArgs)
+			}
+		})
+	}
+}
+
+func TestClient_PreviewQueryError(t *testing.T) {
+	client := &Client{
+		execCommand: func(string, ...string) *exec.Cmd {
+			return helperCommand(t, "", errors.New("failed"))
+		},
+	}
+	if _, err := client.StashSummary(context.Background()); err == nil {
+		t.Fatal("expected error from failing command")
+	}
+}
+
+func TestClient_PreviewQueryCancelled(t *testing.T) {
+	client := &Client{
+		execCommand: func(string, ...string) *exec.Cmd {
+			return exec.Command("sleep", "5")
+		},
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	_, err := client.StatusSummary(ctx)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("StatusSummary() error = %v, want deadline exceeded", err)
+	}
+	if elapsed := time.Since(start); elapsed > 2*time.Second {
+		t.Errorf("cancelled query took %v, expected the process to be killed", elapsed)
+	}
+}
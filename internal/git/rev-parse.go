@@ -4,6 +4,11 @@ import (
 	"strings"
 )
 
+// RepositoryRootReader provides the top-level directory of the current repository.
+type RepositoryRootReader interface {
+	GetRepositoryRoot() (string, error)
+}
+
 // GetCurrentBranch gets the current branch name.
 func (c *Client) GetCurrentBranch() (string, error) {
 	cmd := c.execCommand("git", "rev-parse", "--abbrev-ref", "HEAD")
@@ -54,3 +59,42 @@ func (c *Client) GetUpstreamBranchName(branch string) (string, error) {
 	}
 	return strings.TrimSpace(string(out)), nil
 }
+
+// GetRepositoryRoot gets the absolute path of the repository's top-level directory.
+func (c *Client) GetRepositoryRoot() (string, error) {
+	cmd := c.execCommand("git", "rev-parse", "--show-toplevel")
+	out, err := cmd.Output()
+	if err != nil {
+		return "", NewOpError("get repository root", "git rev-parse --show-toplevel", err)
+	}
+	return strings.TrimSpace(string(out)), nil
+}
+
+// GetRepositoryPrefix gets the path of the current directory relative to the
+// repository's top-level directory, such as "cmd/" or "" at the top level.
+func (c *Client) GetRepositoryPrefix() (string, error) {
+	cmd := c.execCommand("git", "rev-parse", "--show-prefix")
+	out, err := cmd.Output()
+	if err != nil {
+		return "", NewOpError("get repository prefix", "git rev-parse --show-prefix", err)
+	}
+	return strings.TrimSpace(string(out)), nil
+}
+
+// GitPathResolver resolves paths inside the git directory. Unlike joining
+// ".git" by hand it works in linked worktrees, where .git is a file, and
+// honors settings such as core.hooksPath.
+type GitPathResolver interface {
+	GitPath(name string) (string, error)
+}
+
+// GitPath resolves name inside the git directory of the current worktree.
+// It runs: git rev-parse --git-path <name>
+func (c *Client) GitPath(name string) (string, error) {
+	cmd := c.execCommand("git", "rev-parse", "--git-path", name)
+	out, err := cmd.Output()
+	if err != nil {
+		return "", NewOpError("get git path", "git rev-parse --git-path "+name, err)
+	}
+	return strings.TrimSpace(string(out)), nil
+}
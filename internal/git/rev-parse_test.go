@@ -242,3 +242,71 @@ func TestClient_GetUpstreamBranchName_Error(t *testing.T) {
 		t.Error("Expected GetUpstreamBranchName to return an error")
 	}
 }
+
+func TestClient_GetRepositoryRoot(t *testing.T) {
+	var gotArgs []string
+	client := &Client{
+		execCommand: func(name string, args ...string) *exec.Cmd {
+			gotArgs = append([]string{name}, args...)
+			return exec.Command("echo", "/home/user/project")
+		},
+	}
+
+	root, err := client.GetRepositoryRoot()
+	if err != nil {
+		t.Fatalf("GetRepositoryRoot() error = %v", err)
+	}
+	wantArgs := []string{"git", "rev-parse", "--show-toplevel"}
+	if !slices.Equal(gotArgs, wantArgs) {
+		t.Errorf("GetRepositoryRoot() gotArgs = %v, want %v", gotArgs, wantArgs)
+	}
+	if root != "/home/user/project" {
+		t.Errorf("GetRepositoryRoot() = %q, want %q", root, "/home/user/project")
+	}
+}
+
+func TestClient_GetRepositoryRoot_Error(t *testing.T) {
+	client := &Client{
+		execCommand: func(name string, args ...string) *exec.Cmd {
+			return exec.Command("false")
+		},
+	}
+
+	if _, err := client.GetRepositoryRoot(); err == nil {
+		t.Error("Expected GetRepositoryRoot to return an error")
+	}
+}
+
+func TestClient_GitPath(t *testing.T) {
+	var gotArgs []string
+	client := &Client{
+		execCommand: func(name string, args ...string) *exec.Cmd {
+			gotArgs = append([]string{name}, args...)
+			return exec.Command("echo", "/home/user/project/.git/hooks")
+		},
+	}
+
+	path, err := client.GitPath("hooks")
+	if err != nil {
+		t.Fatalf("GitPath() error = %v", err)
+	}
+	wantArgs := []string{"git", "rev-parse", "--git-path", "hooks"}
+	if !slices.Equal(gotArgs, wantArgs) {
+		t.Errorf("GitPath() gotArgs = %v, want %v", gotArgs, wantArgs)
+	}
+	if path != "/home/user/project/.git/hooks" {
+		t.Errorf("GitPath() = %q", path)
+	}
+}
+
+func TestClient_GitPath_Error(t *testing.T) {
+	client := &Client{
+		execCommand: func(name string, args ...string) *exec.Cmd {
+			return exec.Command("false")
+		},
+	}
+
+	if _, err := client.GitPath("hooks"); err == nil {
+		t.Error("Expected GitPath to return an error")
+	}
+}
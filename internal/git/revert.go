@@ -0,0 +1,106 @@
+package git
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+)
+
+// RevertOps provides operations used by the revert command.
+type RevertOps interface {
+	// sequence operations
+	Revert(commits []string, opts *RevertOptions) error
+	RevertContinue() error
+	RevertAbort() error
+	// discovery
+	LogRecent(limit int) (string, error)
+	CommitParents(commit string) ([]string, error)
+	CommitFiles(commit string, mainline int) (string, error)
+	RevParseVerify(ref string) bool
+}
+
+// RevertOptions holds options for git revert command
+type RevertOptions struct {
+	NoCommit bool // stage the reverted changes without committing
+	Mainline int  // parent number to keep when reverting a merge commit
+}
+
+// Revert creates commits that undo commits, newest first as git does.
+func (c *Client) Revert(commits []string, opts *RevertOptions) error {
+	args := []string{"revert"}
+	if opts != nil {
+		if opts.NoCommit {
+			args = append(args, "--no-commit")
+		}
+		if opts.Mainline > 0 {
+			args = append(args, "-m", strconv.Itoa(opts.Mainline))
+		}
+	}
+	args = append(args, commits...)
+	return c.runRevert("revert", args)
+}
+
+// RevertContinue continues a revert after conflicts are resolved.
+func (c *Client) RevertContinue() error {
+	return c.runRevert("revert continue", []string{"revert", "--continue"})
+}
+
+// RevertAbort cancels a revert and restores the branch.
+func (c *Client) RevertAbort() error {
+	return c.runRevert("revert abort", []string{"revert", "--abort"})
+}
+
+// LogRecent lists the latest commits of the current branch as
+// "<hash> <subject>" lines, newest first.
+func (c *Client) LogRecent(limit int) (string, error) {
+	args := []string{"log", "--format=%h %s", "-n", strconv.Itoa(limit)}
+	cmd := c.execCommand("git", args...)
+	out, err := cmd.Output()
+	if err != nil {
+		return "", NewOpError("log recent", "git "+strings.Join(args, " "), err)
+	}
+	return string(out), nil
+}
+
+// CommitParents lists the parents of commit as "<hash> <subject>" lines in
+// parent order. A merge commit has more than one.
+func (c *Client) CommitParents(commit string) ([]string, error) {
+	args := []string{"show", "--no-patch", "--format=%h %s", commit + "^@"}
+	cmd := c.execCommand("git", args...)
+	out, err := cmd.Output()
+	if err != nil {
+		return nil, NewOpError("get commit parents", "git "+strings.Join(args, " "), err)
+	}
+	trimmed := strings.TrimSpace(string(out))
+	if trimmed == "" {
+		return nil, nil
+	}
+	return strings.Split(trimmed, "\n"), nil
+}
+
+// CommitFiles lists the files commit changes in --name-status format. For a
+// merge commit, mainline names the parent to compare against.
+func (c *Client) CommitFiles(commit string, mainline int) (string, error) {
+	args := []string{"show", "--format=", "--name-status", commit}
+	if mainline > 0 {
+		args = []string{"diff", "--name-status", fmt.Sprintf("%s^%d", commit, mainline), commit}
+	}
+	cmd := c.execCommand("git", args...)
+	out, err := cmd.Output()
+	if err != nil {
+		return "", NewOpError("get commit files", "git "+strings.Join(args, " "), err)
+	}
+	return string(out), nil
+}
+
+func (c *Client) runRevert(op string, args []string) error {
+	cmd := c.execCommand("git", args...)
+	cmd.Stdin = os.Stdin
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	if err := cmd.Run(); err != nil {
+		return NewOpError(op, "git "+strings.Join(args, " "), err)
+	}
+	return nil
+}
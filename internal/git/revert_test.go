@@ -0,0 +1,129 @@
+package git
+
+import (
+	"os/exec"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestClient_RevertOps(t *testing.T) {
+	tests := []struct {
+		name     string
+		run      func(c *Client) error
+		wantArgs []string
+	}{
+		{"commits", func(c *Client) error { return c.Revert([]string{"abc123", "def456"}, nil) }, []string{"git", "revert", "abc123", "def456"}},
+		{"no_commit", func(c *Client) error { return c.Revert([]string{"abc123"}, &RevertOptions{NoCommit: true}) }, []string{"git", "revert", "--no-commit", "abc123"}},
+		{"mainline", func(c *Client) error { return c.Revert([]string{"abc123"}, &RevertOptions{Mainline: 1}) }, []string{"git", "revert", "-m", "1", "abc123"}},
+		{"continue", func(c *Client) error { return c.RevertContinue() }, []string{"git", "revert", "--continue"}},
+		{"abort", func(c *Client) error { return c.RevertAbort() }, []string{"git", "revert", "--abort"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var gotArgs []string
+			client := &Client{
+				execCommand: func(name string, args ...string) *exec.Cmd {
+					gotArgs = append([]string{name}, args...)
+					return exec.Command("true")
+				},
+			}
+
+			if err := tt.run(client); err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+			if !slices.Equal(gotArgs, tt.wantArgs) {
+				t.Errorf("gotArgs = %v, want %v", gotArgs, tt.wantArgs)
+			}
+		})
+	}
+}
+
+func TestClient_Revert_Error(t *testing.T) {
+	client := &Client{
+		execCommand: func(string, ...string) *exec.Cmd {
+			return exec.Command("false")
+		},
+	}
+
+	err := client.Revert([]string{"abc123"}, nil)
+	if err == nil {
+		t.Fatal("expected an error")
+	}
+	if !strings.Contains(err.Error(), "git revert abc123") {
+		t.Errorf("error should name the command, got %v", err)
+	}
+}
+
+func TestClient_CommitParents(t *testing.T) {
+	var gotArgs []string
+	client := &Client{
+		execCommand: func(name string, args ...string) *exec.Cmd {
+			gotArgs = append([]string{name}, args...)
+			return exec.Command("printf", "aaa1111 Main work\nbbb2222 Feature work\n")
+		},
+	}
+
+	parents, err := client.CommitParents("abc123")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if want := []string{"aaa1111 Main work", "bbb2222 Feature work"}; !slices.Equal(parents, want) {
+		t.Errorf("parents = %v, want %v", parents, want)
+	}
+	if want := []string{"git", "show", "--no-patch", "--format=%h %s", "abc123^@"}; !slices.Equal(gotArgs, want) {
+		t.Errorf("gotArgs = %v, want %v", gotArgs, want)
+	}
+}
+
+func TestClient_CommitFiles(t *testing.T) {
+	tests := []struct {
+		name     string
+		mainline int
+		wantArgs []string
+	}{
+		{"commit", 0, []string{"git", "show", "--format=", "--name-status", "abc123"}},
+		{"merge", 2, []string{"git", "diff", "--name-status", "abc123^2", "abc123"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var gotArgs []string
+			client := &Client{
+				execCommand: func(name string, args ...string) *exec.Cmd {
+					gotArgs = append([]string{name}, args...)
+					return exec.Command("echo", "M\tmain.go")
+				},
+			}
+
+			out, err := client.CommitFiles("abc123", tt.mainline)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if strings.TrimSpace(out) != "M\tmain.go" {
+				t.Errorf("out = %q", out)
+			}
+			if !slices.Equal(gotArgs, tt.wantArgs) {
+				t.Errorf("gotArgs = %v, want %v", gotArgs, tt.wantArgs)
+			}
+		})
+	}
+}
+
+func TestClient_LogRecent(t *testing.T) {
+	var gotArgs []string
+	client := &Client{
+		execCommand: func(name string, args ...string) *exec.Cmd {
+			gotArgs = append([]string{name}, args...)
+			return exec.Command("echo", "abc123 Fix crash")
+		},
+	}
+
+	if _, err := client.LogRecent(20); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if want := []string{"git", "log", "--format=%h %s", "-n", "20"}; !slices.Equal(gotArgs, want) {
+		t.Errorf("gotArgs = %v, want %v", gotArgs, want)
+	}
+}
@@ -0,0 +1,118 @@
+package git
+
+import (
+	"slices"
+	"strings"
+	"time"
+)
+
+// ShowOps provides operations used by the show command.
+type ShowOps interface {
+	ShowCommit(rev string) (*CommitInfo, error)
+	CommitChildren(commit string) ([]string, error)
+	CommitDetails(commit string) (*CommitDetails, error)
+	CommitDiff(commit string) (string, error)
+}
+
+// CommitInfo describes a commit with its full message.
+type CommitInfo struct {
+	Hash           string
+	Parents        []string
+	Author         string
+	AuthorEmail    string
+	AuthorDate     time.Time
+	Committer      string
+	CommitterEmail string
+	CommitDate     time.Time
+	// Refs are the branches and tags pointing at the commit, as git log
+	// decorates them, such as "HEAD -> main" or "tag: v1.0.0".
+	Refs    []string
+	Subject string
+	// Body is the message after the subject, without the trailers.
+	Body     string
+	Trailers []Trailer
+}
+
+// Trailer is a "Key: value" line at the end of a commit message, such as
+// "Signed-off-by: Jane <jane@example.com>".
+type Trailer struct {
+	Key   string
+	Value string
+}
+
+// commitInfoFormat separates the fields with NUL, which commit messages
+// cannot contain.
+const commitInfoFormat = "%H%x00%P%x00%an%x00%ae%x00%aI%x00%cn%x00%ce%x00%cI%x00%D%x00%s%x00%b%x00%(trailers:only,unfold)"
+
+// ShowCommit returns the commit rev points to, peeling tags.
+func (c *Client) ShowCommit(rev string) (*CommitInfo, error) {
+	if rev == "" {
+		rev = "HEAD"
+	}
+	args := []string{"log", "-1", "--format=" + commitInfoFormat, rev, "--"}
+	cmd := c.execCommand("git", args...)
+	out, err := cmd.Output()
+	if err != nil {
+		return nil, NewOpError("show commit", "git "+strings.Join(args, " "), err)
+	}
+	return parseCommitInfo(string(out)), nil
+}
+
+func parseCommitInfo(out string) *CommitInfo {
+	fields := strings.Split(out, "\x00")
+	for len(fields) < 12 {
+		fields = append(fields, "")
+	}
+	info := &CommitInfo{
+		Hash:           fields[0],
+		Parents:        strings.Fields(fields[1]),
+		Author:         fields[2],
+		AuthorEmail:    fields[3],
+		Committer:      fields[5],
+		CommitterEmail: fields[6],
+		Subject:        fields[9],
+	}
+	info.AuthorDate, _ = time.Parse(time.RFC3339, fields[4])
+	info.CommitDate, _ = time.Parse(time.RFC3339, fields[7])
+	if fields[8] != "" {
+		info.Refs = strings.Split(fields[8], ", ")
+	}
+	for _, line := range strings.Split(fields[11], "\n") {
+		if key, value, ok := strings.Cut(line, ": "); ok {
+			info.Trailers = append(info.Trailers, Trailer{Key: key, Value: value})
+		}
+	}
+	info.Body = strings.TrimRight(fields[10], "\n")
+	if len(info.Trailers) > 0 {
+		info.Body = stripTrailerBlock(info.Body)
+	}
+	return info
+}
+
+// stripTrailerBlock drops the last paragraph of body, which is where git
+// finds the trailers.
+func stripTrailerBlock(body string) string {
+	if i := strings.LastIndex(body, "\n\n"); i >= 0 {
+		return strings.TrimRight(body[:i], "\n")
+	}
+	return ""
+}
+
+// CommitChildren returns the commits reachable from any ref that have
+// commit, a full hash, as a parent.
+func (c *Client) CommitChildren(commit string) ([]string, error) {
+	args := []string{"rev-list", "--parents", "--ancestry-path", "--all", "^" + commit}
+	cmd := c.execCommand("git", args...)
+	out, err := cmd.Output()
+	if err != nil {
+		return nil, NewOpError("list child commits", "git "+strings.Join(args, " "), err)
+	}
+	var children []string
+	for _, line := range strings.Split(strings.TrimSpace(string(out)), "\n") {
+		fields := strings.Fields(line)
+		if len(fields) > 1 && slices.Contains(fields[1:], commit) {
+			children = append(children, fields[0])
+		}
+	}
+	return children, nil
+}
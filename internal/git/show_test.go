@@ -0,0 +1,108 @@
+package git
+
+import (
+	"os/exec"
+	"reflect"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestClient_ShowCommit(t *testing.T) {
+	out := "57d318092a0a5812099d307c07d065d8d03aeeb2\x00c915211aaa320203388a0d8486869775981e3311\x00Jane\x00jane@example.com\x00" +
+		"2026-10-19T08:39:20+09:00\x00Bob\x00bob@example.com\x002026-10-20T10:00:00+00:00\x00HEAD -> main, tag: v1\x00" +
+		"Fix the parser\x00Longer body\nline two.\n\nSigned-off-by: Jane <jane@example.com>\nReviewed-by: Bob\n\x00" +
+		"Signed-off-by: Jane <jane@example.com>\nReviewed-by: Bob\n\n"
+
+	var gotArgs []string
+	client := &Client{
+		execCommand: func(name string, args ...string) *exec.Cmd {
+			gotArgs = args
+			// Arguments cannot contain NUL, so printf expands it.
+			return exec.Command("printf", "%b", strings.ReplaceAll(out, "\x00", `\0000`))
+		},
+	}
+
+	got, err := client.ShowCommit("")
+	if err != nil {
+		t.Fatalf("ShowCommit() error = %v", err)
+	}
+	wantArgs := []string{"log", "-1", "--format=" + commitInfoFormat, "HEAD", "--"}
+	if !slices.Equal(gotArgs, wantArgs) {
+		t.Errorf("args = %v, want %v", gotArgs, wantArgs)
+	}
+	want := &CommitInfo{
+		Hash:           "57d318092a0a5812099d307c07d065d8d03aeeb2",
+		Parents:        []string{"c915211aaa320203388a0d8486869775981e3311"},
+		Author:         "Jane",
+		AuthorEmail:    "jane@example.com",
+		AuthorDate:     time.Date(2026, 10, 19, 8, 39, 20, 0, time.FixedZone("", 9*60*60)),
+		Committer:      "Bob",
+		CommitterEmail: "bob@example.com",
+		CommitDate:     time.Date(2026, 10, 20, 10, 0, 0, 0, time.UTC),
+		Refs:           []string{"HEAD -> main", "tag: v1"},
+		Subject:        "Fix the parser",
+		Body:           "Longer body\nline two.",
+		Trailers: []Trailer{
+			{Key: "Signed-off-by", Value: "Jane <jane@example.com>"},
+			{Key: "Reviewed-by", Value: "Bob"},
+		},
+	}
+	if !got.AuthorDate.Equal(want.AuthorDate) || !got.CommitDate.Equal(want.CommitDate) {
+		t.Errorf("dates = %v, %v", got.AuthorDate, got.CommitDate)
+	}
+	got.AuthorDate, got.CommitDate = want.AuthorDate, want.CommitDate
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ShowCommit() =\n%+v\nwant\n%+v", got, want)
+	}
+}
+
+func TestParseCommitInfo_RootCommitWithoutBody(t *testing.T) {
+	got := parseCommitInfo("abc\x00\x00Jane\x00j@x\x00\x00Jane\x00j@x\x00\x00\x00init\x00\x00\n")
+	if len(got.Parents) != 0 || len(got.Refs) != 0 || got.Body != "" || len(got.Trailers) != 0 || got.Subject != "init" {
+		t.Errorf("parseCommitInfo() = %+v", got)
+	}
+}
+
+func TestParseCommitInfo_OnlyTrailers(t *testing.T) {
+	got := parseCommitInfo("abc\x00\x00Jane\x00j@x\x00\x00Jane\x00j@x\x00\x00\x00init\x00Signed-off-by: Jane\n\x00Signed-off-by: Jane\n\n")
+	if got.Body != "" || len(got.Trailers) != 1 {
+		t.Errorf("parseCommitInfo() = %+v", got)
+	}
+}
+
+func TestClient_ShowCommit_Error(t *testing.T) {
+	client := &Client{
+		execCommand: func(string, ...string) *exec.Cmd {
+			return exec.Command("false")
+		},
+	}
+
+	if _, err := client.ShowCommit("nope"); err == nil {
+		t.Error("expected an error")
+	}
+}
+
+func TestClient_CommitChildren(t *testing.T) {
+	out := "ccc bbb\nddd bbb aaa\neee ddd\n"
+	var gotArgs []string
+	client := &Client{
+		execCommand: func(name string, args ...string) *exec.Cmd {
+			gotArgs = args
+			return exec.Command("printf", "%s", out)
+		},
+	}
+
+	got, err := client.CommitChildren("aaa")
+	if err != nil {
+		t.Fatalf("CommitChildren() error = %v", err)
+	}
+	if !slices.Equal(got, []string{"ddd"}) {
+		t.Errorf("CommitChildren() = %v, want [ddd]", got)
+	}
+	wantArgs := []string{"rev-list", "--parents", "--ancestry-path", "--all", "^aaa"}
+	if !slices.Equal(gotArgs, wantArgs) {
+		t.Errorf("args = %v, want %v", gotArgs, wantArgs)
+	}
+}
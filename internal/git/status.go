@@ -18,6 +18,8 @@ type BranchUpstreamReader interface {
 type StatusInfoReader interface {
 	StatusReader
 	BranchUpstreamReader
+	OperationReader
+	SubmoduleStatusReader
 }
 
 // Status gets git status output.
@@ -42,20 +44,22 @@ func (c *Client) StatusShort() (string, error) {
 
 // StatusWithColor gets git status output with color.
 func (c *Client) StatusWithColor() (string, error) {
-	cmd := c.execCommand("git", "-c", "color.status=always", "status")
+	setting := "color.status=" + c.colorWhen()
+	cmd := c.execCommand("git", "-c", setting, "status")
 	out, err := cmd.Output()
 	if err != nil {
-		return "", NewOpError("get status with color", "git -c color.status=always status", err)
+		return "", NewOpError("get status with color", "git -c "+setting+" status", err)
 	}
 	return string(out), nil
 }
 
 // StatusShortWithColor gets git status --short output with color.
 func (c *Client) StatusShortWithColor() (string, error) {
-	cmd := c.execCommand("git", "-c", "color.status=always", "status", "--short")
+	setting := "color.status=" + c.colorWhen()
+	cmd := c.execCommand("git", "-c", setting, "status", "--short")
 	out, err := cmd.Output()
 	if err != nil {
-		return "", NewOpError("get status short with color", "git -c color.status=always status --short", err)
+		return "", NewOpError("get status short with color", "git -c "+setting+" status --short", err)
 	}
 	return string(out), nil
 }
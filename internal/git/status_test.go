@@ -84,6 +84,26 @@ func TestClient_StatusWithColor(t *testing.T) {
 	}
 }
 
+func TestClient_StatusWithColor_Disabled(t *testing.T) {
+	var gotArgs []string
+	client := &Client{
+		execCommand: func(name string, args ...string) *exec.Cmd {
+			gotArgs = append([]string{name}, args...)
+			return exec.Command("echo", "-n", "")
+		},
+	}
+	client.SetColor(false)
+
+	if _, err := client.StatusWithColor(); err != nil {
+		t.Errorf("StatusWithColor() error = %v", err)
+	}
+
+	wantArgs := []string{"git", "-c", "color.status=never", "status"}
+	if !slices.Equal(gotArgs, wantArgs) {
+		t.Errorf("StatusWithColor() gotArgs = %v, want %v", gotArgs, wantArgs)
+	}
+}
+
 func TestClient_StatusShortWithColor(t *testing.T) {
 	var gotArgs []string
 	expectedOutput := " M file.go\n?? new_file.go"
@@ -0,0 +1,206 @@
+package git
+
+import (
+	"os"
+	"strings"
+)
+
+// SubmoduleStatusReader reports the state of the submodules.
+type SubmoduleStatusReader interface {
+	ListSubmodules() ([]Submodule, error)
+}
+
+// SubmoduleOps provides operations used by the submodule command.
+type SubmoduleOps interface {
+	SubmoduleStatusReader
+	SubmoduleInit(paths []string) error
+	SubmoduleUpdate(paths []string) error
+	SubmoduleSync(paths []string) error
+	SubmoduleForeach(command []string) error
+	SubmoduleAdd(url, path string) error
+}
+
+// Submodule describes a submodule of the repository.
+type Submodule struct {
+	// Path is relative to the current directory, as git prints it.
+	Path string
+	// Commit is the checked out commit, or the recorded one when the
+	// submodule is not initialized.
+	Commit string
+	// Recorded is the commit the superproject's index records when it
+	// differs from Commit.
+	Recorded string
+	// Describe names Commit by a ref when git finds one, such as
+	// "heads/main" or "v1.2.0".
+	Describe      string
+	Uninitialized bool
+	// OutOfDate is set when the checked out commit is not the recorded one.
+	OutOfDate  bool
+	Conflicted bool
+	// Modified and Untracked report changes in the submodule's own
+	// working tree.
+	Modified  bool
+	Untracked bool
+}
+
+// Dirty reports whether the submodule is out of sync with the superproject
+// or has changes of its own.
+func (s *Submodule) Dirty() bool {
+	return s.OutOfDate || s.Conflicted || s.Modified || s.Untracked
+}
+
+// ListSubmodules returns the submodules with their state. git submodule
+// status tells whether each is initialized and checks out the recorded
+// commit; git status adds the changes inside them.
+func (c *Client) ListSubmodules() ([]Submodule, error) {
+	cmd := c.execCommand("git", "submodule", "status")
+	out, err := cmd.Output()
+	if err != nil {
+		return nil, NewOpError("list submodules", "git submodule status", err)
+	}
+	submodules := parseSubmoduleStatus(string(out))
+
+	var paths []string
+	for _, s := range submodules {
+		if !s.Uninitialized {
+			paths = append(paths, s.Path)
+		}
+	}
+	if len(paths) == 0 {
+		return submodules, nil
+	}
+	// status.relativePaths keeps the paths relative to the current
+	// directory like those of git submodule status.
+	args := append([]string{"-c", "status.relativePaths=true", "status", "--porcelain=v2", "--"}, paths...)
+	cmd = c.execCommand("git", args...)
+	out, err = cmd.Output()
+	if err != nil {
+		return nil, NewOpError("list submodules", "git "+strings.Join(args, " "), err)
+	}
+	applySubmoduleChanges(submodules, string(out))
+	return submodules, nil
+}
+
+// parseSubmoduleStatus parses git submodule status lines of the form
+// "<state><hash> <path>[ (<describe>)]", where state is '-' for an
+// uninitialized submodule, '+' for one checking out another commit than
+// the recorded one and 'U' for one with merge conflicts.
+func parseSubmoduleStatus(out string) []Submodule {
+	var submodules []Submodule
+	for _, line := range strings.Split(out, "\n") {
+		if len(line) < 2 {
+			continue
+		}
+		hash, rest, ok := strings.Cut(line[1:], " ")
+		if !ok {
+			continue
+		}
+		s := Submodule{Commit: hash, Path: rest}
+		if strings.HasSuffix(rest, ")") {
+			if i := strings.LastIndex(rest, " ("); i >= 0 {
+				s.Path, s.Describe = rest[:i], rest[i+2:len(rest)-1]
+			}
+		}
+		switch line[0] {
+		case '-':
+			s.Uninitialized = true
+		case '+':
+			s.OutOfDate = true
+		case 'U':
+			s.Conflicted = true
+		}
+		submodules = append(submodules, s)
+	}
+	return submodules
+}
+
+// applySubmoduleChanges reads the submodule entries of git status
+// --porcelain=v2. Their "S<c><m><u>" field flags a changed commit,
+// tracked changes and untracked files.
+func applySubmoduleChanges(submodules []Submodule, out string) {
+	byPath := make(map[string]*Submodule, len(submodules))
+	for i := range submodules {
+		byPath[submodules[i].Path] = &submodules[i]
+	}
+	for _, line := range strings.Split(out, "\n") {
+		var fields []string
+		switch {
+		case strings.HasPrefix(line, "1 "):
+			fields = strings.SplitN(line, " ", 9)
+		case strings.HasPrefix(line, "2 "):
+			// Renamed entries end with "<score> <path>\t<original path>".
+			fields = strings.SplitN(line, " ", 10)
+			if len(fields) == 10 {
+				fields[8], _, _ = strings.Cut(fields[9], "\t")
+				fields = fields[:9]
+			}
+		}
+		if len(fields) != 9 || len(fields[2]) != 4 || fields[2][0] != 'S' {
+			continue
+		}
+		s, ok := byPath[fields[8]]
+		if !ok {
+			continue
+		}
+		flags := fields[2]
+		s.Modified = flags[2] == 'M'
+		s.Untracked = flags[3] == 'U'
+		if s.OutOfDate && flags[1] == 'C' && fields[7] != s.Commit {
+			s.Recorded = fields[7]
+		}
+	}
+}
+
+// SubmoduleInit registers the submodules at paths, or all of them, in the
+// repository's configuration.
+func (c *Client) SubmoduleInit(paths []string) error {
+	return c.runSubmodule("submodule init", withPaths([]string{"init"}, paths))
+}
+
+// SubmoduleUpdate checks out the recorded commit in the submodules at
+// paths, or all initialized ones, and in their nested submodules.
+func (c *Client) SubmoduleUpdate(paths []string) error {
+	return c.runSubmodule("submodule update", withPaths([]string{"update", "--recursive"}, paths))
+}
+
+// SubmoduleSync copies the submodule URLs from .gitmodules to the
+// configuration, after a URL changed upstream.
+func (c *Client) SubmoduleSync(paths []string) error {
+	return c.runSubmodule("submodule sync", withPaths([]string{"sync", "--recursive"}, paths))
+}
+
+// SubmoduleForeach runs command in each checked out submodule, nested ones
+// included. A single argument is run by the shell.
+func (c *Client) SubmoduleForeach(command []string) error {
+	return c.runSubmodule("submodule foreach", append([]string{"foreach", "--recursive"}, command...))
+}
+
+// SubmoduleAdd clones url into path, or a directory named after it, and
+// records it as a submodule.
+func (c *Client) SubmoduleAdd(url, path string) error {
+	args := []string{"add", "--", url}
+	if path != "" {
+		args = append(args, path)
+	}
+	return c.runSubmodule("submodule add", args)
+}
+
+func withPaths(args, paths []string) []string {
+	if len(paths) == 0 {
+		return args
+	}
+	return append(append(args, "--"), paths...)
+}
+
+// runSubmodule runs a submodule subcommand, showing its progress.
+func (c *Client) runSubmodule(op string, args []string) error {
+	cmdArgs := append([]string{"submodule"}, args...)
+	cmd := c.execCommand("git", cmdArgs...)
+	cmd.Stdin = os.Stdin
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	if err := cmd.Run(); err != nil {
+		return NewOpError(op, "git "+strings.Join(cmdArgs, " "), err)
+	}
+	return nil
+}
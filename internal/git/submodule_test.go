@@ -0,0 +1,136 @@
+package git
+
+import (
+	"os/exec"
+	"reflect"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestParseSubmoduleStatus(t *testing.T) {
+	out := ` 219e3fd480141f673b72eee25ababf5de4192a75 libs/lib (heads/main)
++5e076753631fa568c3d073786c489df5550a0911 vendor/old lib (5e07675)
+-8d99f2170cddc496187fb10bdf4c3bab5029e40b docs
+U0000000000000000000000000000000000000000 conflicted
+`
+	got := parseSubmoduleStatus(out)
+	want := []Submodule{
+		{Path: "libs/lib", Commit: "219e3fd480141f673b72eee25ababf5de4192a75", Describe: "heads/main"},
+		{Path: "vendor/old lib", Commit: "5e076753631fa568c3d073786c489df5550a0911", Describe: "5e07675", OutOfDate: true},
+		{Path: "docs", Commit: "8d99f2170cddc496187fb10bdf4c3bab5029e40b", Uninitialized: true},
+		{Path: "conflicted", Commit: "0000000000000000000000000000000000000000", Conflicted: true},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("parseSubmoduleStatus() =\n%+v\nwant\n%+v", got, want)
+	}
+}
+
+func TestClient_ListSubmodules(t *testing.T) {
+	submoduleStatus := " 219e3fd480141f673b72eee25ababf5de4192a75 other (heads/main)\n" +
+		"+5e076753631fa568c3d073786c489df5550a0911 libs/lib (5e07675)\n" +
+		"-8d99f2170cddc496187fb10bdf4c3bab5029e40b docs\n"
+	porcelain := "1 .M SC.. 160000 160000 160000 219e3fd480141f673b72eee25ababf5de4192a75 219e3fd480141f673b72eee25ababf5de4192a75 libs/lib\n" +
+		"1 .M S.MU 160000 160000 160000 219e3fd480141f673b72eee25ababf5de4192a75 219e3fd480141f673b72eee25ababf5de4192a75 other\n" +
+		"1 .M N... 100644 100644 100644 aaaa aaaa other.txt\n"
+
+	var calls [][]string
+	client := &Client{
+		execCommand: func(name string, args ...string) *exec.Cmd {
+			calls = append(calls, args)
+			if args[0] == "submodule" {
+				return exec.Command("printf", "%s", submoduleStatus)
+			}
+			return exec.Command("printf", "%s", porcelain)
+		},
+	}
+
+	got, err := client.ListSubmodules()
+	if err != nil {
+		t.Fatalf("ListSubmodules() error = %v", err)
+	}
+	want := []Submodule{
+		{Path: "other", Commit: "219e3fd480141f673b72eee25ababf5de4192a75", Describe: "heads/main", Modified: true, Untracked: true},
+		{Path: "libs/lib", Commit: "5e076753631fa568c3d073786c489df5550a0911", Recorded: "219e3fd480141f673b72eee25ababf5de4192a75", Describe: "5e07675", OutOfDate: true},
+		{Path: "docs", Commit: "8d99f2170cddc496187fb10bdf4c3bab5029e40b", Uninitialized: true},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ListSubmodules() =\n%+v\nwant\n%+v", got, want)
+	}
+	for i, s := range got {
+		if s.Dirty() != (i < 2) {
+			t.Errorf("%s: Dirty() = %v", s.Path, s.Dirty())
+		}
+	}
+	wantStatus := []string{"-c", "status.relativePaths=true", "status", "--porcelain=v2", "--", "other", "libs/lib"}
+	if len(calls) != 2 || !slices.Equal(calls[1], wantStatus) {
+		t.Errorf("calls = %v, want the status of the initialized submodules", calls)
+	}
+}
+
+func TestClient_ListSubmodules_None(t *testing.T) {
+	calls := 0
+	client := &Client{
+		execCommand: func(string, ...string) *exec.Cmd {
+			calls++
+			return exec.Command("true")
+		},
+	}
+
+	got, err := client.ListSubmodules()
+	if err != nil || len(got) != 0 {
+		t.Errorf("ListSubmodules() = %v, %v; want none", got, err)
+	}
+	if calls != 1 {
+		t.Errorf("expected git status to be skipped without submodules, got %d calls", calls)
+	}
+}
+
+func TestClient_SubmoduleOps(t *testing.T) {
+	tests := []struct {
+		name     string
+		run      func(c *Client) error
+		wantArgs []string
+	}{
+		{"init", func(c *Client) error { return c.SubmoduleInit(nil) }, []string{"git", "submodule", "init"}},
+		{"init_paths", func(c *Client) error { return c.SubmoduleInit([]string{"docs"}) }, []string{"git", "submodule", "init", "--", "docs"}},
+		{"update", func(c *Client) error { return c.SubmoduleUpdate(nil) }, []string{"git", "submodule", "update", "--recursive"}},
+		{"update_paths", func(c *Client) error { return c.SubmoduleUpdate([]string{"a", "b"}) }, []string{"git", "submodule", "update", "--recursive", "--", "a", "b"}},
+		{"sync", func(c *Client) error { return c.SubmoduleSync(nil) }, []string{"git", "submodule", "sync", "--recursive"}},
+		{"foreach", func(c *Client) error { return c.SubmoduleForeach([]string{"git", "pull"}) }, []string{"git", "submodule", "foreach", "--recursive", "git", "pull"}},
+		{"add", func(c *Client) error { return c.SubmoduleAdd("../lib", "") }, []string{"git", "submodule", "add", "--", "../lib"}},
+		{"add_path", func(c *Client) error { return c.SubmoduleAdd("https://example.com/lib.git", "libs/lib") }, []string{"git", "submodule", "add", "--", "https://example.com/lib.git", "libs/lib"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var gotArgs []string
+			client := &Client{
+				execCommand: func(name string, args ...string) *exec.Cmd {
+					gotArgs = append([]string{name}, args...)
+					return exec.Command("true")
+				},
+			}
+
+			if err := tt.run(client); err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+			if !slices.Equal(gotArgs, tt.wantArgs) {
+				t.Errorf("gotArgs = %v, want %v", gotArgs, tt.wantArgs)
+			}
+		})
+	}
+}
+
+func TestClient_SubmoduleUpdate_Error(t *testing.T) {
+	client := &Client{
+		execCommand: func(string, ...string) *exec.Cmd {
+			return exec.Command("false")
+		},
+	}
+
+	err := client.SubmoduleUpdate(nil)
+	if err == nil || !strings.Contains(err.Error(), "git submodule update --recursive") {
+		t.Errorf("expected an error naming the command, got %v", err)
+	}
+}
@@ -8,7 +8,7 @@ import (
 // TagOps provides operations used by the tag command.
 type TagOps interface {
 	// list/show
-	TagList(pattern []string) error
+	TagList(pattern []string) (string, error)
 	TagShow(name string) error
 	// create/delete
 	TagCreate(name string, commit string) error
@@ -23,20 +23,20 @@ type TagOps interface {
 	GetTagCommit(name string) (string, error)
 }
 
-// TagList lists tags, optionally filtered by pattern.
-func (c *Client) TagList(pattern []string) error {
+// TagList returns the tags, optionally filtered by pattern, newest first.
+func (c *Client) TagList(pattern []string) (string, error) {
 	var cmd = c.execCommand("git", "tag", "--sort=-version:refname")
 	if len(pattern) > 0 {
 		args := append([]string{"tag", "--sort=-version:refname", "-l"}, pattern...)
 		cmd = c.execCommand("git", args...)
 	}
 
-	cmd.Stdout = os.Stdout
 	cmd.Stderr = os.Stderr
-	if err := cmd.Run(); err != nil {
-		return NewOpError("tag list", "git tag --sort=-version:refname", err)
+	out, err := cmd.Output()
+	if err != nil {
+		return "", NewOpError("tag list", "git tag --sort=-version:refname", err)
 	}
-	return nil
+	return string(out), nil
 }
 
 // TagCreate creates a lightweight tag.
@@ -39,7 +39,7 @@ func TestClient_TagList(t *testing.T) {
 				},
 			}
 
-			err := client.TagList(tt.pattern)
+			_, err := client.TagList(tt.pattern)
 			if err != nil {
 				t.Errorf("TagList() error = %v", err)
 			}
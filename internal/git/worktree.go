@@ -0,0 +1,134 @@
+package git
+
+import (
+	"os"
+	"strings"
+)
+
+// WorktreeOps provides operations used by the worktree command.
+type WorktreeOps interface {
+	ListWorktrees() ([]Worktree, error)
+	AddWorktree(path, branch string) error
+	AddWorktreeNewBranch(path, branch, startPoint string) error
+	RemoveWorktree(path string, force bool) error
+	PruneWorktrees() error
+	LockWorktree(path, reason string) error
+	UnlockWorktree(path string) error
+}
+
+// Worktree is a working tree attached to the repository, as reported by
+// git worktree list --porcelain.
+type Worktree struct {
+	Path string
+	Head string
+	// Branch is the short name of the checked out branch; it is empty for
+	// a detached HEAD or a bare repository.
+	Branch   string
+	Bare     bool
+	Detached bool
+	Locked   bool
+	// LockReason is the reason given when the worktree was locked, if any.
+	LockReason string
+	Prunable   bool
+}
+
+// ListWorktrees lists the worktrees of the repository. The main worktree
+// comes first.
+func (c *Client) ListWorktrees() ([]Worktree, error) {
+	cmd := c.execCommand("git", "worktree", "list", "--porcelain")
+	out, err := cmd.Output()
+	if err != nil {
+		return nil, NewOpError("list worktrees", "git worktree list --porcelain", err)
+	}
+	return parseWorktrees(string(out)), nil
+}
+
+func parseWorktrees(out string) []Worktree {
+	var worktrees []Worktree
+	var current *Worktree
+	for _, line := range strings.Split(out, "\n") {
+		key, value, _ := strings.Cut(line, " ")
+		if key == "worktree" {
+			worktrees = append(worktrees, Worktree{Path: value})
+			current = &worktrees[len(worktrees)-1]
+			continue
+		}
+		if current == nil {
+			continue
+		}
+		switch key {
+		case "HEAD":
+			current.Head = value
+		case "branch":
+			current.Branch = strings.TrimPrefix(value, "refs/heads/")
+		case "bare":
+			current.Bare = true
+		case "detached":
+			current.Detached = true
+		case "locked":
+			current.Locked = true
+			current.LockReason = value
+		case "prunable":
+			current.Prunable = true
+		}
+	}
+	return worktrees
+}
+
+// AddWorktree creates a worktree at path with branch checked out.
+func (c *Client) AddWorktree(path, branch string) error {
+	return c.runWorktree("add worktree", "add", path, branch)
+}
+
+// AddWorktreeNewBranch creates a worktree at path on a new branch started
+// from startPoint, or from HEAD when startPoint is empty. A remote-tracking
+// start point makes the new branch track it.
+func (c *Client) AddWorktreeNewBranch(path, branch, startPoint string) error {
+	args := []string{"add", "-b", branch, path}
+	if startPoint != "" {
+		args = append(args, startPoint)
+	}
+	return c.runWorktree("add worktree", args...)
+}
+
+// RemoveWorktree removes the worktree at path. force also removes a
+// worktree with uncommitted changes.
+func (c *Client) RemoveWorktree(path string, force bool) error {
+	args := []string{"remove"}
+	if force {
+		args = append(args, "--force")
+	}
+	return c.runWorktree("remove worktree", append(args, path)...)
+}
+
+// PruneWorktrees removes the administrative files of worktrees whose
+// directory no longer exists.
+func (c *Client) PruneWorktrees() error {
+	return c.runWorktree("prune worktrees", "prune", "--verbose")
+}
+
+// LockWorktree keeps the worktree at path from being pruned, for example
+// while it lives on a removable drive.
+func (c *Client) LockWorktree(path, reason string) error {
+	args := []string{"lock"}
+	if reason != "" {
+		args = append(args, "--reason", reason)
+	}
+	return c.runWorktree("lock worktree", append(args, path)...)
+}
+
+// UnlockWorktree unlocks the worktree at path.
+func (c *Client) UnlockWorktree(path string) error {
+	return c.runWorktree("unlock worktree", "unlock", path)
+}
+
+func (c *Client) runWorktree(op string, args ...string) error {
+	cmdArgs := append([]string{"worktree"}, args...)
+	cmd := c.execCommand("git", cmdArgs...)
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	if err := cmd.Run(); err != nil {
+		return NewOpError(op, "git "+strings.Join(cmdArgs, " "), err)
+	}
+	return nil
+}
@@ -0,0 +1,115 @@
+package git
+
+import (
+	"os/exec"
+	"reflect"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestClient_ListWorktrees(t *testing.T) {
+	out := "worktree /repo\nHEAD 1111111\nbranch refs/heads/main\n\n" +
+		"worktree /repo-feature\nHEAD 2222222\nbranch refs/heads/feature/x\nlocked on usb drive\n\n" +
+		"worktree /tmp/detached\nHEAD 3333333\ndetached\nprunable gitdir file points to non-existent location\n\n"
+	var gotArgs []string
+	client := &Client{
+		execCommand: func(name string, args ...string) *exec.Cmd {
+			gotArgs = append([]string{name}, args...)
+			return exec.Command("printf", "%s", out)
+		},
+	}
+
+	got, err := client.ListWorktrees()
+	if err != nil {
+		t.Fatalf("ListWorktrees() error = %v", err)
+	}
+	wantArgs := []string{"git", "worktree", "list", "--porcelain"}
+	if !slices.Equal(gotArgs, wantArgs) {
+		t.Errorf("gotArgs = %v, want %v", gotArgs, wantArgs)
+	}
+	want := []Worktree{
+		{Path: "/repo", Head: "1111111", Branch: "main"},
+		{Path: "/repo-feature", Head: "2222222", Branch: "feature/x", Locked: true, LockReason: "on usb drive"},
+		{Path: "/tmp/detached", Head: "3333333", Detached: true, Prunable: true},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ListWorktrees() = %+v, want %+v", got, want)
+	}
+}
+
+func TestParseWorktrees_BareAndLockedWithoutReason(t *testing.T) {
+	got := parseWorktrees("worktree /repo.git\nbare\n\nworktree /wt\nHEAD abc\nbranch refs/heads/dev\nlocked\n")
+	want := []Worktree{
+		{Path: "/repo.git", Bare: true},
+		{Path: "/wt", Head: "abc", Branch: "dev", Locked: true},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("parseWorktrees() = %+v, want %+v", got, want)
+	}
+}
+
+func TestClient_WorktreeOps(t *testing.T) {
+	tests := []struct {
+		name     string
+		run      func(c *Client) error
+		wantArgs []string
+	}{
+		{"add", func(c *Client) error { return c.AddWorktree("../wt", "feature") },
+			[]string{"git", "worktree", "add", "../wt", "feature"}},
+		{"add_new_branch", func(c *Client) error { return c.AddWorktreeNewBranch("../wt", "feature", "") },
+			[]string{"git", "worktree", "add", "-b", "feature", "../wt"}},
+		{"add_new_branch_from_remote", func(c *Client) error { return c.AddWorktreeNewBranch("../wt", "feature", "origin/feature") },
+			[]string{"git", "worktree", "add", "-b", "feature", "../wt", "origin/feature"}},
+		{"remove", func(c *Client) error { return c.RemoveWorktree("../wt", false) },
+			[]string{"git", "worktree", "remove", "../wt"}},
+		{"remove_force", func(c *Client) error { return c.RemoveWorktree("../wt", true) },
+			[]string{"git", "worktree", "remove", "--force", "../wt"}},
+		{"prune", func(c *Client) error { return c.PruneWorktrees() },
+			[]string{"git", "worktree", "prune", "--verbose"}},
+		{"lock", func(c *Client) error { return c.LockWorktree("../wt", "") },
+			[]string{"git", "worktree", "lock", "../wt"}},
+		{"lock_reason", func(c *Client) error { return c.LockWorktree("../wt", "on usb drive") },
+			[]string{"git", "worktree", "lock", "--reason", "on usb drive", "../wt"}},
+		{"unlock", func(c *Client) error { return c.UnlockWorktree("../wt") },
+			[]string{"git", "worktree", "unlock", "../wt"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var gotArgs []string
+			client := &Client{
+				execCommand: func(name string, args ...string) *exec.Cmd {
+					gotArgs = append([]string{name}, args...)
+					return exec.Command("true")
+				},
+			}
+
+			if err := tt.run(client); err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+			if !slices.Equal(gotArgs, tt.wantArgs) {
+				t.Errorf("gotArgs = %v, want %v", gotArgs, tt.wantArgs)
+			}
+		})
+	}
+}
+
+func TestClient_Worktree_Error(t *testing.T) {
+	client := &Client{
+		execCommand: func(string, ...string) *exec.Cmd {
+			return exec.Command("false")
+		},
+	}
+
+	if _, err := client.ListWorktrees(); err == nil {
+		t.Error("expected ListWorktrees to return an error")
+	}
+	err := client.RemoveWorktree("../wt", false)
+	if err == nil {
+		t.Fatal("expected an error")
+	}
+	if !strings.Contains(err.Error(), "git worktree remove ../wt") {
+		t.Errorf("error should name the command, got %v", err)
+	}
+}
@@ -0,0 +1,172 @@
+package interactive
+
+import "slices"
+
+const (
+	// pinnedCategory is the browser tab listing pinned commands.
+	pinnedCategory = "Pinned"
+	// otherCategory collects built-in commands without a category.
+	otherCategory = "Other"
+)
+
+// PinStore persists the commands pinned in the category browser. The router
+// passed to NewUI may implement it; without one, pins last for the session.
+type PinStore interface {
+	SavePinnedCommands(commands []string) error
+}
+
+// browseCategory is one tab of the category browser.
+type browseCategory struct {
+	name     string
+	commands []CommandInfo
+}
+
+// categoryBrowser lets users explore commands grouped by category instead of
+// searching by name.
+type categoryBrowser struct {
+	categories []browseCategory
+	catIdx     int
+	selected   int
+	offset     int
+	notice     string
+}
+
+// browseCategories groups commands into tabs: pinned commands first, then
+// built-in commands by category in list order, then aliases and workflows.
+func browseCategories(commands []CommandInfo, pinned []string) []browseCategory {
+	byCommand := make(map[string]CommandInfo, len(commands))
+	var order []string
+	groups := make(map[string][]CommandInfo)
+	var aliases, workflows []CommandInfo
+	for _, cmd := range commands {
+		byCommand[cmd.Command] = cmd
+		switch cmd.Kind {
+		case KindAlias:
+			aliases = append(aliases, cmd)
+		case KindWorkflow:
+			workflows = append(workflows, cmd)
+		default:
+			name := cmd.Category
+			if name == "" {
+				name = otherCategory
+			}
+			if _, ok := groups[name]; !ok {
+				order = append(order, name)
+			}
+			groups[name] = append(groups[name], cmd)
+		}
+	}
+
+	pinnedCmds := make([]CommandInfo, 0, len(pinned))
+	for _, command := range pinned {
+		if cmd, ok := byCommand[command]; ok {
+			pinnedCmds = append(pinnedCmds, cmd)
+		}
+	}
+	categories := []browseCategory{{name: pinnedCategory, commands: pinnedCmds}}
+	for _, name := range order {
+		categories = append(categories, browseCategory{name: name, commands: groups[name]})
+	}
+	if len(aliases) > 0 {
+		categories = append(categories, browseCategory{name: "Aliases", commands: aliases})
+	}
+	if len(workflows) > 0 {
+		categories = append(categories, browseCategory{name: "Workflows", commands: workflows})
+	}
+	return categories
+}
+
+func (b *categoryBrowser) current() browseCategory {
+	return b.categories[b.catIdx]
+}
+
+// selectedCommand returns the highlighted command, if the tab has any.
+func (b *categoryBrowser) selectedCommand() (CommandInfo, bool) {
+	cmds := b.current().commands
+	if b.selected < 0 || b.selected >= len(cmds) {
+		return CommandInfo{}, false
+	}
+	return cmds[b.selected], true
+}
+
+// switchCategory moves to the next (1) or previous (-1) tab, wrapping around.
+func (b *categoryBrowser) switchCategory(delta int) {
+	n := len(b.categories)
+	b.catIdx = (b.catIdx + delta + n) % n
+	b.selected, b.offset = 0, 0
+}
+
+func (b *categoryBrowser) move(delta int) {
+	n := len(b.current().commands)
+	if n == 0 {
+		return
+	}
+	b.selected = min(max(b.selected+delta, 0), n-1)
+}
+
+// reveal adjusts the scroll offset so the selection is within rows lines.
+func (b *categoryBrowser) reveal(rows int) {
+	rows = max(rows, 1)
+	if b.selected < b.offset {
+		b.offset = b.selected
+	}
+	if b.selected >= b.offset+rows {
+		b.offset = b.selected - rows + 1
+	}
+}
+
+// isPinned reports whether command is pinned.
+func (ui *UI) isPinned(command string) bool {
+	return slices.Contains(ui.pinned, command)
+}
+
+// toggleCategoryView opens the category browser, or closes it when open.
+func (ui *UI) toggleCategoryView() {
+	if ui == nil || ui.state == nil {
+		return
+	}
+	if ui.state.IsBrowseMode() {
+		ui.closeBrowser()
+		return
+	}
+	ui.state.browser = &categoryBrowser{categories: browseCategories(ui.state.commands, ui.pinned)}
+	// Start on the first category when nothing is pinned yet.
+	if len(ui.state.browser.categories[0].commands) == 0 && len(ui.state.browser.categories) > 1 {
+		ui.state.browser.catIdx = 1
+	}
+	ui.state.SetMode(ModeBrowse)
+}
+
+// closeBrowser leaves the category browser and returns to search.
+func (ui *UI) closeBrowser() {
+	ui.state.browser = nil
+	ui.enterSearchMode()
+}
+
+// togglePin pins or unpins the highlighted command and saves the pins when
+// the router can store them.
+func (ui *UI) togglePin() {
+	b := ui.state.browser
+	cmd, ok := b.selectedCommand()
+	if !ok {
+		return
+	}
+	if i := slices.Index(ui.pinned, cmd.Command); i >= 0 {
+		ui.pinned = slices.Delete(slices.Clone(ui.pinned), i, i+1)
+		b.notice = "Unpinned " + cmd.Command
+	} else {
+		ui.pinned = append(slices.Clone(ui.pinned), cmd.Command)
+		b.notice = "Pinned " + cmd.Command
+	}
+	if ui.pinStore != nil {
+		if err := ui.pinStore.SavePinnedCommands(ui.pinned); err != nil {
+			b.notice = "Failed to save pinned commands: " + err.Error()
+		}
+	}
+
+	name := b.current().name
+	b.categories = browseCategories(ui.state.commands, ui.pinned)
+	if name == pinnedCategory {
+		b.move(0)
+	}
+}
@@ -0,0 +1,143 @@
+package interactive
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	kb "github.com/bmf-san/ggc/v8/internal/keybindings"
+)
+
+type fakePinStore struct {
+	saved []string
+	err   error
+}
+
+func (s *fakePinStore) SavePinnedCommands(commands []string) error {
+	s.saved = commands
+	return s.err
+}
+
+func newBrowseTestUI(pinned ...string) *UI {
+	ui, _ := newHelpTestUI(kb.DefaultKeyBindingMap())
+	ui.state.commands = []CommandInfo{
+		{Command: "add <file>", Description: "Add file", Category: "Basics"},
+		{Command: "status", Description: "Show status", Category: "Basics"},
+		{Command: "push current", Description: "Push branch", Category: "Remote"},
+		{Command: "debug", Description: "Debug"},
+		{Command: "st", Description: "status short", Kind: KindAlias},
+		{Command: "sync", Description: "fetch → pull", Kind: KindWorkflow},
+	}
+	ui.pinned = pinned
+	return ui
+}
+
+func categoryNames(categories []browseCategory) []string {
+	names := make([]string, len(categories))
+	for i, c := range categories {
+		names[i] = c.name
+	}
+	return names
+}
+
+func TestBrowseCategories(t *testing.T) {
+	ui := newBrowseTestUI("sync", "unknown", "status")
+	categories := browseCategories(ui.state.commands, ui.pinned)
+
+	want := []string{pinnedCategory, "Basics", "Remote", otherCategory, "Aliases", "Workflows"}
+	if got := categoryNames(categories); !reflect.DeepEqual(got, want) {
+		t.Fatalf("categories = %v, want %v", got, want)
+	}
+	var pinned []string
+	for _, cmd := range categories[0].commands {
+		pinned = append(pinned, cmd.Command)
+	}
+	if !reflect.DeepEqual(pinned, []string{"sync", "status"}) {
+		t.Errorf("pinned commands = %v, want pin order with unknown pins skipped", pinned)
+	}
+}
+
+func TestCategoryBrowser_ToggleAndNavigate(t *testing.T) {
+	ui := newBrowseTestUI()
+	ui.handler.HandleKey(2, false, nil, nil) // Ctrl+B
+	if !ui.state.IsBrowseMode() {
+		t.Fatal("Ctrl+B should open the category browser")
+	}
+	if got := ui.state.browser.current().name; got != "Basics" {
+		t.Fatalf("without pins the browser should start on the first category, got %q", got)
+	}
+
+	typeKeys(ui, "j")
+	if cmd, _ := ui.state.browser.selectedCommand(); cmd.Command != "status" {
+		t.Fatalf("j should select the next command, got %q", cmd.Command)
+	}
+	typeKeys(ui, "l")
+	if got := ui.state.browser.current().name; got != "Remote" {
+		t.Fatalf("l should switch to the next category, got %q", got)
+	}
+	typeKeys(ui, "hhh")
+	if got := ui.state.browser.current().name; got != "Workflows" {
+		t.Fatalf("h should wrap around to the last category, got %q", got)
+	}
+
+	ui.handler.HandleKey(2, false, nil, nil)
+	if ui.state.IsBrowseMode() || ui.state.browser != nil {
+		t.Fatal("Ctrl+B should close the category browser")
+	}
+}
+
+func TestCategoryBrowser_EnterRunsCommand(t *testing.T) {
+	ui := newBrowseTestUI()
+	ui.toggleCategoryView()
+	typeKeys(ui, "lj") // Remote, then past the last command
+
+	cont, result := ui.handler.HandleKey(13, false, nil, nil)
+	if cont || !reflect.DeepEqual(result, []string{"ggc", "push", "current"}) {
+		t.Fatalf("Enter = %v, %v; want false, [ggc push current]", cont, result)
+	}
+}
+
+func TestCategoryBrowser_TogglePinSaves(t *testing.T) {
+	ui := newBrowseTestUI()
+	store := &fakePinStore{}
+	ui.pinStore = store
+	ui.toggleCategoryView()
+
+	typeKeys(ui, "jp")
+	if !reflect.DeepEqual(store.saved, []string{"status"}) || !ui.isPinned("status") {
+		t.Fatalf("p should pin and save the command, saved %v", store.saved)
+	}
+	if got := ui.state.browser.categories[0].commands; len(got) != 1 || got[0].Command != "status" {
+		t.Fatalf("pinned tab should list the new pin, got %+v", got)
+	}
+
+	ui.state.browser.switchCategory(-1)
+	store.err = errors.New("read-only")
+	typeKeys(ui, "p")
+	if len(store.saved) != 0 || ui.isPinned("status") {
+		t.Fatalf("p in the pinned tab should unpin, saved %v", store.saved)
+	}
+	if !strings.Contains(ui.state.browser.notice, "read-only") {
+		t.Errorf("a failed save should be reported, got notice %q", ui.state.browser.notice)
+	}
+	if _, ok := ui.state.browser.selectedCommand(); ok {
+		t.Error("selection should be cleared once the pinned tab is empty")
+	}
+}
+
+func TestCategoryBrowser_Render(t *testing.T) {
+	ui := newBrowseTestUI("status")
+	ui.toggleCategoryView()
+	ui.state.browser.switchCategory(1)
+
+	var buf strings.Builder
+	ui.renderer.writer = &buf
+	ui.renderer.Render(ui, ui.state)
+	out := buf.String()
+	for _, want := range []string{"Browse Commands", "Basics", "Remote", "★ Show status", "p pin"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("rendered browser missing %q:\n%s", want, out)
+		}
+	}
+}
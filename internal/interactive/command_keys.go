@@ -0,0 +1,147 @@
+package interactive
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/bmf-san/ggc/v8/internal/config"
+	kb "github.com/bmf-san/ggc/v8/internal/keybindings"
+)
+
+// wordMotionKeys move the search cursor by word. They are not part of the
+// keymap, but command keys would shadow them all the same.
+var wordMotionKeys = []kb.ActionBinding{
+	{Action: "move_word_left", Keys: []kb.KeyStroke{kb.NewAltKeyStroke('b', "")}},
+	{Action: "move_word_right", Keys: []kb.KeyStroke{kb.NewAltKeyStroke('f', "")}},
+}
+
+// commandKey binds a key stroke to a command that runs as soon as the key is
+// pressed, from any mode.
+type commandKey struct {
+	stroke kb.KeyStroke
+	target CommandInfo
+}
+
+// resolveCommandKeys turns the interactive.commands config into command keys.
+// Each command line must name an entry of commands: a built-in command
+// (placeholders may be filled in), an alias or a named workflow, and its key
+// must not be bound to an action of keyMaps. Bindings that cannot be
+// resolved are reported as errors and skipped.
+func resolveCommandKeys(bindings map[string]string, commands []CommandInfo, keyMaps *kb.ContextualKeyBindingMap) ([]commandKey, []error) {
+	keys := make([]string, 0, len(bindings))
+	for key := range bindings {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	var resolved []commandKey
+	var errs []error
+	for _, key := range keys {
+		stroke, err := kb.ParseKeyStroke(key)
+		if err == nil {
+			err = config.ValidateCommandKey(key)
+		}
+		if err != nil {
+			errs = append(errs, fmt.Errorf("interactive.commands.%s: %w", key, err))
+			continue
+		}
+		if action, ok := boundKeyAction(stroke, keyMaps); ok {
+			errs = append(errs, fmt.Errorf("interactive.commands.%s: the key is already bound to %s", key, action))
+			continue
+		}
+		target, ok := resolveCommandLine(bindings[key], commands)
+		if !ok {
+			errs = append(errs, fmt.Errorf("interactive.commands.%s: %q is not a known command, alias or workflow", key, bindings[key]))
+			continue
+		}
+		resolved = append(resolved, commandKey{stroke: stroke, target: target})
+	}
+	return resolved, errs
+}
+
+// boundKeyAction returns the action stroke triggers in any context of
+// keyMaps, including the word motions handled outside the keymap.
+func boundKeyAction(stroke kb.KeyStroke, keyMaps *kb.ContextualKeyBindingMap) (string, bool) {
+	bindings := slices.Clone(wordMotionKeys)
+	if keyMaps != nil {
+		for _, ctx := range kb.GetAllContexts() {
+			if km, ok := keyMaps.GetContext(ctx); ok && km != nil {
+				bindings = append(bindings, km.Bindings()...)
+			}
+		}
+	}
+	for _, binding := range bindings {
+		for _, ks := range binding.Keys {
+			if ks.Equals(stroke) {
+				return binding.Action, true
+			}
+		}
+	}
+	return "", false
+}
+
+// resolveCommandLine finds what line refers to. A bare alias or workflow
+// name resolves to that entry so its arguments are prompted for; anything
+// else must match a command, with placeholder words accepting any value.
+func resolveCommandLine(line string, commands []CommandInfo) (CommandInfo, bool) {
+	words := strings.Fields(line)
+	if len(words) == 0 {
+		return CommandInfo{}, false
+	}
+	for _, cmd := range commands {
+		name := strings.Fields(cmd.Command)
+		if len(name) == 0 {
+			continue
+		}
+		switch cmd.Kind {
+		case KindAlias, KindWorkflow:
+			if name[0] != words[0] {
+				continue
+			}
+			if len(words) == 1 {
+				return cmd, true
+			}
+			// Alias arguments given in the binding are passed through as is.
+			if cmd.Kind == KindAlias {
+				return CommandInfo{Command: strings.Join(words, " "), Description: cmd.Description}, true
+			}
+		default:
+			if matchCommandTemplate(words, name) {
+				return CommandInfo{Command: strings.Join(words, " "), Description: cmd.Description}, true
+			}
+		}
+	}
+	return CommandInfo{}, false
+}
+
+// matchCommandTemplate reports whether words fit template, where <...> and
+// [...] words accept any value and a trailing placeholder absorbs the rest.
+func matchCommandTemplate(words, template []string) bool {
+	if len(words) < len(template) {
+		return false
+	}
+	for i, t := range template {
+		if strings.HasPrefix(t, "<") || strings.HasPrefix(t, "[") {
+			if i == len(template)-1 {
+				return true
+			}
+			continue
+		}
+		if words[i] != t {
+			return false
+		}
+	}
+	return len(words) == len(template)
+}
+
+// commandForKey returns the command bound to stroke, if any.
+func (ui *UI) commandForKey(stroke kb.KeyStroke) (CommandInfo, bool) {
+	for _, key := range ui.commandKeys {
+		if key.stroke.Equals(stroke) {
+			return key.target, true
+		}
+	}
+	return CommandInfo{}, false
+}
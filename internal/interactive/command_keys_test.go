@@ -0,0 +1,164 @@
+package interactive
+
+import (
+	"bufio"
+	"reflect"
+	"strings"
+	"testing"
+
+	kb "github.com/bmf-san/ggc/v8/internal/keybindings"
+)
+
+var commandKeyTestCommands = []CommandInfo{
+	{Command: "status short", Description: "Show concise status"},
+	{Command: "branch checkout <branch>", Description: "Switch branch"},
+	{Command: "sync <arg1>", Description: "pull current → push current", Kind: KindAlias},
+	{Command: "release", Description: "tag create → push", Kind: KindWorkflow},
+}
+
+// defaultContextualMap binds the default keymap in every context.
+func defaultContextualMap() *kb.ContextualKeyBindingMap {
+	keyMaps := kb.NewContextualKeyBindingMap(kb.ProfileDefault, "", "")
+	for _, ctx := range kb.GetAllContexts() {
+		keyMaps.SetContext(ctx, kb.DefaultKeyBindingMap())
+	}
+	return keyMaps
+}
+
+func TestResolveCommandKeys(t *testing.T) {
+	keys, errs := resolveCommandKeys(map[string]string{
+		"ctrl+s": "status short",
+		"alt+p":  "sync",
+		"alt+m":  "sync main",
+		"ctrl+f": "branch checkout main",
+		"ctrl+r": "release",
+		"ctrl+m": "status short",
+		"ctrl+x": "bogus",
+		"ctrl+y": "status",
+	}, commandKeyTestCommands, defaultContextualMap())
+
+	got := make(map[string]CommandInfo)
+	for _, key := range keys {
+		got[kb.FormatKeyStrokeForDisplay(key.stroke)] = key.target
+	}
+	want := map[string]CommandInfo{
+		"Ctrl+s": {Command: "status short", Description: "Show concise status"},
+		"Alt+p":  commandKeyTestCommands[2],
+		"Alt+m":  {Command: "sync main", Description: "pull current → push current"},
+		"Ctrl+f": {Command: "branch checkout main", Description: "Switch branch"},
+		"Ctrl+r": commandKeyTestCommands[3],
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("resolved keys = %+v, want %+v", got, want)
+	}
+
+	if len(errs) != 3 {
+		t.Fatalf("expected 3 errors, got %v", errs)
+	}
+	joined := ""
+	for _, err := range errs {
+		joined += err.Error() + "\n"
+	}
+	for _, want := range []string{"interactive.commands.ctrl+m", `"bogus" is not a known`, `"status" is not a known`} {
+		if !strings.Contains(joined, want) {
+			t.Errorf("errors %q do not mention %q", joined, want)
+		}
+	}
+}
+
+func TestResolveCommandKeys_RejectsKeysBoundToActions(t *testing.T) {
+	keyMaps := defaultContextualMap()
+	results := kb.DefaultKeyBindingMap()
+	results.MoveDown = append(results.MoveDown, kb.NewCtrlKeyStroke('j'), kb.NewCtrlKeyStroke('l'))
+	keyMaps.SetContext(kb.ContextResults, results)
+
+	keys, errs := resolveCommandKeys(map[string]string{
+		"ctrl+n": "status short",
+		"ctrl+a": "status short",
+		"ctrl+l": "status short",
+		"alt+b":  "status short",
+		"alt+f":  "status short",
+		"alt+s":  "status short",
+	}, commandKeyTestCommands, keyMaps)
+
+	if len(keys) != 1 || kb.FormatKeyStrokeForDisplay(keys[0].stroke) != "Alt+s" {
+		t.Errorf("expected only alt+s to resolve, got %+v", keys)
+	}
+	joined := ""
+	for _, err := range errs {
+		joined += err.Error() + "\n"
+	}
+	for _, want := range []string{
+		"interactive.commands.ctrl+n: the key is already bound to move_down",
+		"interactive.commands.ctrl+a: the key is already bound to move_to_beginning",
+		"interactive.commands.ctrl+l: the key is already bound to move_down",
+		"interactive.commands.alt+b: the key is already bound to move_word_left",
+		"interactive.commands.alt+f: the key is already bound to move_word_right",
+	} {
+		if !strings.Contains(joined, want) {
+			t.Errorf("errors %q do not mention %q", joined, want)
+		}
+	}
+}
+
+func TestMatchCommandTemplate(t *testing.T) {
+	tests := []struct {
+		words, template string
+		want            bool
+	}{
+		{"status short", "status short", true},
+		{"status", "status short", false},
+		{"status short extra", "status short", false},
+		{"commit -m fix the bug", "commit -m <message>", true},
+		{"branch checkout", "branch checkout <branch>", false},
+	}
+	for _, tt := range tests {
+		if got := matchCommandTemplate(strings.Fields(tt.words), strings.Fields(tt.template)); got != tt.want {
+			t.Errorf("matchCommandTemplate(%q, %q) = %v, want %v", tt.words, tt.template, got, tt.want)
+		}
+	}
+}
+
+func newCommandKeyTestUI(t *testing.T, bindings map[string]string) *UI {
+	t.Helper()
+	ui, _ := newHelpTestUI(kb.DefaultKeyBindingMap())
+	keys, errs := resolveCommandKeys(bindings, commandKeyTestCommands, defaultContextualMap())
+	if len(errs) != 0 {
+		t.Fatalf("unexpected errors: %v", errs)
+	}
+	ui.commandKeys = keys
+	return ui
+}
+
+func TestHandleKey_CtrlCommandKeyRunsCommand(t *testing.T) {
+	ui := newCommandKeyTestUI(t, map[string]string{"ctrl+s": "status short"})
+	ui.state.SetMode(ModeWorkflow)
+
+	cont, result := ui.handler.HandleKey(19, false, nil, nil) // Ctrl+S
+	if cont || !reflect.DeepEqual(result, []string{"ggc", "status", "short"}) {
+		t.Fatalf("HandleKey(Ctrl+S) = %v, %v; want false, [ggc status short]", cont, result)
+	}
+}
+
+func TestHandleKey_AltCommandKeyRunsAlias(t *testing.T) {
+	ui := newCommandKeyTestUI(t, map[string]string{"alt+m": "sync main"})
+
+	reader := bufio.NewReader(strings.NewReader("m"))
+	cont, result := ui.handler.HandleKey(27, false, nil, reader)
+	if cont || !reflect.DeepEqual(result, []string{"ggc", "sync", "main"}) {
+		t.Fatalf("HandleKey(Alt+M) = %v, %v; want false, [ggc sync main]", cont, result)
+	}
+}
+
+func TestHelpOverlay_ListsCommandKeys(t *testing.T) {
+	ui := newCommandKeyTestUI(t, map[string]string{"ctrl+s": "status short"})
+	var entries []helpEntry
+	for _, e := range ui.helpEntries() {
+		if e.category == "Commands" {
+			entries = append(entries, e)
+		}
+	}
+	if len(entries) != 1 || entries[0].keys != "Ctrl+s" || entries[0].desc != "Run status short" {
+		t.Fatalf("expected the command key in the help overlay, got %+v", entries)
+	}
+}
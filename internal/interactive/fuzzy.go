@@ -1,33 +1,54 @@
 // Package interactive houses interactive UI types and helpers shared across the application.
 package interactive
 
-import "unicode"
+import (
+	"math"
+	"unicode"
+)
+
+// Scoring constants for fuzzy matching, modeled after fzf. Every matched rune
+// earns scoreMatch, gaps between matched runes are penalized, and runes that
+// start a word or continue a consecutive run earn a bonus.
+const (
+	scoreMatch        = 16
+	scoreGapStart     = -3
+	scoreGapExtension = -1
+
+	bonusBoundaryWhite = 10
+	bonusBoundary      = 8
+	bonusConsecutive   = 4
+
+	bonusFirstCharMultiplier = 2
+)
 
 // fuzzyMatch performs fuzzy matching between text and pattern
 // Returns true if all characters in pattern appear in text in order (but not necessarily consecutive)
 func fuzzyMatch(text, pattern string) bool {
-	matched, _ := fuzzyMatchScore(text, pattern)
+	matched, _, _ := fuzzyMatchScore(text, pattern)
 	return matched
 }
 
-// fuzzyMatchScore returns whether the pattern matches the text and a relevance score for sorting results.
-// Lower scores indicate a tighter, earlier match.
-func fuzzyMatchScore(text, pattern string) (bool, matchScore) {
+// fuzzyMatchScore returns whether the pattern matches the text, a relevance score for
+// sorting results, and the rune indices in text that matched the pattern.
+// Scores compare with less; a lesser score indicates a better match.
+func fuzzyMatchScore(text, pattern string) (bool, matchScore, []int) {
 	if pattern == "" {
-		return true, matchScore{length: len([]rune(text))}
+		return true, matchScore{length: len([]rune(text))}, nil
 	}
 
 	textRunes := []rune(text)
 	patternRunes := []rune(pattern)
 
-	matched, meta := matchPattern(textRunes, patternRunes)
-	if !matched {
-		return false, matchScore{}
+	positions, points := alignPattern(textRunes, patternRunes)
+	if positions == nil {
+		return false, matchScore{}, nil
 	}
 
+	meta := matchMetadataFor(positions)
 	trailing := len(textRunes) - meta.lastIndex - 1
 	continuation := continuationPenalty(textRunes, meta.lastIndex)
 	score := matchScore{
+		points:       points,
 		first:        meta.firstIndex,
 		gap:          meta.gapScore,
 		trailing:     trailing,
@@ -35,7 +56,7 @@ func fuzzyMatchScore(text, pattern string) (bool, matchScore) {
 		length:       len(textRunes),
 	}
 
-	return true, score
+	return true, score, positions
 }
 
 type matchMetadata struct {
@@ -44,34 +65,124 @@ type matchMetadata struct {
 	gapScore   int
 }
 
-func matchPattern(textRunes, patternRunes []rune) (bool, matchMetadata) {
+func matchMetadataFor(positions []int) matchMetadata {
 	meta := matchMetadata{
-		firstIndex: -1,
-		lastIndex:  -1,
+		firstIndex: positions[0],
+		lastIndex:  positions[len(positions)-1],
+	}
+	for i := 1; i < len(positions); i++ {
+		meta.gapScore += positions[i] - positions[i-1] - 1
+	}
+	return meta
+}
+
+// alignPattern finds the highest scoring placement of patternRunes inside
+// textRunes and returns the matched rune indices with their score. It returns
+// nil positions when the pattern does not appear in order.
+//
+// Unlike a greedy left-to-right scan, this considers every placement so that
+// "ab" in "rebase abort" lands on the word "abort" rather than "rebase".
+func alignPattern(textRunes, patternRunes []rune) ([]int, int) {
+	n, m := len(textRunes), len(patternRunes)
+	if m == 0 || m > n {
+		return nil, 0
+	}
+
+	bonuses := make([]int, n)
+	for j := range textRunes {
+		bonuses[j] = boundaryBonus(textRunes, j)
 	}
 
-	textIdx := 0
-	patternIdx := 0
+	const unset = math.MinInt32
+	scores := make([][]int, m)
+	chunkBonus := make([][]int, m)
+	from := make([][]int, m)
+	for i := range scores {
+		scores[i] = make([]int, n)
+		chunkBonus[i] = make([]int, n)
+		from[i] = make([]int, n)
+		for j := range scores[i] {
+			scores[i][j] = unset
+		}
+	}
+
+	for j := 0; j < n; j++ {
+		if textRunes[j] == patternRunes[0] {
+			scores[0][j] = scoreMatch + bonuses[j]*bonusFirstCharMultiplier
+			chunkBonus[0][j] = bonuses[j]
+			from[0][j] = -1
+		}
+	}
 
-	for textIdx < len(textRunes) && patternIdx < len(patternRunes) {
-		if textRunes[textIdx] == patternRunes[patternIdx] {
-			if meta.firstIndex == -1 {
-				meta.firstIndex = textIdx
+	for i := 1; i < m; i++ {
+		for j := i; j < n; j++ {
+			if textRunes[j] != patternRunes[i] {
+				continue
 			}
-			if meta.lastIndex != -1 {
-				meta.gapScore += textIdx - meta.lastIndex - 1
+			best, bestFrom, bestChunk := unset, -1, 0
+			for k := i - 1; k < j; k++ {
+				prev := scores[i-1][k]
+				if prev == unset {
+					continue
+				}
+				var score, chunk int
+				if k == j-1 {
+					// Consecutive runes inherit the bonus of the rune that started the run.
+					chunk = chunkBonus[i-1][k]
+					if bonuses[j] >= bonusBoundary && bonuses[j] > chunk {
+						chunk = bonuses[j]
+					}
+					score = prev + scoreMatch + max(bonuses[j], chunk, bonusConsecutive)
+				} else {
+					chunk = bonuses[j]
+					score = prev + scoreGapStart + scoreGapExtension*(j-k-2) + scoreMatch + bonuses[j]
+				}
+				if score > best {
+					best, bestFrom, bestChunk = score, k, chunk
+				}
 			}
-			meta.lastIndex = textIdx
-			patternIdx++
+			scores[i][j] = best
+			chunkBonus[i][j] = bestChunk
+			from[i][j] = bestFrom
 		}
-		textIdx++
 	}
 
-	if patternIdx != len(patternRunes) {
-		return false, meta
+	end, total := -1, unset
+	for j := m - 1; j < n; j++ {
+		if scores[m-1][j] > total {
+			end, total = j, scores[m-1][j]
+		}
+	}
+	if end < 0 {
+		return nil, 0
 	}
 
-	return true, meta
+	positions := make([]int, m)
+	for i := m - 1; i >= 0; i-- {
+		positions[i] = end
+		end = from[i][end]
+	}
+	return positions, total
+}
+
+// boundaryBonus rewards runes that begin a word: the start of the text or a
+// rune following whitespace earns the most, one following punctuation less.
+func boundaryBonus(textRunes []rune, idx int) int {
+	if idx == 0 {
+		return bonusBoundaryWhite
+	}
+	prev := textRunes[idx-1]
+	switch {
+	case unicode.IsSpace(prev):
+		return bonusBoundaryWhite
+	case !isWordRune(prev) && isWordRune(textRunes[idx]):
+		return bonusBoundary
+	}
+	return 0
+}
+
+func isWordRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r)
 }
 
 func continuationPenalty(textRunes []rune, lastMatchIdx int) int {
@@ -94,6 +205,7 @@ func continuationPenalty(textRunes []rune, lastMatchIdx int) int {
 }
 
 type matchScore struct {
+	points       int
 	first        int
 	gap          int
 	trailing     int
@@ -102,6 +214,9 @@ type matchScore struct {
 }
 
 func (m matchScore) less(other matchScore) bool {
+	if m.points != other.points {
+		return m.points > other.points
+	}
 	if m.first != other.first {
 		return m.first < other.first
 	}
@@ -17,6 +17,8 @@ type GitStatus struct {
 	Ahead      int
 	Behind     int
 	HasChanges bool
+	Operation  git.Operation // rebase, merge, etc. waiting to be continued
+	Submodules int           // submodules out of sync or with changes
 }
 
 // ANSIColors is an alias to the shared UI palette definition.
@@ -27,6 +29,12 @@ func NewANSIColors() *ANSIColors {
 	return uiutil.NewANSIColors()
 }
 
+// Symbols is an alias to the shared glyph table.
+type Symbols = uiutil.Symbols
+
+// Theme is an alias to the shared color theme.
+type Theme = uiutil.Theme
+
 // getGitStatus retrieves the current Git repository status
 func getGitStatus(gitClient git.StatusInfoReader) *GitStatus {
 	status := &GitStatus{}
@@ -49,9 +57,41 @@ func getGitStatus(gitClient git.StatusInfoReader) *GitStatus {
 	status.Ahead = ahead
 	status.Behind = behind
 
+	// An error leaves the status line without the operation
+	status.Operation, _ = gitClient.OperationInProgress()
+
+	if submodules, err := gitClient.ListSubmodules(); err == nil {
+		for _, s := range submodules {
+			if s.Dirty() {
+				status.Submodules++
+			}
+		}
+	}
+
 	return status
 }
 
+// refreshGitStatus reloads the status line and the commands suggested for
+// the operation in progress.
+func (ui *UI) refreshGitStatus() {
+	ui.gitStatus = getGitStatus(ui.gitClient)
+	var op git.Operation
+	if ui.gitStatus != nil {
+		op = ui.gitStatus.Operation
+	}
+	ui.state.setSuggestions(operationSuggestions[op])
+}
+
+// operationSuggestions lists the commands offered at the top of the search
+// while an operation is in progress.
+var operationSuggestions = map[git.Operation][]string{
+	git.OperationMerge:      {"conflicts", "merge continue", "merge abort"},
+	git.OperationRebase:     {"conflicts", "rebase continue", "rebase skip", "rebase abort"},
+	git.OperationCherryPick: {"conflicts", "cherry-pick continue", "cherry-pick skip", "cherry-pick abort"},
+	git.OperationRevert:     {"conflicts", "revert continue", "revert abort"},
+	git.OperationBisect:     {"bisect", "bisect good", "bisect bad", "bisect skip", "bisect reset"},
+}
+
 // getGitBranch gets the current branch name
 func getGitBranch(gitClient git.StatusInfoReader) string {
 	branch, err := gitClient.GetCurrentBranch()
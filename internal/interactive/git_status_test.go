@@ -0,0 +1,192 @@
+package interactive
+
+import (
+	"bytes"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/bmf-san/ggc/v8/internal/git"
+	uiutil "github.com/bmf-san/ggc/v8/internal/ui"
+)
+
+var operationTestCommands = []CommandInfo{
+	{Command: "conflicts", Description: "Resolve conflicted files interactively"},
+	{Command: "rebase continue", Description: "Continue an in-progress rebase"},
+	{Command: "rebase abort", Description: "Abort an in-progress rebase"},
+	{Command: "status", Description: "Show status"},
+}
+
+func TestGetGitStatus_Operation(t *testing.T) {
+	mock := &mockStatusInfoReader{currentBranch: "main", operation: git.OperationRebase}
+	status := getGitStatus(mock)
+	if status == nil || status.Operation != git.OperationRebase {
+		t.Fatalf("expected a rebase in progress, got %+v", status)
+	}
+}
+
+func TestRenderGitStatus_Operation(t *testing.T) {
+	var buf bytes.Buffer
+	r := &Renderer{writer: &buf, colors: NewANSIColors(), width: 80, height: 24}
+
+	r.renderGitStatus(nil, &GitStatus{Branch: "main", Operation: git.OperationCherryPick})
+	if !strings.Contains(uiutil.StripANSI(buf.String()), "cherry-pick in progress") {
+		t.Errorf("status line should show the operation, got %q", buf.String())
+	}
+
+	buf.Reset()
+	r.renderGitStatus(nil, &GitStatus{Branch: "main"})
+	if strings.Contains(buf.String(), "in progress") {
+		t.Errorf("status line should not show an operation, got %q", buf.String())
+	}
+}
+
+func TestGetGitStatus_Submodules(t *testing.T) {
+	mock := &mockStatusInfoReader{currentBranch: "main", submodules: []git.Submodule{
+		{Path: "a", OutOfDate: true},
+		{Path: "b", Untracked: true},
+		{Path: "c"},
+		{Path: "d", Uninitialized: true},
+	}}
+	status := getGitStatus(mock)
+	if status == nil || status.Submodules != 2 {
+		t.Fatalf("expected 2 changed submodules, got %+v", status)
+	}
+}
+
+func TestRenderGitStatus_Submodules(t *testing.T) {
+	var buf bytes.Buffer
+	r := &Renderer{writer: &buf, colors: NewANSIColors(), width: 80, height: 24}
+
+	r.renderGitStatus(nil, &GitStatus{Branch: "main", Submodules: 1})
+	if !strings.Contains(uiutil.StripANSI(buf.String()), "1 submodule changed") {
+		t.Errorf("status line should show the submodules, got %q", buf.String())
+	}
+
+	buf.Reset()
+	r.renderGitStatus(nil, &GitStatus{Branch: "main", Submodules: 3})
+	if !strings.Contains(uiutil.StripANSI(buf.String()), "3 submodules changed") {
+		t.Errorf("status line should show the submodules, got %q", buf.String())
+	}
+
+	buf.Reset()
+	r.renderGitStatus(nil, &GitStatus{Branch: "main"})
+	if strings.Contains(buf.String(), "submodule") {
+		t.Errorf("status line should not show submodules, got %q", buf.String())
+	}
+}
+
+func TestUIState_SuggestionsComeFirst(t *testing.T) {
+	now := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+	h := newTestHistory(t, now)
+	h.entries = []HistoryEntry{
+		{Template: "rebase continue", Line: "rebase continue", Count: 1, LastUsed: now.Add(-time.Minute)},
+		{Template: "status", Line: "status", Count: 3, LastUsed: now.Add(-time.Hour)},
+	}
+
+	state := &UIState{history: h, commands: operationTestCommands}
+	// merge abort is not registered and is skipped.
+	state.setSuggestions([]string{"conflicts", "rebase continue", "merge abort"})
+	state.UpdateFiltered()
+
+	var got []string
+	for _, info := range state.filtered {
+		got = append(got, info.Command)
+	}
+	if want := []string{"conflicts", "rebase continue", "status"}; !slices.Equal(got, want) {
+		t.Fatalf("filtered = %v, want %v", got, want)
+	}
+	if !state.IsSuggestionSelected() {
+		t.Error("expected the first suggestion to be selected")
+	}
+	state.selected = 2
+	if state.IsSuggestionSelected() {
+		t.Error("a recent line should not count as a suggestion")
+	}
+
+	state.input = "st"
+	state.UpdateFiltered()
+	if state.IsSuggestionSelected() {
+		t.Error("suggestions apply only to the empty search")
+	}
+}
+
+func TestUIState_SuggestionsWithoutHistory(t *testing.T) {
+	state := &UIState{commands: operationTestCommands}
+	state.setSuggestions(operationSuggestions[git.OperationRebase])
+	state.UpdateFiltered()
+
+	if !state.IsRecentView() || len(state.filtered) != 3 || state.filtered[0].Command != "conflicts" {
+		t.Fatalf("expected the rebase suggestions, got %v", state.filtered)
+	}
+}
+
+func TestUI_RefreshGitStatusSetsSuggestions(t *testing.T) {
+	mock := &mockStatusInfoReader{currentBranch: "main", operation: git.OperationRebase}
+	ui := &UI{gitClient: mock, state: &UIState{commands: operationTestCommands}}
+
+	ui.refreshGitStatus()
+	if len(ui.state.suggestions) != 3 {
+		t.Fatalf("expected rebase suggestions, got %v", ui.state.suggestions)
+	}
+
+	mock.operation = git.OperationNone
+	ui.refreshGitStatus()
+	if len(ui.state.suggestions) != 0 {
+		t.Errorf("expected suggestions to clear, got %v", ui.state.suggestions)
+	}
+}
+
+func TestRenderRecentList_Suggestions(t *testing.T) {
+	ui, _ := newHelpTestUI(nil)
+	ui.gitStatus = &GitStatus{Branch: "main", Operation: git.OperationRebase}
+	ui.state.commands = operationTestCommands
+	ui.state.setSuggestions(operationSuggestions[git.OperationRebase])
+	ui.state.UpdateFiltered()
+
+	var buf strings.Builder
+	ui.renderer.writer = &buf
+	ui.renderer.renderRecentList(ui, ui.state)
+
+	out := uiutil.StripANSI(buf.String())
+	for _, want := range []string{"Rebase in progress", "rebase continue", "rebase abort"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("output missing %q:\n%s", want, out)
+		}
+	}
+	if strings.Contains(out, "Recent commands") {
+		t.Errorf("no recent header expected without history:\n%s", out)
+	}
+}
+
+func TestKeyHandler_EnterRunsSuggestion(t *testing.T) {
+	now := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+	h := newTestHistory(t, now)
+	h.entries = []HistoryEntry{
+		{Template: "status", Line: "status", Count: 1, LastUsed: now.Add(-time.Hour)},
+	}
+
+	var stdout bytes.Buffer
+	ui := &UI{
+		stdout: &stdout,
+		stderr: &bytes.Buffer{},
+		colors: NewANSIColors(),
+		state:  &UIState{history: h, commands: operationTestCommands},
+	}
+	handler := &KeyHandler{ui: ui}
+	ui.handler = handler
+	ui.state.setSuggestions([]string{"rebase continue"})
+	ui.state.UpdateFiltered()
+
+	cont, args := handler.handleEnter(nil)
+	if cont {
+		t.Fatal("expected Enter on a suggestion to execute it")
+	}
+	if want := []string{"ggc", "rebase", "continue"}; !slices.Equal(args, want) {
+		t.Errorf("handleEnter() args = %v, want %v", args, want)
+	}
+	if !strings.Contains(stdout.String(), "Executing:") {
+		t.Errorf("expected the command to be executed, got %q", stdout.String())
+	}
+}
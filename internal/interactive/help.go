@@ -0,0 +1,180 @@
+package interactive
+
+import (
+	"strings"
+
+	kb "github.com/bmf-san/ggc/v8/internal/keybindings"
+)
+
+// helpEntry is one row of the keyboard help overlay.
+type helpEntry struct {
+	category string
+	action   string // keymap action name, empty for built-in keys
+	keys     string
+	desc     string
+}
+
+// helpOverlay lists every key binding of the current context. It is drawn
+// over whichever mode is active and filtered as the user types.
+type helpOverlay struct {
+	query    string
+	offset   int
+	pageSize int
+}
+
+// helpRow is a rendered row of the overlay: a category heading or an entry.
+type helpRow struct {
+	heading string
+	entry   helpEntry
+}
+
+func (o *helpOverlay) scroll(delta, rows int) {
+	o.offset = min(max(o.offset+delta, 0), max(rows-o.pageSize, 0))
+}
+
+// helpEntries lists the actions bound in the active context, resolved from
+// the profile, platform and user overrides, followed by the command keys and
+// the fixed keys of the current mode.
+func (ui *UI) helpEntries() []helpEntry {
+	var km *kb.KeyBindingMap
+	if ui.handler != nil {
+		km = ui.handler.GetCurrentKeyMap()
+	}
+	if km == nil {
+		km = kb.DefaultKeyBindingMap()
+	}
+
+	var entries []helpEntry
+	for _, binding := range km.Bindings() {
+		keys := kb.FormatKeyStrokesForDisplay(binding.Keys)
+		if len(binding.Keys) == 0 || keys == "" || keys == "none" {
+			continue
+		}
+		entries = append(entries, helpEntry{
+			category: binding.Category,
+			action:   binding.Action,
+			keys:     keys,
+			desc:     binding.Description,
+		})
+	}
+	for _, key := range ui.commandKeys {
+		entries = append(entries, helpEntry{
+			category: "Commands",
+			keys:     kb.FormatKeyStrokeForDisplay(key.stroke),
+			desc:     "Run " + key.target.Command,
+		})
+	}
+	return append(entries, modeHelpEntries(ui.state.mode, ui.sym())...)
+}
+
+// modeHelpEntries returns the keys that are fixed for mode and cannot be
+// rebound.
+func modeHelpEntries(mode UIMode, sym *Symbols) []helpEntry {
+	leftRight, upDown := sym.Left+"/"+sym.Right, sym.Up+"/"+sym.Down
+	var category string
+	var keys [][2]string
+	switch mode {
+	case ModeWorkflow:
+		category = "Workflow mode"
+		keys = [][2]string{
+			{"x", "Execute active workflow"},
+			{"n", "Create new workflow"},
+			{"d", "Delete active workflow"},
+		}
+	case ModeBrowse:
+		category = "Category browser"
+		keys = [][2]string{
+			{leftRight + " h/l Tab", "Switch category"},
+			{upDown + " j/k", "Select command"},
+			{"Enter", "Run selected command"},
+			{"p", "Pin or unpin command"},
+		}
+	case ModeOutput:
+		category = "Output pane"
+		keys = [][2]string{
+			{"Enter/q", "Back to search"},
+			{"j/k", "Scroll"},
+			{"Space/b", "Page down/up"},
+			{"g/G", "Jump to top/bottom"},
+			{"/", "Search output"},
+			{"n/N", "Next/previous match"},
+			{"y", "Copy output"},
+		}
+	default:
+		category = "Search"
+		keys = [][2]string{
+			{"Enter", "Execute selected command"},
+			{"Backspace", "Delete character"},
+			{leftRight, "Move cursor"},
+			{"Ctrl+" + leftRight, "Move by word"},
+		}
+	}
+	keys = append(keys, [2]string{"?", "Show this help"}, [2]string{"Ctrl+c", "Quit"})
+
+	entries := make([]helpEntry, 0, len(keys))
+	for _, k := range keys {
+		entries = append(entries, helpEntry{category: category, keys: k[0], desc: k[1]})
+	}
+	return entries
+}
+
+// filterHelpEntries keeps the entries whose category, action name, keys or
+// description contain query, case-insensitively.
+func filterHelpEntries(entries []helpEntry, query string) []helpEntry {
+	needle := strings.ToLower(strings.TrimSpace(query))
+	if needle == "" {
+		return entries
+	}
+	var filtered []helpEntry
+	for _, e := range entries {
+		haystack := strings.ToLower(strings.Join([]string{e.category, e.action, e.keys, e.desc}, " "))
+		if strings.Contains(haystack, needle) {
+			filtered = append(filtered, e)
+		}
+	}
+	return filtered
+}
+
+// helpRows groups entries under a heading per category, keeping the order in
+// which categories first appear.
+func helpRows(entries []helpEntry) []helpRow {
+	var order []string
+	groups := make(map[string][]helpEntry)
+	for _, e := range entries {
+		if _, ok := groups[e.category]; !ok {
+			order = append(order, e.category)
+		}
+		groups[e.category] = append(groups[e.category], e)
+	}
+	var rows []helpRow
+	for _, category := range order {
+		rows = append(rows, helpRow{heading: category})
+		for _, e := range groups[category] {
+			rows = append(rows, helpRow{entry: e})
+		}
+	}
+	return rows
+}
+
+// canOpenHelp reports whether '?' opens the help overlay rather than being
+// typed into an input field.
+func (ui *UI) canOpenHelp() bool {
+	switch ui.state.mode {
+	case ModeWorkflow, ModeBrowse:
+		return true
+	case ModeOutput:
+		return ui.state.output == nil || !ui.state.output.searching
+	default:
+		return ui.state.input == ""
+	}
+}
+
+// openHelp shows the keyboard help overlay.
+func (ui *UI) openHelp() {
+	ui.state.help = &helpOverlay{pageSize: defaultOutputPageSize}
+}
+
+// closeHelp hides the keyboard help overlay.
+func (ui *UI) closeHelp() {
+	ui.state.help = nil
+}
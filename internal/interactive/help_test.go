@@ -0,0 +1,131 @@
+package interactive
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	kb "github.com/bmf-san/ggc/v8/internal/keybindings"
+)
+
+func newHelpTestUI(keyMap *kb.KeyBindingMap) (*UI, *bytes.Buffer) {
+	var stdout bytes.Buffer
+	colors := NewANSIColors()
+	ui := &UI{
+		stdin:    strings.NewReader(""),
+		stdout:   &stdout,
+		stderr:   &bytes.Buffer{},
+		colors:   colors,
+		profile:  kb.ProfileDefault,
+		renderer: &Renderer{writer: &stdout, width: 80, height: 40, colors: colors},
+		state: &UIState{
+			context:  kb.ContextInput,
+			commands: []CommandInfo{{Command: "status", Description: "Show status"}},
+		},
+	}
+	contextual := kb.NewContextualKeyBindingMap(kb.ProfileDefault, "linux", "xterm")
+	contextual.SetContext(kb.ContextInput, keyMap)
+	ui.handler = &KeyHandler{ui: ui, contextualMap: contextual}
+	return ui, &stdout
+}
+
+func typeKeys(ui *UI, keys string) {
+	for _, r := range keys {
+		ui.handler.HandleKey(r, false, nil, nil)
+	}
+}
+
+func TestHelpOverlay_OpensOnlyWhenQuestionMarkIsNotTyped(t *testing.T) {
+	ui, _ := newHelpTestUI(kb.DefaultKeyBindingMap())
+	typeKeys(ui, "st?")
+	if ui.state.help != nil || ui.state.input != "st?" {
+		t.Fatalf("'?' after input should be typed, got input %q help %v", ui.state.input, ui.state.help)
+	}
+
+	ui.state.ClearInput()
+	typeKeys(ui, "?")
+	if ui.state.help == nil {
+		t.Fatal("'?' on an empty search should open the help overlay")
+	}
+	typeKeys(ui, "?")
+	if ui.state.help != nil {
+		t.Fatal("'?' on an empty filter should close the help overlay")
+	}
+}
+
+func TestHelpOverlay_ListsActiveKeymap(t *testing.T) {
+	keyMap := kb.DefaultKeyBindingMap()
+	keyMap.MoveUp = []kb.KeyStroke{kb.NewCtrlKeyStroke('k')}
+	keyMap.TogglePreview = nil
+	ui, _ := newHelpTestUI(keyMap)
+
+	entries := ui.helpEntries()
+	var moveUp *helpEntry
+	for i := range entries {
+		switch entries[i].action {
+		case "move_up":
+			moveUp = &entries[i]
+		case "toggle_preview":
+			t.Error("unbound action toggle_preview should not be listed")
+		}
+	}
+	if moveUp == nil || moveUp.keys != kb.FormatKeyStrokesForDisplay(keyMap.MoveUp) {
+		t.Fatalf("expected move_up with the overridden key, got %+v", moveUp)
+	}
+	if entries[len(entries)-1].category != "Search" {
+		t.Errorf("expected search mode keys last, got %q", entries[len(entries)-1].category)
+	}
+}
+
+func TestHelpOverlay_FilterAndClose(t *testing.T) {
+	ui, stdout := newHelpTestUI(kb.DefaultKeyBindingMap())
+	typeKeys(ui, "?workflow")
+	if ui.state.help == nil || ui.state.help.query != "workflow" {
+		t.Fatalf("expected filter query 'workflow', got %+v", ui.state.help)
+	}
+	if ui.state.input != "" {
+		t.Fatalf("typing in the overlay must not edit the search input, got %q", ui.state.input)
+	}
+
+	ui.renderer.Render(ui, ui.state)
+	out := stdout.String()
+	if !strings.Contains(out, "Keyboard shortcuts") || !strings.Contains(out, "Add command to workflow") {
+		t.Fatalf("expected filtered workflow bindings, got %q", out)
+	}
+	if strings.Contains(out, "Delete previous word") {
+		t.Errorf("filter should hide unrelated bindings, got %q", out)
+	}
+
+	ui.handler.HandleKey(7, false, nil, nil) // Ctrl+G soft cancel
+	if ui.state.help != nil {
+		t.Fatal("soft cancel should close the help overlay")
+	}
+}
+
+func TestHelpOverlay_OutputSearchKeepsQuestionMark(t *testing.T) {
+	ui, _ := newHelpTestUI(kb.DefaultKeyBindingMap())
+	ui.ShowOutput(CommandOutput{Command: "status", Output: "line"})
+	typeKeys(ui, "/?")
+	if ui.state.help != nil || ui.state.output.query != "?" {
+		t.Fatalf("'?' while searching output should be typed, got query %q", ui.state.output.query)
+	}
+}
+
+func TestHelpRows_GroupsByCategory(t *testing.T) {
+	rows := helpRows([]helpEntry{
+		{category: "Navigation", keys: "a"},
+		{category: "Editing", keys: "b"},
+		{category: "Navigation", keys: "c"},
+	})
+	var got []string
+	for _, row := range rows {
+		if row.heading != "" {
+			got = append(got, "#"+row.heading)
+		} else {
+			got = append(got, row.entry.keys)
+		}
+	}
+	if want := "#Navigation a c #Editing b"; strings.Join(got, " ") != want {
+		t.Errorf("helpRows = %q, want %q", strings.Join(got, " "), want)
+	}
+}
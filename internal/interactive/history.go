@@ -0,0 +1,247 @@
+package interactive
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+)
+
+const (
+	// historyLimit caps the number of distinct command lines kept per repository.
+	historyLimit = 200
+	// recentLimit is the number of entries shown in the recent view.
+	recentLimit = 10
+	// frecencyBonusCap bounds how far history can lift a fuzzy match so that
+	// frequently used commands float up without burying better text matches.
+	frecencyBonusCap = 2 * scoreMatch
+)
+
+// HistoryEntry records how often and how recently a command line was run.
+// Args holds the arguments after "ggc" so that a re-run keeps the argument
+// boundaries; Line is their shell-quoted form for display.
+type HistoryEntry struct {
+	Template string    `json:"template"`
+	Line     string    `json:"line"`
+	Args     []string  `json:"args,omitempty"`
+	Count    int       `json:"count"`
+	LastUsed time.Time `json:"last_used"`
+}
+
+// CommandHistory is a per-repository store of commands executed from
+// interactive mode. It backs frecency ranking and the recent view.
+type CommandHistory struct {
+	path    string
+	entries []HistoryEntry
+	now     func() time.Time
+}
+
+// NewCommandHistory loads the history stored at path. A missing or unreadable
+// file yields an empty history that will be created on the first Record.
+func NewCommandHistory(path string) *CommandHistory {
+	h := &CommandHistory{path: path, now: time.Now}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return h
+	}
+	var entries []HistoryEntry
+	if err := json.Unmarshal(data, &entries); err == nil {
+		h.entries = entries
+	}
+	return h
+}
+
+// historyPathForRepo returns the history file for the repository rooted at root,
+// under $XDG_STATE_HOME/ggc/history (default ~/.local/state/ggc/history).
+func historyPathForRepo(root string) (string, error) {
+	if root == "" {
+		return "", errors.New("repository root is empty")
+	}
+	stateDir := os.Getenv("XDG_STATE_HOME")
+	if stateDir == "" {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return "", fmt.Errorf("failed to resolve home directory: %w", err)
+		}
+		stateDir = filepath.Join(home, ".local", "state")
+	}
+	sum := sha256.Sum256([]byte(root))
+	return filepath.Join(stateDir, "ggc", "history", hex.EncodeToString(sum[:8])+".json"), nil
+}
+
+// Record notes an execution of args, the arguments after "ggc" that were
+// produced from template, and persists the history.
+func (h *CommandHistory) Record(template string, args []string) error {
+	if h == nil || len(args) == 0 {
+		return nil
+	}
+	line := quoteArgs(args)
+	now := h.now()
+	found := false
+	for i := range h.entries {
+		if h.entries[i].Line == line {
+			h.entries[i].Count++
+			h.entries[i].LastUsed = now
+			h.entries[i].Template = template
+			h.entries[i].Args = slices.Clone(args)
+			found = true
+			break
+		}
+	}
+	if !found {
+		h.entries = append(h.entries, HistoryEntry{Template: template, Line: line, Args: slices.Clone(args), Count: 1, LastUsed: now})
+	}
+	h.prune(now)
+	return h.save()
+}
+
+// prune drops the lowest-frecency entries once the history exceeds historyLimit.
+func (h *CommandHistory) prune(now time.Time) {
+	if len(h.entries) <= historyLimit {
+		return
+	}
+	sort.SliceStable(h.entries, func(i, j int) bool {
+		return entryFrecency(h.entries[i], now) > entryFrecency(h.entries[j], now)
+	})
+	h.entries = h.entries[:historyLimit]
+}
+
+func (h *CommandHistory) save() error {
+	if err := os.MkdirAll(filepath.Dir(h.path), 0o700); err != nil {
+		return fmt.Errorf("failed to create history directory: %w", err)
+	}
+	data, err := json.Marshal(h.entries)
+	if err != nil {
+		return fmt.Errorf("failed to encode history: %w", err)
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(h.path), ".history-*.tmp")
+	if err != nil {
+		return fmt.Errorf("failed to create temp history file: %w", err)
+	}
+	tmpName := tmp.Name()
+	if _, err := tmp.Write(data); err != nil {
+		_ = tmp.Close()
+		_ = os.Remove(tmpName)
+		return fmt.Errorf("failed to write history: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		_ = os.Remove(tmpName)
+		return fmt.Errorf("failed to close history file: %w", err)
+	}
+	if err := os.Rename(tmpName, h.path); err != nil {
+		_ = os.Remove(tmpName)
+		return fmt.Errorf("failed to replace history file: %w", err)
+	}
+	return nil
+}
+
+// Recent returns up to limit entries, most recently used first.
+func (h *CommandHistory) Recent(limit int) []HistoryEntry {
+	if h == nil || len(h.entries) == 0 {
+		return nil
+	}
+	recent := make([]HistoryEntry, len(h.entries))
+	copy(recent, h.entries)
+	sort.SliceStable(recent, func(i, j int) bool {
+		return recent[i].LastUsed.After(recent[j].LastUsed)
+	})
+	if limit > 0 && len(recent) > limit {
+		recent = recent[:limit]
+	}
+	return recent
+}
+
+// templateFor returns the template recorded for line, or line itself when unknown.
+func (h *CommandHistory) templateFor(line string) string {
+	if h != nil {
+		for _, entry := range h.entries {
+			if entry.Line == line {
+				return entry.Template
+			}
+		}
+	}
+	return line
+}
+
+// argsFor returns the arguments recorded for line. Entries written before
+// the arguments were stored fall back to splitting the line on whitespace.
+func (h *CommandHistory) argsFor(line string) []string {
+	if h != nil {
+		for _, entry := range h.entries {
+			if entry.Line == line && len(entry.Args) > 0 {
+				return slices.Clone(entry.Args)
+			}
+		}
+	}
+	return strings.Fields(line)
+}
+
+// quoteArgs joins args into a line a shell would split back into args,
+// single-quoting the arguments that contain whitespace, quotes or
+// backslashes.
+func quoteArgs(args []string) string {
+	quoted := make([]string, len(args))
+	for i, arg := range args {
+		if arg != "" && !strings.ContainsAny(arg, " \t\n'\"\\") {
+			quoted[i] = arg
+			continue
+		}
+		quoted[i] = "'" + strings.ReplaceAll(arg, "'", `'\''`) + "'"
+	}
+	return strings.Join(quoted, " ")
+}
+
+// Frecency returns the combined frequency/recency weight of every recorded
+// line that was produced from template.
+func (h *CommandHistory) Frecency(template string) int {
+	if h == nil {
+		return 0
+	}
+	now := h.now()
+	total := 0
+	for _, entry := range h.entries {
+		if entry.Template == template {
+			total += entryFrecency(entry, now)
+		}
+	}
+	return total
+}
+
+func entryFrecency(entry HistoryEntry, now time.Time) int {
+	return entry.Count * recencyWeight(now.Sub(entry.LastUsed))
+}
+
+// recencyWeight buckets the age of a history entry, favoring recent use.
+func recencyWeight(age time.Duration) int {
+	switch {
+	case age < time.Hour:
+		return 8
+	case age < 24*time.Hour:
+		return 4
+	case age < 7*24*time.Hour:
+		return 2
+	default:
+		return 1
+	}
+}
+
+// formatAge renders a short human-readable age such as "5m ago" or "3d ago".
+func formatAge(age time.Duration) string {
+	switch {
+	case age < time.Minute:
+		return "just now"
+	case age < time.Hour:
+		return fmt.Sprintf("%dm ago", int(age/time.Minute))
+	case age < 24*time.Hour:
+		return fmt.Sprintf("%dh ago", int(age/time.Hour))
+	default:
+		return fmt.Sprintf("%dd ago", int(age/(24*time.Hour)))
+	}
+}
@@ -0,0 +1,230 @@
+package interactive
+
+import (
+	"bytes"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+)
+
+func newTestHistory(t *testing.T, now time.Time) *CommandHistory {
+	t.Helper()
+	h := NewCommandHistory(filepath.Join(t.TempDir(), "history", "repo.json"))
+	h.now = func() time.Time { return now }
+	return h
+}
+
+func TestCommandHistory_RecordPersists(t *testing.T) {
+	now := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+	h := newTestHistory(t, now)
+
+	if err := h.Record("commit <message>", []string{"commit", "fix", "typo"}); err != nil {
+		t.Fatalf("Record() error = %v", err)
+	}
+	if err := h.Record("commit <message>", []string{"commit", "fix", "typo"}); err != nil {
+		t.Fatalf("Record() error = %v", err)
+	}
+
+	reloaded := NewCommandHistory(h.path)
+	if len(reloaded.entries) != 1 {
+		t.Fatalf("expected 1 persisted entry, got %d", len(reloaded.entries))
+	}
+	entry := reloaded.entries[0]
+	if entry.Count != 2 || entry.Template != "commit <message>" || !entry.LastUsed.Equal(now) {
+		t.Errorf("unexpected persisted entry: %+v", entry)
+	}
+}
+
+func TestCommandHistory_RecentOrdersByLastUse(t *testing.T) {
+	now := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+	h := newTestHistory(t, now)
+	h.entries = []HistoryEntry{
+		{Template: "status", Line: "status", Count: 9, LastUsed: now.Add(-48 * time.Hour)},
+		{Template: "fetch", Line: "fetch", Count: 1, LastUsed: now.Add(-time.Minute)},
+		{Template: "log simple", Line: "log simple", Count: 3, LastUsed: now.Add(-time.Hour)},
+	}
+
+	var got []string
+	for _, entry := range h.Recent(2) {
+		got = append(got, entry.Line)
+	}
+	if want := []string{"fetch", "log simple"}; !slices.Equal(got, want) {
+		t.Errorf("Recent(2) = %v, want %v", got, want)
+	}
+}
+
+func TestCommandHistory_FrecencyFavorsRecentUse(t *testing.T) {
+	now := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+	h := newTestHistory(t, now)
+	h.entries = []HistoryEntry{
+		{Template: "commit <message>", Line: "commit a", Count: 2, LastUsed: now.Add(-10 * time.Minute)},
+		{Template: "commit <message>", Line: "commit b", Count: 1, LastUsed: now.Add(-30 * 24 * time.Hour)},
+		{Template: "status", Line: "status", Count: 3, LastUsed: now.Add(-30 * 24 * time.Hour)},
+	}
+
+	if got := h.Frecency("commit <message>"); got != 2*8+1 {
+		t.Errorf("Frecency(commit) = %d, want %d", got, 2*8+1)
+	}
+	if got := h.Frecency("status"); got != 3 {
+		t.Errorf("Frecency(status) = %d, want 3", got)
+	}
+	if got := h.Frecency("push"); got != 0 {
+		t.Errorf("Frecency(push) = %d, want 0", got)
+	}
+}
+
+func TestCommandHistory_PruneKeepsHighestFrecency(t *testing.T) {
+	now := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+	h := newTestHistory(t, now)
+	for i := 0; i < historyLimit; i++ {
+		h.entries = append(h.entries, HistoryEntry{Template: "old", Line: "old " + strings.Repeat("x", i+1), Count: 1, LastUsed: now.Add(-30 * 24 * time.Hour)})
+	}
+
+	if err := h.Record("status", []string{"status"}); err != nil {
+		t.Fatalf("Record() error = %v", err)
+	}
+	if len(h.entries) != historyLimit {
+		t.Fatalf("expected history capped at %d, got %d", historyLimit, len(h.entries))
+	}
+	if h.templateFor("status") != "status" || h.entries[0].Line != "status" {
+		t.Errorf("expected freshly recorded entry to survive pruning, got first entry %+v", h.entries[0])
+	}
+}
+
+func TestUIState_UpdateFiltered_FrecencyBoost(t *testing.T) {
+	now := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+	h := newTestHistory(t, now)
+	h.entries = []HistoryEntry{
+		{Template: "status short", Line: "status short", Count: 5, LastUsed: now.Add(-time.Minute)},
+	}
+
+	state := &UIState{
+		input:     "st",
+		cursorPos: 2,
+		history:   h,
+		commands: []CommandInfo{
+			{Command: "stash", Description: "stash"},
+			{Command: "status short", Description: "status short"},
+		},
+	}
+	state.UpdateFiltered()
+
+	if len(state.filtered) != 2 || state.filtered[0].Command != "status short" {
+		t.Fatalf("expected frequently used command first, got %v", state.filtered)
+	}
+}
+
+func TestUIState_UpdateFiltered_RecentViewOnEmptyInput(t *testing.T) {
+	now := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+	h := newTestHistory(t, now)
+	h.entries = []HistoryEntry{
+		{Template: "commit <message>", Line: "commit fix typo", Count: 2, LastUsed: now.Add(-2 * time.Hour)},
+	}
+
+	state := &UIState{history: h, commands: []CommandInfo{{Command: "status", Description: "status"}}}
+	state.UpdateFiltered()
+
+	if !state.IsRecentView() {
+		t.Fatal("expected recent view when input is empty and history exists")
+	}
+	if len(state.filtered) != 1 || state.filtered[0].Command != "commit fix typo" {
+		t.Fatalf("expected recent command line, got %v", state.filtered)
+	}
+	if state.filtered[0].Description != "run 2 times, 2h ago" {
+		t.Errorf("unexpected recent description %q", state.filtered[0].Description)
+	}
+
+	state.input = "s"
+	state.UpdateFiltered()
+	if state.IsRecentView() {
+		t.Error("expected recent view to end once input is typed")
+	}
+}
+
+func TestKeyHandler_EnterRerunsRecentLine(t *testing.T) {
+	now := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+	h := newTestHistory(t, now)
+	h.entries = []HistoryEntry{
+		{Template: "commit <message>", Line: "commit fix typo", Count: 1, LastUsed: now.Add(-time.Hour)},
+	}
+
+	var stdout bytes.Buffer
+	ui := &UI{
+		stdout: &stdout,
+		stderr: &bytes.Buffer{},
+		colors: NewANSIColors(),
+		state:  &UIState{history: h},
+	}
+	handler := &KeyHandler{ui: ui}
+	ui.handler = handler
+	ui.state.UpdateFiltered()
+
+	cont, args := handler.handleEnter(nil)
+	if cont {
+		t.Fatal("expected Enter in recent view to execute the command")
+	}
+	if want := []string{"ggc", "commit", "fix", "typo"}; !slices.Equal(args, want) {
+		t.Errorf("handleEnter() args = %v, want %v", args, want)
+	}
+	if h.entries[0].Count != 2 || h.entries[0].Template != "commit <message>" {
+		t.Errorf("expected re-run to be recorded against its template, got %+v", h.entries[0])
+	}
+}
+
+func TestKeyHandler_RerunKeepsQuotedArguments(t *testing.T) {
+	now := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+	h := newTestHistory(t, now)
+	tests := [][]string{
+		{"commit", "fix the thing"},
+		{"submodule", "foreach", "git fetch"},
+		{"commit", "it's \"done\""},
+	}
+	for _, args := range tests {
+		if err := h.Record("template", args); err != nil {
+			t.Fatalf("Record() error = %v", err)
+		}
+	}
+	reloaded := NewCommandHistory(h.path)
+	reloaded.now = h.now
+
+	for _, want := range tests {
+		line := quoteArgs(want)
+		if got := reloaded.argsFor(line); !slices.Equal(got, want) {
+			t.Errorf("argsFor(%q) = %q, want %q", line, got, want)
+		}
+	}
+	if got := quoteArgs(tests[1]); got != "submodule foreach 'git fetch'" {
+		t.Errorf("quoteArgs() = %q", got)
+	}
+
+	ui := &UI{
+		stdout: &bytes.Buffer{},
+		stderr: &bytes.Buffer{},
+		colors: NewANSIColors(),
+		state:  &UIState{history: reloaded},
+	}
+	handler := &KeyHandler{ui: ui}
+	ui.handler = handler
+	ui.state.UpdateFiltered()
+	for i, entry := range ui.state.filtered {
+		if entry.Command == "commit 'fix the thing'" {
+			ui.state.selected = i
+		}
+	}
+
+	_, args := handler.handleEnter(nil)
+	if want := []string{"ggc", "commit", "fix the thing"}; !slices.Equal(args, want) {
+		t.Errorf("handleEnter() args = %q, want %q", args, want)
+	}
+}
+
+func TestCommandHistory_ArgsForLegacyEntry(t *testing.T) {
+	h := newTestHistory(t, time.Now())
+	h.entries = []HistoryEntry{{Template: "status", Line: "status short", Count: 1}}
+
+	if got := h.argsFor("status short"); !slices.Equal(got, []string{"status", "short"}) {
+		t.Errorf("argsFor() = %q, want the line split on whitespace", got)
+	}
+}
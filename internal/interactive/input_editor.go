@@ -52,13 +52,13 @@ func (e *realTimeEditor) handleEnter() inputResult {
 		e.ui.write("\r\n")
 		return inputResult{done: true, text: string(*e.inputRunes)}
 	}
-	e.ui.write(" %s(required)%s", e.ui.colors.BrightRed, e.ui.colors.Reset)
+	e.ui.write(" %s(required)%s", e.ui.th().Error, e.ui.colors.Reset)
 	return inputResult{}
 }
 
 // handleCtrlC processes Ctrl+C
 func (e *realTimeEditor) handleCtrlC() inputResult {
-	e.ui.write("\r\n%sOperation canceled%s\r\n", e.ui.colors.BrightRed, e.ui.colors.Reset)
+	e.ui.write("\r\n%sOperation canceled%s\r\n", e.ui.th().Error, e.ui.colors.Reset)
 	return inputResult{canceled: true}
 }
 
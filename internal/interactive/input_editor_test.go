@@ -19,31 +19,6 @@ func makeEditor(runes []rune, cursorPos int) (*realTimeEditor, *[]rune, *int) {
 	return e, &r, &c
 }
 
-// --- runeDisplayWidth (missing East Asian wide branch) ---
-
-func TestRuneDisplayWidth_EastAsianWide(t *testing.T) {
-	// '中' is U+4E2D, an East Asian Wide character
-	got := runeDisplayWidth('中')
-	if got != 2 {
-		t.Errorf("'中' width: got %d, want 2", got)
-	}
-}
-
-func TestRuneDisplayWidth_ZeroWidth(t *testing.T) {
-	// U+0301 combining acute accent is zero width
-	got := runeDisplayWidth(0x0301)
-	if got != 0 {
-		t.Errorf("combining mark width: got %d, want 0", got)
-	}
-}
-
-func TestRuneDisplayWidth_Normal(t *testing.T) {
-	got := runeDisplayWidth('A')
-	if got != 1 {
-		t.Errorf("'A' width: got %d, want 1", got)
-	}
-}
-
 // --- colsBetween edge cases (currently 70%) ---
 
 func TestColsBetween_NegativeFrom(t *testing.T) {
@@ -4,67 +4,9 @@ import (
 	"strings"
 	"unicode"
 
-	"golang.org/x/text/width"
+	uiutil "github.com/bmf-san/ggc/v8/internal/ui"
 )
 
-// isCombining reports whether r is a combining mark (zero display width)
-func isCombining(r rune) bool {
-	return unicode.Is(unicode.Mn, r) || unicode.Is(unicode.Me, r) || unicode.Is(unicode.Mc, r)
-}
-
-// isVariationSelector reports whether r is a variation selector (zero width)
-func isVariationSelector(r rune) bool {
-	// U+FE00..U+FE0F (VS1..VS16) and U+E0100..U+E01EF (IVS)
-	return (r >= 0xFE00 && r <= 0xFE0F) || (r >= 0xE0100 && r <= 0xE01EF)
-}
-
-// isRegionalIndicator reports whether r is a regional indicator rune (used for flags)
-func isRegionalIndicator(r rune) bool { return r >= 0x1F1E6 && r <= 0x1F1FF }
-
-// isZWJ reports whether r is ZERO WIDTH JOINER
-func isZWJ(r rune) bool { return r == 0x200D }
-
-// isEmoji reports common emoji ranges that should render as width 2 on most terminals
-func isEmoji(r rune) bool {
-	return isEmojiRange1(r) || isEmojiRange2(r)
-}
-
-// isEmojiRange1 checks the first set of emoji Unicode ranges
-func isEmojiRange1(r rune) bool {
-	return (r >= 0x1F300 && r <= 0x1F5FF) || // Misc Symbols and Pictographs
-		(r >= 0x1F600 && r <= 0x1F64F) || // Emoticons
-		(r >= 0x1F680 && r <= 0x1F6FF) || // Transport and Map Symbols
-		(r >= 0x1F700 && r <= 0x1F77F) || // Alchemical Symbols
-		(r >= 0x1F780 && r <= 0x1F7FF) // Geometric Shapes Extended
-}
-
-// isEmojiRange2 checks the second set of emoji Unicode ranges
-func isEmojiRange2(r rune) bool {
-	return (r >= 0x1F800 && r <= 0x1F8FF) || // Supplemental Arrows-C
-		(r >= 0x1F900 && r <= 0x1F9FF) || // Supplemental Symbols and Pictographs
-		(r >= 0x1FA00 && r <= 0x1FAFF) || // Symbols and Pictographs Extended-A
-		(r >= 0x2600 && r <= 0x26FF) || // Misc symbols
-		(r >= 0x2700 && r <= 0x27BF) // Dingbats
-}
-
-// runeDisplayWidth returns the number of terminal columns used by r
-func runeDisplayWidth(r rune) int {
-	// Zero-width characters
-	if isCombining(r) || isVariationSelector(r) || isZWJ(r) {
-		return 0
-	}
-	// East Asian wide/fullwidth
-	switch width.LookupRune(r).Kind() {
-	case width.EastAsianFullwidth, width.EastAsianWide:
-		return 2
-	}
-	// Common emoji are typically 2 columns
-	if isEmoji(r) {
-		return 2
-	}
-	return 1
-}
-
 // findGraphemeStart finds the start of the grapheme cluster ending at the given position
 func (e *realTimeEditor) findGraphemeStart(pos int) int {
 	start := pos
@@ -79,7 +21,7 @@ func (e *realTimeEditor) findGraphemeStart(pos int) int {
 
 // skipCombiningMarks skips any trailing variation selectors or combining marks
 func (e *realTimeEditor) skipCombiningMarks(start int) int {
-	for start >= 0 && (isCombining((*e.inputRunes)[start]) || isVariationSelector((*e.inputRunes)[start])) {
+	for start >= 0 && (uiutil.IsCombining((*e.inputRunes)[start]) || uiutil.IsVariationSelector((*e.inputRunes)[start])) {
 		start--
 	}
 	return start
@@ -87,8 +29,8 @@ func (e *realTimeEditor) skipCombiningMarks(start int) int {
 
 // handleRegionalIndicators handles regional indicator pairs (flags)
 func (e *realTimeEditor) handleRegionalIndicators(start int) int {
-	if start >= 0 && isRegionalIndicator((*e.inputRunes)[start]) {
-		if start > 0 && isRegionalIndicator((*e.inputRunes)[start-1]) {
+	if start >= 0 && uiutil.IsRegionalIndicator((*e.inputRunes)[start]) {
+		if start > 0 && uiutil.IsRegionalIndicator((*e.inputRunes)[start-1]) {
 			start--
 		}
 	}
@@ -98,7 +40,7 @@ func (e *realTimeEditor) handleRegionalIndicators(start int) int {
 // handleZWJSequences handles ZWJ sequences by including the joiner and previous rune repeatedly
 func (e *realTimeEditor) handleZWJSequences(start int) int {
 	for {
-		if start > 0 && isZWJ((*e.inputRunes)[start-1]) {
+		if start > 0 && uiutil.IsZWJ((*e.inputRunes)[start-1]) {
 			// Include ZWJ and the previous rune
 			start -= 2
 			// Also include any combining marks attached to the new base
@@ -111,7 +53,7 @@ func (e *realTimeEditor) handleZWJSequences(start int) int {
 }
 
 // runeWidth returns the display width of a rune
-func (e *realTimeEditor) runeWidth(r rune) int { return runeDisplayWidth(r) }
+func (e *realTimeEditor) runeWidth(r rune) int { return uiutil.RuneWidth(r) }
 
 // colsBetween calculates the number of display columns between two positions
 func (e *realTimeEditor) colsBetween(from, to int) int {
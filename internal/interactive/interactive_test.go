@@ -14,6 +14,7 @@ import (
 	"golang.org/x/term"
 
 	"github.com/bmf-san/ggc/v8/internal/config"
+	"github.com/bmf-san/ggc/v8/internal/git"
 	kb "github.com/bmf-san/ggc/v8/internal/keybindings"
 	"github.com/bmf-san/ggc/v8/internal/termio"
 	"github.com/bmf-san/ggc/v8/internal/testutil"
@@ -473,8 +474,8 @@ func TestFuzzyMatch(t *testing.T) {
 }
 
 func TestFuzzyMatchScoreContinuationPenalty(t *testing.T) {
-	_, baseScore := fuzzyMatchScore("commit <message>", "commit")
-	_, variantScore := fuzzyMatchScore("commit amend", "commit")
+	_, baseScore, _ := fuzzyMatchScore("commit <message>", "commit")
+	_, variantScore, _ := fuzzyMatchScore("commit amend", "commit")
 
 	if !baseScore.less(variantScore) {
 		t.Errorf("expected base command score %v to be less than variant score %v", baseScore, variantScore)
@@ -482,23 +483,80 @@ func TestFuzzyMatchScoreContinuationPenalty(t *testing.T) {
 }
 
 func TestFuzzyMatchScoreGapPreference(t *testing.T) {
-	_, tight := fuzzyMatchScore("branch", "brn")
-	_, loose := fuzzyMatchScore("branch delete", "brn")
+	_, tight, _ := fuzzyMatchScore("branch", "brn")
+	_, loose, _ := fuzzyMatchScore("branch delete", "brn")
 
 	if !tight.less(loose) {
 		t.Errorf("expected tighter match score %v to be less than loose score %v", tight, loose)
 	}
 }
 
+func TestFuzzyMatchScorePositionsPreferWordBoundary(t *testing.T) {
+	ok, _, positions := fuzzyMatchScore("rebase abort", "ab")
+	if !ok {
+		t.Fatal("expected 'ab' to match 'rebase abort'")
+	}
+	if !slices.Equal(positions, []int{7, 8}) {
+		t.Errorf("expected match positions [7 8], got %v", positions)
+	}
+}
+
+func TestFuzzyMatchScoreBoundaryBonus(t *testing.T) {
+	_, boundary, _ := fuzzyMatchScore("rebase abort", "ab")
+	_, inWord, _ := fuzzyMatchScore("stash branch", "ab")
+
+	if !boundary.less(inWord) {
+		t.Errorf("expected word-boundary score %v to be less than in-word score %v", boundary, inWord)
+	}
+}
+
+func TestUIState_UpdateFiltered_WordBoundaryRanking(t *testing.T) {
+	state := &UIState{
+		input:     "bd",
+		cursorPos: 2,
+		commands: []CommandInfo{
+			{Command: "submodule add", Description: "add submodule"},
+			{Command: "rebase abort --dry-run", Description: "abort rebase"},
+			{Command: "branch delete", Description: "delete a branch"},
+		},
+	}
+
+	state.UpdateFiltered()
+
+	if len(state.filtered) == 0 || state.filtered[0].Command != "branch delete" {
+		t.Fatalf("expected 'branch delete' to rank first, got %v", state.filtered)
+	}
+	if got := state.matchPositions(0); !slices.Equal(got, []int{0, 7}) {
+		t.Errorf("expected match positions [0 7], got %v", got)
+	}
+}
+
+func TestRenderer_RenderCommandItemHighlightsMatches(t *testing.T) {
+	var buf bytes.Buffer
+	colors := NewANSIColors()
+	renderer := &Renderer{writer: &buf, colors: colors, width: 80, height: 24}
+
+	renderer.renderCommandItem(nil, CommandInfo{Command: "branch delete", Description: "Delete"}, []int{0, 7}, 1, 0, 13)
+
+	highlight := colors.BrightYellow + colors.Underline
+	output := buf.String()
+	if !strings.Contains(output, highlight+"b") || !strings.Contains(output, highlight+"d") {
+		t.Errorf("expected matched runes to be highlighted, got %q", output)
+	}
+	if strings.Count(output, highlight) != 2 {
+		t.Errorf("expected exactly two highlighted runs, got %q", output)
+	}
+}
+
 func TestUIState_MoveUp(t *testing.T) {
 	state := &UIState{
 		selected:  2,
 		input:     "",
 		cursorPos: 0,
 		filtered: []CommandInfo{
-			{"cmd1", "desc1"},
-			{"cmd2", "desc2"},
-			{"cmd3", "desc3"},
+			{Command: "cmd1", Description: "desc1"},
+			{Command: "cmd2", Description: "desc2"},
+			{Command: "cmd3", Description: "desc3"},
 		},
 	}
 
@@ -520,9 +578,9 @@ func TestUIState_MoveDown(t *testing.T) {
 		input:     "",
 		cursorPos: 0,
 		filtered: []CommandInfo{
-			{"cmd1", "desc1"},
-			{"cmd2", "desc2"},
-			{"cmd3", "desc3"},
+			{Command: "cmd1", Description: "desc1"},
+			{Command: "cmd2", Description: "desc2"},
+			{Command: "cmd3", Description: "desc3"},
 		},
 	}
 
@@ -771,8 +829,8 @@ func TestUIState_GetSelectedCommand(t *testing.T) {
 		input:     "",
 		cursorPos: 0,
 		filtered: []CommandInfo{
-			{"cmd1", "desc1"},
-			{"cmd2", "desc2"},
+			{Command: "cmd1", Description: "desc1"},
+			{Command: "cmd2", Description: "desc2"},
 		},
 	}
 
@@ -813,9 +871,9 @@ func TestRenderer_UpdateSize(t *testing.T) {
 func TestRenderer_CalculateMaxCommandLength(t *testing.T) {
 	renderer := &Renderer{}
 	commands := []CommandInfo{
-		{"short", "desc"},
-		{"very long command", "desc"},
-		{"medium", "desc"},
+		{Command: "short", Description: "desc"},
+		{Command: "very long command", Description: "desc"},
+		{Command: "medium", Description: "desc"},
 	}
 
 	maxLen := renderer.calculateMaxCommandLength(commands)
@@ -1138,7 +1196,7 @@ func TestRenderer_RenderCommandItem(t *testing.T) {
 
 	// Test selected item
 	buf.Reset()
-	renderer.renderCommandItem(ui, cmd, 0, 0, 20) // index=0, selected=0
+	renderer.renderCommandItem(ui, cmd, nil, 0, 0, 20) // index=0, selected=0
 	output := buf.String()
 	if !strings.Contains(output, "▶") {
 		t.Error("Expected selected item to contain '▶' indicator")
@@ -1146,7 +1204,7 @@ func TestRenderer_RenderCommandItem(t *testing.T) {
 
 	// Test non-selected item
 	buf.Reset()
-	renderer.renderCommandItem(ui, cmd, 1, 0, 20) // index=1, selected=0
+	renderer.renderCommandItem(ui, cmd, nil, 1, 0, 20) // index=1, selected=0
 	output = buf.String()
 	if strings.Contains(output, "▶") {
 		t.Error("Expected non-selected item to NOT contain '▶' indicator")
@@ -2396,6 +2454,8 @@ type mockStatusInfoReader struct {
 	aheadBehindErr    error
 	upstreamName      string
 	upstreamNameErr   error
+	operation         git.Operation
+	submodules        []git.Submodule
 }
 
 func (m *mockStatusInfoReader) GetCurrentBranch() (string, error) {
@@ -2413,6 +2473,12 @@ func (m *mockStatusInfoReader) GetAheadBehindCount(_, _ string) (string, error)
 func (m *mockStatusInfoReader) GetUpstreamBranchName(_ string) (string, error) {
 	return m.upstreamName, m.upstreamNameErr
 }
+func (m *mockStatusInfoReader) OperationInProgress() (git.Operation, error) {
+	return m.operation, nil
+}
+func (m *mockStatusInfoReader) ListSubmodules() ([]git.Submodule, error) {
+	return m.submodules, nil
+}
 
 func TestGetGitBranch_Error(t *testing.T) {
 	mock := &mockStatusInfoReader{currentBranchErr: errors.New("not a repo")}
@@ -2482,3 +2548,97 @@ func TestUIWorkflow_NilExecutorGuard(t *testing.T) {
 		t.Errorf("ExecuteWorkflow with nil executor should return error, got %v", err)
 	}
 }
+
+func TestKeyHandler_ProcessAlias_KeepsMultiwordArgs(t *testing.T) {
+	ui := &UI{
+		stdin:  strings.NewReader("fix the bug\n"),
+		stdout: &bytes.Buffer{},
+		stderr: &bytes.Buffer{},
+		colors: NewANSIColors(),
+		term:   &mockTerminal{shouldFailRaw: true},
+	}
+	handler := &KeyHandler{ui: ui}
+	ui.handler = handler
+
+	args, canceled := handler.processAlias("ship <arg1>")
+	if canceled {
+		t.Fatal("processAlias should not cancel for provided input")
+	}
+	if want := []string{"ggc", "ship", "fix the bug"}; !slices.Equal(args, want) {
+		t.Errorf("processAlias() = %v, want %v", args, want)
+	}
+}
+
+func TestKeyHandler_EnterRunsNamedWorkflow(t *testing.T) {
+	var stdout bytes.Buffer
+	ui := &UI{
+		stdout:      &stdout,
+		stderr:      &bytes.Buffer{},
+		colors:      NewANSIColors(),
+		workflowMgr: NewWorkflowManager(),
+		state: &UIState{
+			input: "sync",
+			commands: []CommandInfo{
+				{Command: "sync", Description: "fetch → pull", Kind: KindWorkflow},
+			},
+		},
+	}
+	ui.workflowMgr.LoadFromConfig(map[string][]string{"sync": {"fetch", "pull"}})
+	router := &mockRouterForExecute{}
+	ui.workflowEx = NewWorkflowExecutor(router, ui)
+	handler := &KeyHandler{ui: ui}
+	ui.handler = handler
+	ui.state.UpdateFiltered()
+
+	cont, args := handler.handleEnter(nil)
+	if cont {
+		t.Fatal("expected Enter on a workflow entry to leave the UI loop")
+	}
+	if want := []string{"ggc", WorkflowExecutedCommand}; !slices.Equal(args, want) {
+		t.Errorf("handleEnter() = %v, want %v", args, want)
+	}
+	if len(router.routedCommands) != 2 {
+		t.Errorf("expected both workflow steps to run, got %v", router.routedCommands)
+	}
+}
+
+func TestKeyHandler_AddToWorkflowRejectsAliases(t *testing.T) {
+	ui := &UI{
+		stdout:      &bytes.Buffer{},
+		colors:      NewANSIColors(),
+		workflowMgr: NewWorkflowManager(),
+		state:       &UIState{input: "st"},
+	}
+	handler := &KeyHandler{ui: ui}
+
+	handler.addSelectedToWorkflow(&CommandInfo{Command: "st", Kind: KindAlias})
+
+	if wf := ui.activeWorkflow(); wf == nil || !wf.IsEmpty() {
+		t.Error("expected alias not to be added to the workflow")
+	}
+	if ui.state.input != "st" {
+		t.Error("expected input to be kept when the entry is rejected")
+	}
+}
+
+func TestRenderer_RenderCommandItemKindMarker(t *testing.T) {
+	var buf bytes.Buffer
+	renderer := &Renderer{writer: &buf, colors: NewANSIColors(), width: 80, height: 24}
+
+	renderer.renderCommandItem(nil, CommandInfo{Command: "st", Description: "status short", Kind: KindAlias}, nil, 1, 0, 2)
+	if !strings.Contains(buf.String(), "[alias]") {
+		t.Errorf("expected alias marker, got %q", buf.String())
+	}
+
+	buf.Reset()
+	renderer.renderCommandItem(nil, CommandInfo{Command: "sync", Description: "fetch → pull", Kind: KindWorkflow}, nil, 0, 0, 4)
+	if !strings.Contains(buf.String(), "[workflow]") {
+		t.Errorf("expected workflow marker, got %q", buf.String())
+	}
+
+	buf.Reset()
+	renderer.renderCommandItem(nil, CommandInfo{Command: "status", Description: "Show status"}, nil, 1, 0, 6)
+	if strings.Contains(buf.String(), "[alias]") || strings.Contains(buf.String(), "[workflow]") {
+		t.Errorf("expected no marker for built-in command, got %q", buf.String())
+	}
+}
@@ -39,6 +39,23 @@ func (h *KeyHandler) GetCurrentKeyMap() *kb.KeyBindingMap {
 func (h *KeyHandler) HandleKey(r rune, _ bool, oldState *term.State, reader *bufio.Reader) (bool, []string) {
 	// Set the reader for consistent access during escape sequence handling
 	h.ui.reader = reader
+	// The help overlay captures every key while it is open
+	if h.ui.state.help != nil {
+		if handled, cont, result := h.handleHelpKeys(r); handled {
+			return cont, result
+		}
+	} else if r == '?' && h.ui.canOpenHelp() {
+		h.ui.openHelp()
+		return true, nil
+	}
+
+	// Keys bound to commands run them from any mode
+	if r >= 1 && r <= 26 {
+		if cmd, ok := h.ui.commandForKey(kb.NewCtrlKeyStroke('a' + r - 1)); ok {
+			return h.executeCommand(cmd, oldState)
+		}
+	}
+
 	// Handle workflow-specific keys first (Tab, etc.)
 	if handled, cont, result := h.handleWorkflowKeys(r, oldState); handled {
 		return cont, result
@@ -0,0 +1,77 @@
+package interactive
+
+import (
+	"golang.org/x/term"
+
+	kb "github.com/bmf-san/ggc/v8/internal/keybindings"
+)
+
+// handleBrowseModeKeys handles keys in the category browser: ←/→ or h/l (and
+// Tab) switch categories, ↑/↓ or j/k move, Enter runs, p pins and soft cancel
+// returns to search.
+func (h *KeyHandler) handleBrowseModeKeys(r rune, oldState *term.State) (bool, bool, []string) {
+	b := h.ui.state.browser
+	if b == nil {
+		h.ui.closeBrowser()
+		return false, true, nil
+	}
+	if r == 3 { // Ctrl+C falls through to the quit handler
+		return false, true, nil
+	}
+	b.notice = ""
+
+	switch r {
+	case 13:
+		if cmd, ok := b.selectedCommand(); ok {
+			cont, result := h.executeCommand(cmd, oldState)
+			return true, cont, result
+		}
+	case 27:
+		if h.shouldHandleEscapeAsSoftCancel() {
+			h.ui.closeBrowser()
+		} else if final, _, ok := h.readEscapeFinal(); ok {
+			h.handleBrowseArrow(b, final)
+		}
+	case 9, 'l':
+		b.switchCategory(1)
+	case 'h':
+		b.switchCategory(-1)
+	case 'j':
+		b.move(1)
+	case 'k':
+		b.move(-1)
+	case 'p':
+		h.ui.togglePin()
+	default:
+		if r >= 1 && r <= 26 {
+			h.handleBrowseCtrlKey(b, r)
+		}
+	}
+	return true, true, nil
+}
+
+func (h *KeyHandler) handleBrowseArrow(b *categoryBrowser, final byte) {
+	switch final {
+	case 'A':
+		b.move(-1)
+	case 'B':
+		b.move(1)
+	case 'C':
+		b.switchCategory(1)
+	case 'D':
+		b.switchCategory(-1)
+	}
+}
+
+func (h *KeyHandler) handleBrowseCtrlKey(b *categoryBrowser, r rune) {
+	km := h.GetCurrentKeyMap()
+	stroke := kb.NewCtrlKeyStroke('a' + r - 1)
+	switch {
+	case km.MatchesKeyStroke("toggle_category_view", stroke), km.MatchesKeyStroke("soft_cancel", stroke):
+		h.ui.closeBrowser()
+	case km.MatchesKeyStroke("move_up", stroke):
+		b.move(-1)
+	case km.MatchesKeyStroke("move_down", stroke):
+		b.move(1)
+	}
+}
@@ -125,6 +125,12 @@ func (h *KeyHandler) handleSearchModeKeys(km *kb.KeyBindingMap, stroke kb.KeyStr
 	case km.MatchesKeyStroke("toggle_workflow_view", stroke) && h.ui.state.input == "":
 		h.ui.ToggleWorkflowView()
 		return true
+	case km.MatchesKeyStroke("toggle_preview", stroke):
+		h.ui.togglePreview()
+		return true
+	case km.MatchesKeyStroke("toggle_category_view", stroke):
+		h.ui.toggleCategoryView()
+		return true
 	case km.MatchesKeyStroke("soft_cancel", stroke):
 		h.handleSoftCancel(oldState)
 		return true
@@ -149,16 +155,12 @@ func (h *KeyHandler) handleSpecialCtrlChars(b byte, oldState *term.State, reader
 			h.handleSoftCancel(oldState)
 			return true, true, nil
 		}
-		h.handleEscapeSequence(reader)
+		if cmd, ok := h.handleEscapeSequence(reader); ok {
+			shouldContinue, result := h.executeCommand(cmd, oldState)
+			return true, shouldContinue, result
+		}
 		return true, true, nil
 	default:
 		return false, true, nil
 	}
 }
-
-// handleEscapeSequence parses common ESC sequences for arrow and word navigation.
-// Supports:
-// - Arrow keys: ESC [ C/D (right/left), ESC O C/D (application mode)
-// - Ctrl+Arrow: ESC [ 1;5 C/D or ESC [ 5 C/D
-// - Alt/Option+Arrow: ESC [ 1;3 C/D, ESC [ 1;9 C/D (varies by terminal)
-// - macOS Option word nav: ESC b / ESC f
@@ -42,6 +42,10 @@ func (h *KeyHandler) processCSIFinalByte(final byte, params string) {
 	keyStroke := kb.NewRawKeyStroke(seq)
 	km := h.GetCurrentKeyMap()
 
+	if h.ui.state.IsOutputMode() && h.handleOutputPageKey(final, params) {
+		return
+	}
+
 	// Try keybinding-based handling first
 	if h.tryArrowKeybinding(km, keyStroke) {
 		return
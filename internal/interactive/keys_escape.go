@@ -10,9 +10,18 @@ import (
 	"github.com/bmf-san/ggc/v8/internal/termio"
 )
 
-func (h *KeyHandler) handleEscapeSequence(reader *bufio.Reader) {
+// handleEscapeSequence parses common ESC sequences for arrow and word navigation.
+// Supports:
+// - Arrow keys: ESC [ C/D (right/left), ESC O C/D (application mode)
+// - Ctrl+Arrow: ESC [ 1;5 C/D or ESC [ 5 C/D
+// - Alt/Option+Arrow: ESC [ 1;3 C/D, ESC [ 1;9 C/D (varies by terminal)
+// - macOS Option word nav: ESC b / ESC f
+//
+// When the sequence is an Alt+<letter> key bound to a command, that command
+// is returned for the caller to run instead.
+func (h *KeyHandler) handleEscapeSequence(reader *bufio.Reader) (CommandInfo, bool) {
 	if h.ui == nil {
-		return
+		return CommandInfo{}, false
 	}
 
 	// Read next byte after ESC
@@ -30,7 +39,13 @@ func (h *KeyHandler) handleEscapeSequence(reader *bufio.Reader) {
 	}
 
 	if err != nil {
-		return
+		return CommandInfo{}, false
+	}
+
+	if b >= 'a' && b <= 'z' {
+		if cmd, ok := h.ui.commandForKey(kb.NewAltKeyStroke(rune(b), "")); ok {
+			return cmd, true
+		}
 	}
 
 	switch b {
@@ -46,6 +61,7 @@ func (h *KeyHandler) handleEscapeSequence(reader *bufio.Reader) {
 		// Meta-Backspace (Option+Backspace): delete word left
 		h.ui.state.DeleteWord()
 	}
+	return CommandInfo{}, false
 }
 
 func (h *KeyHandler) handleSoftCancel(_ *term.State) {
@@ -0,0 +1,103 @@
+package interactive
+
+import (
+	"unicode"
+
+	kb "github.com/bmf-san/ggc/v8/internal/keybindings"
+)
+
+// handleHelpKeys handles keys while the help overlay is open. Typing filters
+// the list; soft cancel, Enter, or '?' on an empty filter close it.
+func (h *KeyHandler) handleHelpKeys(r rune) (bool, bool, []string) {
+	help := h.ui.state.help
+	switch {
+	case r == 3: // Ctrl+C falls through to the quit handler
+		return false, true, nil
+	case r == 13:
+		h.ui.closeHelp()
+	case r == 27:
+		if h.shouldHandleEscapeAsSoftCancel() {
+			h.ui.closeHelp()
+		} else {
+			h.handleHelpEscapeSequence()
+		}
+	case r == 127 || r == 8:
+		if runes := []rune(help.query); len(runes) > 0 {
+			help.query = string(runes[:len(runes)-1])
+			help.offset = 0
+		}
+	case r >= 1 && r <= 26:
+		h.handleHelpCtrlKey(help, r)
+	case r == '?' && help.query == "":
+		h.ui.closeHelp()
+	case unicode.IsPrint(r):
+		help.query += string(r)
+		help.offset = 0
+	}
+	return true, true, nil
+}
+
+func (h *KeyHandler) handleHelpCtrlKey(help *helpOverlay, r rune) {
+	km := h.GetCurrentKeyMap()
+	stroke := kb.NewCtrlKeyStroke('a' + r - 1)
+	switch {
+	case km.MatchesKeyStroke("soft_cancel", stroke):
+		h.ui.closeHelp()
+	case km.MatchesKeyStroke("move_up", stroke):
+		help.scroll(-1, h.helpRowCount())
+	case km.MatchesKeyStroke("move_down", stroke):
+		help.scroll(1, h.helpRowCount())
+	case km.MatchesKeyStroke("clear_line", stroke):
+		help.query = ""
+		help.offset = 0
+	}
+}
+
+// handleHelpEscapeSequence reads an escape sequence and applies it to the
+// overlay, so arrow keys scroll the help instead of the view underneath.
+func (h *KeyHandler) handleHelpEscapeSequence() {
+	if final, params, ok := h.readEscapeFinal(); ok {
+		h.handleHelpCSIKey(final, params)
+	}
+}
+
+// readEscapeFinal reads the rest of a CSI (ESC [) or SS3 (ESC O) sequence
+// after its ESC and returns the final byte and parameters. Views with their
+// own navigation use it so that keys never reach the search input.
+func (h *KeyHandler) readEscapeFinal() (byte, string, bool) {
+	b, err := h.readNextByte(h.ui.reader)
+	if err != nil || (b != '[' && b != 'O') {
+		return 0, "", false
+	}
+	var params []byte
+	for {
+		nb, err := h.readNextByte(h.ui.reader)
+		if err != nil {
+			return 0, "", false
+		}
+		if (nb >= 'A' && nb <= 'Z') || nb == '~' {
+			return nb, string(params), true
+		}
+		params = append(params, nb)
+	}
+}
+
+// handleHelpCSIKey scrolls the help overlay with the arrow and paging keys.
+func (h *KeyHandler) handleHelpCSIKey(final byte, params string) {
+	help := h.ui.state.help
+	rows := h.helpRowCount()
+	switch {
+	case final == 'A':
+		help.scroll(-1, rows)
+	case final == 'B':
+		help.scroll(1, rows)
+	case final == '~' && params == "5":
+		help.scroll(-max(help.pageSize-1, 1), rows)
+	case final == '~' && params == "6":
+		help.scroll(max(help.pageSize-1, 1), rows)
+	}
+}
+
+func (h *KeyHandler) helpRowCount() int {
+	return len(helpRows(filterHelpEntries(h.ui.helpEntries(), h.ui.state.help.query)))
+}
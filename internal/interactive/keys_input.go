@@ -9,6 +9,8 @@ import (
 	"unicode"
 
 	"golang.org/x/term"
+
+	uiutil "github.com/bmf-san/ggc/v8/internal/ui"
 )
 
 func (h *KeyHandler) readNextByte(reader *bufio.Reader) (byte, error) {
@@ -60,6 +62,12 @@ func (h *KeyHandler) reenterRawMode(oldState *term.State) {
 // handleEnter handles Enter key press
 func (h *KeyHandler) handleEnter(oldState *term.State) (bool, []string) {
 	if !h.ui.state.HasInput() {
+		if h.ui.state.IsSuggestionSelected() {
+			return h.executeCommand(*h.ui.state.GetSelectedCommand(), oldState)
+		}
+		if h.ui.state.IsRecentView() {
+			return h.rerunRecent(oldState)
+		}
 		return true, nil
 	}
 
@@ -67,28 +75,69 @@ func (h *KeyHandler) handleEnter(oldState *term.State) (bool, []string) {
 	if selectedCmd == nil {
 		return true, nil
 	}
+	return h.executeCommand(*selectedCmd, oldState)
+}
+
+// executeCommand runs selectedCmd, first prompting for any placeholders.
+func (h *KeyHandler) executeCommand(selectedCmd CommandInfo, oldState *term.State) (bool, []string) {
+	if selectedCmd.Kind == KindWorkflow {
+		return h.runNamedWorkflow(selectedCmd.Command, oldState)
+	}
 
 	// Restore terminal state BEFORE showing Execute message
 	h.restoreTerminalState(oldState)
 
 	// Clear screen and show execution message
 	clearScreen(h.ui.stdout)
-	executeMsg := fmt.Sprintf("%s🚀 %sExecuting:%s %s%s%s\n\n",
-		h.ui.colors.BrightGreen,
-		h.ui.colors.BrightWhite+h.ui.colors.Bold,
+	executeMsg := fmt.Sprintf("%s%s%sExecuting:%s %s%s%s\n\n",
+		h.ui.th().Accent,
+		h.ui.sym().Launch,
+		h.ui.th().Emphasis,
 		h.ui.colors.Reset,
-		h.ui.colors.BrightCyan+h.ui.colors.Bold,
+		h.ui.th().Command,
 		selectedCmd.Command,
 		h.ui.colors.Reset)
 	h.ui.writeColor(executeMsg)
 
 	// Handle placeholders
-	args, canceled := h.processCommand(selectedCmd.Command)
+	var args []string
+	var canceled bool
+	if selectedCmd.Kind == KindAlias {
+		args, canceled = h.processAlias(selectedCmd.Command)
+	} else {
+		args, canceled = h.processCommand(selectedCmd.Command)
+	}
 	if canceled {
 		// Re-enter raw mode before returning to main loop
 		h.reenterRawMode(oldState)
 		return true, nil
 	}
+	h.ui.recordHistory(selectedCmd.Command, args)
+	return false, args
+}
+
+// rerunRecent executes the full command line selected in the recent view
+// without prompting for placeholders again.
+func (h *KeyHandler) rerunRecent(oldState *term.State) (bool, []string) {
+	selected := h.ui.state.GetSelectedCommand()
+	if selected == nil {
+		return true, nil
+	}
+	line := selected.Command
+
+	h.restoreTerminalState(oldState)
+	clearScreen(h.ui.stdout)
+	h.ui.writeColor(fmt.Sprintf("%s%s%sRe-running:%s %s%s%s\n\n",
+		h.ui.th().Accent,
+		h.ui.sym().Rerun,
+		h.ui.th().Emphasis,
+		h.ui.colors.Reset,
+		h.ui.th().Command,
+		line,
+		h.ui.colors.Reset))
+
+	args := append([]string{"ggc"}, h.ui.state.history.argsFor(line)...)
+	h.ui.recordHistory(h.ui.state.history.templateFor(line), args)
 	return false, args
 }
 
@@ -121,6 +170,56 @@ func (h *KeyHandler) processCommand(cmdTemplate string) ([]string, bool) {
 	return args, false
 }
 
+// processAlias prompts for the alias's positional arguments and returns them
+// as separate args so that multi-word values reach the alias unsplit.
+func (h *KeyHandler) processAlias(cmdTemplate string) ([]string, bool) {
+	parts := strings.Fields(cmdTemplate)
+	if len(parts) == 0 {
+		return nil, true
+	}
+	args := []string{"ggc", parts[0]}
+
+	placeholders := extractPlaceholders(cmdTemplate)
+	if len(placeholders) == 0 {
+		return args, false
+	}
+
+	inputs, canceled := h.interactiveInput(placeholders)
+	if canceled {
+		h.handleSoftCancel(nil)
+		return nil, true
+	}
+	for _, ph := range placeholders {
+		args = append(args, inputs[ph])
+	}
+	return args, false
+}
+
+// runNamedWorkflow executes the configured workflow called name from search mode.
+func (h *KeyHandler) runNamedWorkflow(name string, oldState *term.State) (bool, []string) {
+	if h.ui.workflowEx == nil || h.ui.workflowMgr == nil {
+		return true, nil
+	}
+	wf, ok := h.ui.workflowMgr.FindByName(name)
+	if !ok {
+		return true, nil
+	}
+
+	h.restoreTerminalState(oldState)
+	clearScreen(h.ui.stdout)
+
+	err := h.ui.workflowEx.Execute(wf)
+	if errors.Is(err, ErrWorkflowCanceled) {
+		h.handleSoftCancel(oldState)
+		h.reenterRawMode(oldState)
+		return true, nil
+	}
+	if err != nil {
+		h.ui.writeError("Workflow execution failed: %v", err)
+	}
+	return false, []string{"ggc", WorkflowExecutedCommand}
+}
+
 // interactiveInput provides real-time interactive input for placeholders
 func (h *KeyHandler) interactiveInput(placeholders []string) (map[string]string, bool) {
 	inputs := make(map[string]string)
@@ -131,14 +230,14 @@ func (h *KeyHandler) interactiveInput(placeholders []string) (map[string]string,
 		// Show progress and prompt
 		if len(placeholders) > 1 {
 			h.ui.write("%s[%d/%d]%s ",
-				h.ui.colors.BrightBlue+h.ui.colors.Bold,
+				h.ui.th().Accent,
 				i+1, len(placeholders),
 				h.ui.colors.Reset)
 		}
 
 		h.ui.write("%s? %s%s%s: ",
-			h.ui.colors.BrightGreen,
-			h.ui.colors.BrightWhite+h.ui.colors.Bold,
+			h.ui.th().Prompt,
+			h.ui.th().Emphasis,
 			ph,
 			h.ui.colors.Reset)
 
@@ -150,11 +249,12 @@ func (h *KeyHandler) interactiveInput(placeholders []string) (map[string]string,
 		inputs[ph] = value
 
 		// Show confirmation
-		h.ui.write("%s✓ %s%s: %s%s%s\n",
-			h.ui.colors.BrightGreen,
-			h.ui.colors.BrightBlue,
+		h.ui.write("%s%s%s%s: %s%s%s\n",
+			h.ui.th().Success,
+			h.ui.sym().Success,
+			h.ui.th().Accent,
 			ph,
-			h.ui.colors.BrightYellow+h.ui.colors.Bold,
+			h.ui.th().Input,
 			value,
 			h.ui.colors.Reset)
 	}
@@ -214,7 +314,7 @@ func (h *KeyHandler) handleInputChar(input *strings.Builder, char rune) (done bo
 			h.ui.write("\r\n")
 			return true, false
 		}
-		h.ui.write(" %s(required)%s", h.ui.colors.BrightRed, h.ui.colors.Reset)
+		h.ui.write(" %s(required)%s", h.ui.th().Error, h.ui.colors.Reset)
 		return false, false
 	case '\b', 127:
 		if input.Len() == 0 {
@@ -227,18 +327,18 @@ func (h *KeyHandler) handleInputChar(input *strings.Builder, char rune) (done bo
 		// Identify start of previous grapheme-like cluster
 		end := len(runes)
 		start := end - 1
-		for start >= 0 && (isCombining(runes[start]) || isVariationSelector(runes[start])) {
+		for start >= 0 && (uiutil.IsCombining(runes[start]) || uiutil.IsVariationSelector(runes[start])) {
 			start--
 		}
-		if start >= 0 && isRegionalIndicator(runes[start]) {
-			if start > 0 && isRegionalIndicator(runes[start-1]) {
+		if start >= 0 && uiutil.IsRegionalIndicator(runes[start]) {
+			if start > 0 && uiutil.IsRegionalIndicator(runes[start-1]) {
 				start--
 			}
 		}
 		for {
-			if start > 0 && isZWJ(runes[start-1]) {
+			if start > 0 && uiutil.IsZWJ(runes[start-1]) {
 				start -= 2
-				for start >= 0 && (isCombining(runes[start]) || isVariationSelector(runes[start])) {
+				for start >= 0 && (uiutil.IsCombining(runes[start]) || uiutil.IsVariationSelector(runes[start])) {
 					start--
 				}
 				continue
@@ -251,7 +351,7 @@ func (h *KeyHandler) handleInputChar(input *strings.Builder, char rune) (done bo
 		// Calculate total columns to clear
 		cols := 0
 		for i := start; i < end; i++ {
-			cols += runeDisplayWidth(runes[i])
+			cols += uiutil.RuneWidth(runes[i])
 		}
 		// Update input
 		input.Reset()
@@ -262,7 +362,7 @@ func (h *KeyHandler) handleInputChar(input *strings.Builder, char rune) (done bo
 		}
 		return false, false
 	case 3: // Ctrl+C
-		h.ui.write("\r\n%sOperation canceled%s\r\n", h.ui.colors.BrightRed, h.ui.colors.Reset)
+		h.ui.write("\r\n%sOperation canceled%s\r\n", h.ui.th().Error, h.ui.colors.Reset)
 		return true, true
 	default:
 		// Accept all printable characters including multibyte
@@ -287,7 +387,7 @@ func (h *KeyHandler) getLineInput() (string, bool) {
 			return line, false
 		}
 		h.ui.write("%s(required)%s ",
-			h.ui.colors.BrightRed,
+			h.ui.th().Error,
 			h.ui.colors.Reset)
 	}
 }
@@ -10,6 +10,8 @@ func (h *KeyHandler) handleMoveUp() {
 	switch h.ui.state.mode {
 	case ModeWorkflow:
 		h.moveWorkflowList(-1)
+	case ModeOutput:
+		h.ui.state.output.scroll(-1)
 	default:
 		h.ui.state.MoveUp()
 	}
@@ -19,6 +21,8 @@ func (h *KeyHandler) handleMoveDown() {
 	switch h.ui.state.mode {
 	case ModeWorkflow:
 		h.moveWorkflowList(1)
+	case ModeOutput:
+		h.ui.state.output.scroll(1)
 	default:
 		h.ui.state.MoveDown()
 	}
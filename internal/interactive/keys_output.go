@@ -0,0 +1,141 @@
+package interactive
+
+import (
+	"encoding/base64"
+	"fmt"
+	"unicode"
+
+	"golang.org/x/term"
+
+	kb "github.com/bmf-san/ggc/v8/internal/keybindings"
+	uiutil "github.com/bmf-san/ggc/v8/internal/ui"
+)
+
+// handleOutputModeKeys handles keys while the output pane is shown. Enter, q
+// and Esc return to the search list; Ctrl+C still quits.
+func (h *KeyHandler) handleOutputModeKeys(r rune, _ *term.State) (bool, bool, []string) {
+	view := h.ui.state.output
+	if view == nil {
+		h.ui.closeOutput()
+		return false, true, nil
+	}
+	if r == 3 { // Ctrl+C falls through to the quit handler
+		return false, true, nil
+	}
+	view.notice = ""
+
+	if view.searching {
+		return h.handleOutputSearchKey(view, r)
+	}
+
+	switch r {
+	case 13, 'q':
+		h.ui.closeOutput()
+		return true, true, nil
+	case 27:
+		if h.shouldHandleEscapeAsSoftCancel() {
+			h.ui.closeOutput()
+			return true, true, nil
+		}
+		// Let arrow and paging escape sequences reach the CSI handler.
+		return false, true, nil
+	}
+
+	if r >= 1 && r <= 26 {
+		h.handleOutputCtrlKey(view, r)
+		return true, true, nil
+	}
+
+	switch r {
+	case 'j':
+		view.scroll(1)
+	case 'k':
+		view.scroll(-1)
+	case ' ', 'f':
+		view.page(1)
+	case 'b':
+		view.page(-1)
+	case 'g':
+		view.scrollToTop()
+	case 'G':
+		view.scrollToBottom()
+	case '/':
+		view.searching = true
+		view.query = ""
+		view.matches = nil
+	case 'n':
+		view.nextMatch(1)
+	case 'N':
+		view.nextMatch(-1)
+	case 'y':
+		h.copyOutput(view)
+	}
+	return true, true, nil
+}
+
+func (h *KeyHandler) handleOutputCtrlKey(view *outputView, r rune) {
+	km := h.GetCurrentKeyMap()
+	stroke := kb.NewCtrlKeyStroke('a' + r - 1)
+	switch {
+	case km.MatchesKeyStroke("move_up", stroke):
+		view.scroll(-1)
+	case km.MatchesKeyStroke("move_down", stroke):
+		view.scroll(1)
+	case km.MatchesKeyStroke("soft_cancel", stroke):
+		h.ui.closeOutput()
+	}
+}
+
+// handleOutputSearchKey edits the output search query.
+func (h *KeyHandler) handleOutputSearchKey(view *outputView, r rune) (bool, bool, []string) {
+	switch r {
+	case 13:
+		view.searching = false
+		view.search(view.query)
+	case 27:
+		if !h.shouldHandleEscapeAsSoftCancel() {
+			h.handleEscapeSequence(h.ui.reader)
+			return true, true, nil
+		}
+		view.searching = false
+		view.search("")
+	case 127, 8:
+		if runes := []rune(view.query); len(runes) > 0 {
+			view.query = string(runes[:len(runes)-1])
+		}
+	default:
+		if unicode.IsPrint(r) {
+			view.query += string(r)
+		}
+	}
+	return true, true, nil
+}
+
+// handleOutputPageKey handles PgUp/PgDn/Home/End in the output pane.
+func (h *KeyHandler) handleOutputPageKey(final byte, params string) bool {
+	view := h.ui.state.output
+	if view == nil {
+		return false
+	}
+	switch {
+	case final == '~' && params == "5":
+		view.page(-1)
+	case final == '~' && params == "6":
+		view.page(1)
+	case final == 'H' || (final == '~' && params == "1"):
+		view.scrollToTop()
+	case final == 'F' || (final == '~' && params == "4"):
+		view.scrollToBottom()
+	default:
+		return false
+	}
+	return true
+}
+
+// copyOutput places the output on the system clipboard with the OSC 52
+// escape sequence, which most terminal emulators honor, including over SSH.
+func (h *KeyHandler) copyOutput(view *outputView) {
+	encoded := base64.StdEncoding.EncodeToString([]byte(view.text()))
+	_, _ = fmt.Fprintf(h.ui.stdout, "\x1b]52;c;%s\a", encoded)
+	view.notice = fmt.Sprintf("Copied %d line%s to the clipboard", len(view.plain), uiutil.Pluralize(len(view.plain)))
+}
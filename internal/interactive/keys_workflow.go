@@ -17,6 +17,10 @@ func (h *KeyHandler) handleWorkflowKeys(r rune, oldState *term.State) (bool, boo
 		return h.handleWorkflowModeKeys(r, oldState)
 	case ModeSearch:
 		return h.handleSearchModeWorkflowKeys(r)
+	case ModeOutput:
+		return h.handleOutputModeKeys(r, oldState)
+	case ModeBrowse:
+		return h.handleBrowseModeKeys(r, oldState)
 	default:
 		return false, true, nil
 	}
@@ -29,8 +33,7 @@ func (h *KeyHandler) handleSearchModeWorkflowKeys(r rune) (bool, bool, []string)
 	if km.MatchesKeyStroke("add_to_workflow", keyStroke) {
 		if h.ui.state.HasInput() {
 			if cmd := h.ui.state.GetSelectedCommand(); cmd != nil {
-				h.addCommandToWorkflow(cmd.Command)
-				h.ui.state.ClearInput()
+				h.addSelectedToWorkflow(cmd)
 			}
 		}
 		return true, true, nil
@@ -84,12 +87,24 @@ func (h *KeyHandler) handleWorkflowAdd(keyStroke kb.KeyStroke) bool {
 		return false
 	}
 	if cmd := h.ui.state.GetSelectedCommand(); cmd != nil {
-		h.addCommandToWorkflow(cmd.Command)
-		h.ui.state.ClearInput()
+		h.addSelectedToWorkflow(cmd)
 	}
 	return true
 }
 
+// addSelectedToWorkflow adds a built-in command to the active workflow.
+// Workflow steps are routed as plain commands, so aliases and workflows
+// cannot be nested inside them.
+func (h *KeyHandler) addSelectedToWorkflow(cmd *CommandInfo) {
+	if cmd.Kind != KindCommand {
+		h.ui.write("\n%sOnly built-in commands can be added to a workflow%s\n",
+			h.ui.th().Warning, h.ui.colors.Reset)
+		return
+	}
+	h.addCommandToWorkflow(cmd.Command)
+	h.ui.state.ClearInput()
+}
+
 func (h *KeyHandler) handleWorkflowClear(keyStroke kb.KeyStroke) bool {
 	if h.ui.state.IsInputFocused() {
 		return false
@@ -132,7 +147,7 @@ func (h *KeyHandler) createWorkflow() {
 			break
 		}
 	}
-	h.ui.write("%s✨ Created workflow #%d%s\n", h.ui.colors.BrightGreen, newID, h.ui.colors.Reset)
+	h.ui.write("%s%sCreated workflow #%d%s\n", h.ui.th().Success, h.ui.sym().Create, newID, h.ui.colors.Reset)
 }
 
 func (h *KeyHandler) deleteActiveWorkflow() {
@@ -141,12 +156,12 @@ func (h *KeyHandler) deleteActiveWorkflow() {
 	}
 	activeID := h.ui.workflowMgr.GetActiveID()
 	if activeID == 0 {
-		h.ui.write("%sNo active workflow to delete%s\n", h.ui.colors.BrightYellow, h.ui.colors.Reset)
+		h.ui.write("%sNo active workflow to delete%s\n", h.ui.th().Warning, h.ui.colors.Reset)
 		return
 	}
 	newActive, ok := h.ui.workflowMgr.DeleteWorkflow(activeID)
 	if !ok {
-		h.ui.write("%sUnable to delete workflow #%d%s\n", h.ui.colors.BrightYellow, activeID, h.ui.colors.Reset)
+		h.ui.write("%sUnable to delete workflow #%d%s\n", h.ui.th().Warning, activeID, h.ui.colors.Reset)
 		return
 	}
 	summaries := h.ui.listWorkflows()
@@ -160,7 +175,7 @@ func (h *KeyHandler) deleteActiveWorkflow() {
 			}
 		}
 	}
-	h.ui.write("%s🗑  Deleted workflow #%d%s\n", h.ui.colors.BrightYellow, activeID, h.ui.colors.Reset)
+	h.ui.write("%s%sDeleted workflow #%d%s\n", h.ui.th().Warning, h.ui.sym().Delete, activeID, h.ui.colors.Reset)
 }
 
 // readNextByte reads the next byte from either a buffered reader or stdin
@@ -183,25 +198,25 @@ func (h *KeyHandler) addCommandToWorkflow(cmdTemplate string) {
 	// Show success message
 	placeholders := extractPlaceholders(cmdTemplate)
 	if len(placeholders) > 0 {
-		h.ui.write("\n%s🎯 Added to workflow!%s\n",
-			h.ui.colors.BrightGreen+h.ui.colors.Bold, h.ui.colors.Reset)
+		h.ui.write("\n%s%sAdded to workflow!%s\n",
+			h.ui.th().Success, h.ui.sym().Added, h.ui.colors.Reset)
 		h.ui.write("%s  Step %d: %s%s%s %s(will prompt for: %v)%s\n",
-			h.ui.colors.BrightCyan, id, h.ui.colors.BrightWhite+h.ui.colors.Bold, cmdTemplate, h.ui.colors.Reset,
-			h.ui.colors.BrightYellow, placeholders, h.ui.colors.Reset)
+			h.ui.th().Accent, id, h.ui.th().Emphasis, cmdTemplate, h.ui.colors.Reset,
+			h.ui.th().Warning, placeholders, h.ui.colors.Reset)
 	} else {
-		h.ui.write("\n%s🎯 Added to workflow!%s\n",
-			h.ui.colors.BrightGreen+h.ui.colors.Bold, h.ui.colors.Reset)
+		h.ui.write("\n%s%sAdded to workflow!%s\n",
+			h.ui.th().Success, h.ui.sym().Added, h.ui.colors.Reset)
 		h.ui.write("%s  Step %d: %s%s%s\n",
-			h.ui.colors.BrightCyan, id, h.ui.colors.BrightWhite+h.ui.colors.Bold, cmdTemplate, h.ui.colors.Reset)
+			h.ui.th().Accent, id, h.ui.th().Emphasis, cmdTemplate, h.ui.colors.Reset)
 	}
 	h.ui.write("%s  Press 'Ctrl+t' to view workflow, or continue adding more commands%s\n\n",
-		h.ui.colors.BrightBlack, h.ui.colors.Reset)
+		h.ui.th().Muted, h.ui.colors.Reset)
 }
 
 // clearWorkflow clears all steps from workflow
 func (h *KeyHandler) clearWorkflow() {
 	h.ui.ClearWorkflow()
-	h.ui.write("%s🧹 Workflow cleared%s\n", h.ui.colors.BrightYellow, h.ui.colors.Reset)
+	h.ui.write("%s%sWorkflow cleared%s\n", h.ui.th().Warning, h.ui.sym().Clear, h.ui.colors.Reset)
 }
 
 // executeWorkflow executes the current workflow
@@ -0,0 +1,161 @@
+package interactive
+
+import (
+	"strings"
+
+	uiutil "github.com/bmf-san/ggc/v8/internal/ui"
+)
+
+// defaultOutputPageSize is used until the renderer reports the real viewport height.
+const defaultOutputPageSize = 10
+
+// CommandOutput is the captured result of a command run from interactive mode.
+type CommandOutput struct {
+	Command string
+	Output  string
+	Err     error
+}
+
+// outputView is the scrollable pane that shows the last command's output.
+type outputView struct {
+	result    CommandOutput
+	lines     []string // output lines as written, including ANSI colors
+	plain     []string // lines with ANSI sequences stripped, for search and copy
+	offset    int
+	pageSize  int
+	query     string
+	searching bool // the search query is being typed
+	matches   []int
+	matchIdx  int
+	notice    string
+}
+
+func newOutputView(result CommandOutput) *outputView {
+	text := strings.ReplaceAll(result.Output, "\r\n", "\n")
+	text = strings.TrimRight(text, "\n")
+	var lines []string
+	if strings.TrimSpace(uiutil.StripANSI(text)) != "" {
+		lines = strings.Split(text, "\n")
+	}
+	plain := make([]string, len(lines))
+	for i, line := range lines {
+		lines[i] = strings.TrimRight(line, "\r")
+		plain[i] = uiutil.StripANSI(lines[i])
+	}
+	return &outputView{
+		result:   result,
+		lines:    lines,
+		plain:    plain,
+		pageSize: defaultOutputPageSize,
+	}
+}
+
+func (v *outputView) maxOffset() int {
+	return max(len(v.lines)-v.pageSize, 0)
+}
+
+// setPageSize updates the number of visible lines, keeping the offset in range.
+func (v *outputView) setPageSize(rows int) {
+	v.pageSize = max(rows, 1)
+	v.scroll(0)
+}
+
+func (v *outputView) scroll(delta int) {
+	v.offset = min(max(v.offset+delta, 0), v.maxOffset())
+}
+
+func (v *outputView) page(direction int) {
+	v.scroll(direction * max(v.pageSize-1, 1))
+}
+
+func (v *outputView) scrollToTop() {
+	v.offset = 0
+}
+
+func (v *outputView) scrollToBottom() {
+	v.offset = v.maxOffset()
+}
+
+// search finds the lines containing query, case-insensitively, and scrolls to
+// the first match at or below the current position.
+func (v *outputView) search(query string) {
+	v.query = query
+	v.matches = nil
+	v.matchIdx = 0
+	if query == "" {
+		return
+	}
+	needle := strings.ToLower(query)
+	for i, line := range v.plain {
+		if strings.Contains(strings.ToLower(line), needle) {
+			v.matches = append(v.matches, i)
+		}
+	}
+	if len(v.matches) == 0 {
+		v.notice = "Pattern not found: " + query
+		return
+	}
+	for i, line := range v.matches {
+		if line >= v.offset {
+			v.matchIdx = i
+			break
+		}
+	}
+	v.reveal(v.matches[v.matchIdx])
+}
+
+// nextMatch moves to the next (direction 1) or previous (-1) match, wrapping around.
+func (v *outputView) nextMatch(direction int) {
+	if len(v.matches) == 0 {
+		return
+	}
+	v.matchIdx = (v.matchIdx + direction + len(v.matches)) % len(v.matches)
+	v.reveal(v.matches[v.matchIdx])
+}
+
+// reveal scrolls so that line is visible.
+func (v *outputView) reveal(line int) {
+	if line >= v.offset && line < v.offset+v.pageSize {
+		return
+	}
+	v.offset = line - v.pageSize/3
+	v.scroll(0)
+}
+
+// isMatch reports whether line contains the active search query.
+func (v *outputView) isMatch(line int) bool {
+	for _, m := range v.matches {
+		if m == line {
+			return true
+		}
+	}
+	return false
+}
+
+// text returns the output without escape sequences.
+func (v *outputView) text() string {
+	return strings.Join(v.plain, "\n")
+}
+
+// ShowOutput displays a command's captured output in the scrollable output
+// pane. Any key that leaves the pane returns to a fresh search.
+func (ui *UI) ShowOutput(result CommandOutput) {
+	if ui == nil || ui.state == nil {
+		return
+	}
+	ui.resetToSearchMode()
+	if ui.gitClient != nil {
+		ui.refreshGitStatus()
+	}
+	ui.state.output = newOutputView(result)
+	ui.state.SetMode(ModeOutput)
+}
+
+// closeOutput leaves the output pane and returns to the search list.
+func (ui *UI) closeOutput() {
+	if ui == nil || ui.state == nil {
+		return
+	}
+	ui.state.output = nil
+	ui.enterSearchMode()
+}
@@ -0,0 +1,137 @@
+package interactive
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func newOutputTestUI(result CommandOutput) (*UI, *KeyHandler, *bytes.Buffer) {
+	var stdout bytes.Buffer
+	colors := NewANSIColors()
+	ui := &UI{
+		stdout:   &stdout,
+		stderr:   &bytes.Buffer{},
+		colors:   colors,
+		renderer: &Renderer{writer: &stdout, width: 80, height: 24, colors: colors},
+		state:    &UIState{},
+	}
+	handler := &KeyHandler{ui: ui}
+	ui.handler = handler
+	ui.ShowOutput(result)
+	return ui, handler, &stdout
+}
+
+func numberedOutput(n int) string {
+	var b strings.Builder
+	for i := 1; i <= n; i++ {
+		fmt.Fprintf(&b, "line %d\n", i)
+	}
+	return b.String()
+}
+
+func TestOutputView_ScrollIsClamped(t *testing.T) {
+	view := newOutputView(CommandOutput{Output: numberedOutput(30)})
+	view.setPageSize(10)
+
+	view.scroll(-5)
+	if view.offset != 0 {
+		t.Fatalf("offset = %d, want 0", view.offset)
+	}
+	view.page(1)
+	if view.offset != 9 {
+		t.Fatalf("offset after page = %d, want 9", view.offset)
+	}
+	view.scrollToBottom()
+	if view.offset != 20 {
+		t.Fatalf("offset at bottom = %d, want 20", view.offset)
+	}
+	view.scroll(3)
+	if view.offset != 20 {
+		t.Fatalf("offset past bottom = %d, want 20", view.offset)
+	}
+}
+
+func TestOutputView_SearchRevealsMatches(t *testing.T) {
+	view := newOutputView(CommandOutput{Output: numberedOutput(30) + "\x1b[31mTarget\x1b[0m\n"})
+	view.setPageSize(10)
+
+	view.search("target")
+	if len(view.matches) != 1 || view.matches[0] != 30 {
+		t.Fatalf("matches = %v, want [30]", view.matches)
+	}
+	if view.offset > 30 || view.offset+view.pageSize <= 30 {
+		t.Fatalf("expected match line to be visible, offset = %d", view.offset)
+	}
+
+	view.search("missing")
+	if len(view.matches) != 0 || view.notice == "" {
+		t.Fatalf("expected not-found notice, got matches %v notice %q", view.matches, view.notice)
+	}
+}
+
+func TestUI_ShowOutputRendersStatusAndLines(t *testing.T) {
+	ui, _, stdout := newOutputTestUI(CommandOutput{
+		Command: "fetch prune",
+		Output:  "From origin\n - [deleted] feature\n",
+		Err:     errors.New("exit status 1"),
+	})
+	if !ui.state.IsOutputMode() {
+		t.Fatal("expected output mode after ShowOutput")
+	}
+
+	ui.renderer.Render(ui, ui.state)
+	out := stdout.String()
+	for _, want := range []string{"Command Output", "$ ggc fetch prune", "exit status 1", " - [deleted] feature", "back to search"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("rendered output missing %q", want)
+		}
+	}
+}
+
+func TestKeyHandler_OutputModeReturnsToSearch(t *testing.T) {
+	for _, key := range []rune{13, 'q'} {
+		ui, handler, _ := newOutputTestUI(CommandOutput{Command: "status", Output: "clean\n"})
+		cont, args := handler.HandleKey(key, false, nil, nil)
+		if !cont || args != nil {
+			t.Fatalf("key %q: expected UI to keep running without executing, got %v %v", key, cont, args)
+		}
+		if ui.state.IsOutputMode() || ui.state.output != nil {
+			t.Errorf("key %q: expected search mode after leaving output", key)
+		}
+	}
+}
+
+func TestKeyHandler_OutputModeSearchAndScroll(t *testing.T) {
+	ui, handler, _ := newOutputTestUI(CommandOutput{Command: "log simple", Output: numberedOutput(40)})
+	view := ui.state.output
+	view.setPageSize(10)
+
+	for _, r := range "/line 35\r" {
+		handler.HandleKey(r, false, nil, nil)
+	}
+	if view.searching || view.query != "line 35" || len(view.matches) != 1 {
+		t.Fatalf("unexpected search state: searching=%v query=%q matches=%v", view.searching, view.query, view.matches)
+	}
+	if ui.state.input != "" {
+		t.Errorf("expected output keys not to reach the search input, got %q", ui.state.input)
+	}
+
+	handler.HandleKey('g', false, nil, nil)
+	handler.HandleKey('j', false, nil, nil)
+	if view.offset != 1 {
+		t.Errorf("offset after g, j = %d, want 1", view.offset)
+	}
+}
+
+func TestKeyHandler_OutputModeCopy(t *testing.T) {
+	_, handler, stdout := newOutputTestUI(CommandOutput{Command: "status", Output: "\x1b[32mclean\x1b[0m\n"})
+	stdout.Reset()
+
+	handler.HandleKey('y', false, nil, nil)
+	if want := "\x1b]52;c;Y2xlYW4=\a"; stdout.String() != want {
+		t.Errorf("copy wrote %q, want %q", stdout.String(), want)
+	}
+}
@@ -0,0 +1,218 @@
+package interactive
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/bmf-san/ggc/v8/internal/git"
+)
+
+const (
+	// previewDebounce delays the preview query so that scrolling through the
+	// list does not spawn a git process for every highlighted row.
+	previewDebounce = 150 * time.Millisecond
+	// previewTimeout bounds how long a single preview query may run.
+	previewTimeout = 2 * time.Second
+	// previewCommitLimit is the number of commits shown by log previews.
+	previewCommitLimit = 15
+)
+
+// previewQuery is a read-only query that renders the preview for a command.
+type previewQuery func(ctx context.Context) (string, error)
+
+// previewPane runs debounced, cancellable preview queries for the highlighted
+// command. Queries run in the background; results are picked up by the main
+// loop, which re-renders while it waits for input (see waitForInput).
+type previewPane struct {
+	enabled bool
+	reader  git.PreviewReader
+
+	mu      sync.Mutex
+	command string // command the shown or pending preview belongs to
+	active  bool   // a preview has been requested for command
+	content string
+	err     error
+	loading bool
+	dirty   bool // a result arrived that has not been rendered yet
+	seq     int  // invalidates results of superseded queries
+	cancel  context.CancelFunc
+}
+
+// previewSnapshot is a consistent copy of the pane state for rendering.
+type previewSnapshot struct {
+	command   string
+	content   string
+	err       error
+	loading   bool
+	supported bool
+}
+
+func newPreviewPane(gitClient git.StatusInfoReader) *previewPane {
+	reader, _ := gitClient.(git.PreviewReader)
+	return &previewPane{reader: reader}
+}
+
+// previewQueryFor returns the query that previews command, or nil when the
+// command has nothing cheap and read-only to show.
+func previewQueryFor(reader git.PreviewReader, command string) previewQuery {
+	fields := strings.Fields(command)
+	if reader == nil || len(fields) == 0 {
+		return nil
+	}
+	sub := ""
+	if len(fields) > 1 {
+		sub = fields[1]
+	}
+
+	switch fields[0] {
+	case "diff":
+		switch sub {
+		case "staged":
+			return func(ctx context.Context) (string, error) { return reader.DiffStat(ctx, "--staged") }
+		case "unstaged":
+			return func(ctx context.Context) (string, error) { return reader.DiffStat(ctx) }
+		default:
+			return func(ctx context.Context) (string, error) { return reader.DiffStat(ctx, "HEAD") }
+		}
+	case "stash":
+		return reader.StashSummary
+	case "branch", "merge", "worktree":
+		return reader.BranchSummary
+	case "log":
+		all := sub == "graph"
+		return func(ctx context.Context) (string, error) {
+			return reader.RecentCommits(ctx, previewCommitLimit, all)
+		}
+	case "rebase", "reset", "push", "pull", "fetch", "tag", "cherry-pick", "revert", "bisect", "blame", "show":
+		return func(ctx context.Context) (string, error) {
+			return reader.RecentCommits(ctx, previewCommitLimit, false)
+		}
+	case "status", "add", "commit", "restore", "clean", "conflicts", "submodule":
+		return reader.StatusSummary
+	}
+	return nil
+}
+
+// request switches the preview to command, cancelling any query in flight
+// for a previous selection.
+func (p *previewPane) request(command string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.active && command == p.command {
+		return
+	}
+	p.resetLocked()
+	p.command = command
+	p.active = true
+
+	query := previewQueryFor(p.reader, command)
+	if query == nil {
+		return
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), previewDebounce+previewTimeout)
+	p.cancel = cancel
+	p.loading = true
+	go p.run(ctx, p.seq, query)
+}
+
+func (p *previewPane) run(ctx context.Context, seq int, query previewQuery) {
+	timer := time.NewTimer(previewDebounce)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return
+	case <-timer.C:
+	}
+
+	content, err := query(ctx)
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if seq != p.seq {
+		return
+	}
+	p.content, p.err = content, err
+	p.loading = false
+	p.dirty = true
+	if p.cancel != nil {
+		p.cancel()
+		p.cancel = nil
+	}
+}
+
+// stop cancels any query in flight and forgets the current preview.
+func (p *previewPane) stop() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.resetLocked()
+	p.command = ""
+	p.active = false
+}
+
+func (p *previewPane) resetLocked() {
+	if p.cancel != nil {
+		p.cancel()
+		p.cancel = nil
+	}
+	p.seq++
+	p.content, p.err = "", nil
+	p.loading, p.dirty = false, false
+}
+
+func (p *previewPane) snapshot() previewSnapshot {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return previewSnapshot{
+		command:   p.command,
+		content:   p.content,
+		err:       p.err,
+		loading:   p.loading,
+		supported: previewQueryFor(p.reader, p.command) != nil,
+	}
+}
+
+func (p *previewPane) isLoading() bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.loading
+}
+
+// takeDirty reports whether a result arrived since the last call.
+func (p *previewPane) takeDirty() bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	dirty := p.dirty
+	p.dirty = false
+	return dirty
+}
+
+// previewVisible reports whether the preview pane should be drawn.
+func (ui *UI) previewVisible() bool {
+	return ui != nil && ui.preview != nil && ui.preview.enabled &&
+		ui.state != nil && ui.state.mode == ModeSearch
+}
+
+// togglePreview shows or hides the preview pane.
+func (ui *UI) togglePreview() {
+	if ui == nil || ui.preview == nil {
+		return
+	}
+	ui.preview.enabled = !ui.preview.enabled
+	if !ui.preview.enabled {
+		ui.preview.stop()
+	}
+}
+
+// refreshPreview points the preview at the highlighted command.
+func (ui *UI) refreshPreview() {
+	if !ui.previewVisible() {
+		return
+	}
+	command := ""
+	if cmd := ui.state.GetSelectedCommand(); cmd != nil && cmd.Kind == KindCommand {
+		command = cmd.Command
+	}
+	ui.preview.request(command)
+}
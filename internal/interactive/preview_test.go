@@ -0,0 +1,197 @@
+package interactive
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+type fakePreviewReader struct {
+	mu    sync.Mutex
+	calls []string
+	block chan struct{}
+}
+
+func (f *fakePreviewReader) record(call string) (string, error) {
+	f.mu.Lock()
+	f.calls = append(f.calls, call)
+	block := f.block
+	f.mu.Unlock()
+	if block != nil {
+		<-block
+	}
+	return call + " output\n", nil
+}
+
+func (f *fakePreviewReader) DiffStat(_ context.Context, args ...string) (string, error) {
+	return f.record(strings.TrimSpace("diff --stat " + strings.Join(args, " ")))
+}
+
+func (f *fakePreviewReader) StashSummary(context.Context) (string, error) {
+	return f.record("stash list")
+}
+
+func (f *fakePreviewReader) BranchSummary(context.Context) (string, error) {
+	return f.record("branch -vv")
+}
+
+func (f *fakePreviewReader) RecentCommits(_ context.Context, _ int, all bool) (string, error) {
+	if all {
+		return f.record("log --all")
+	}
+	return f.record("log")
+}
+
+func (f *fakePreviewReader) StatusSummary(context.Context) (string, error) {
+	return f.record("status --short")
+}
+
+func (f *fakePreviewReader) callLog() []string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]string(nil), f.calls...)
+}
+
+func waitForPreviewResult(t *testing.T, p *previewPane) {
+	t.Helper()
+	deadline := time.Now().Add(2 * time.Second)
+	for p.isLoading() {
+		if time.Now().After(deadline) {
+			t.Fatal("timed out waiting for preview")
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+}
+
+func TestPreviewQueryFor(t *testing.T) {
+	reader := &fakePreviewReader{}
+	cases := map[string]string{
+		"diff staged":          "diff --stat --staged",
+		"diff unstaged":        "diff --stat",
+		"diff":                 "diff --stat HEAD",
+		"stash list":           "stash list",
+		"branch info <branch>": "branch -vv",
+		"log graph":            "log --all",
+		"log simple":           "log",
+		"status short":         "status --short",
+	}
+	for command, want := range cases {
+		query := previewQueryFor(reader, command)
+		if query == nil {
+			t.Fatalf("previewQueryFor(%q) = nil", command)
+		}
+		got, _ := query(context.Background())
+		if got != want+" output\n" {
+			t.Errorf("previewQueryFor(%q) ran %q, want %q", command, got, want)
+		}
+	}
+	if previewQueryFor(reader, "version") != nil {
+		t.Error("expected no preview for version")
+	}
+	if previewQueryFor(nil, "diff staged") != nil {
+		t.Error("expected no preview without a reader")
+	}
+}
+
+func TestPreviewPane_SelectionChangeCancelsPendingQuery(t *testing.T) {
+	reader := &fakePreviewReader{}
+	pane := &previewPane{reader: reader, enabled: true}
+
+	pane.request("diff staged")
+	pane.request("stash list")
+	waitForPreviewResult(t, pane)
+
+	if calls := reader.callLog(); len(calls) != 1 || calls[0] != "stash list" {
+		t.Fatalf("expected only the final selection to be queried, got %v", calls)
+	}
+	snap := pane.snapshot()
+	if snap.command != "stash list" || snap.content != "stash list output\n" {
+		t.Errorf("unexpected snapshot %+v", snap)
+	}
+	if !pane.takeDirty() || pane.takeDirty() {
+		t.Error("expected exactly one pending redraw after the result arrived")
+	}
+
+	pane.request("stash list")
+	if pane.isLoading() {
+		t.Error("expected repeated request for the same command to reuse the result")
+	}
+}
+
+func TestPreviewPane_StaleResultIsDiscarded(t *testing.T) {
+	reader := &fakePreviewReader{block: make(chan struct{})}
+	pane := &previewPane{reader: reader, enabled: true}
+
+	pane.request("branch list local")
+	deadline := time.Now().Add(2 * time.Second)
+	for len(reader.callLog()) == 0 {
+		if time.Now().After(deadline) {
+			t.Fatal("timed out waiting for the query to start")
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+	pane.request("version")
+	close(reader.block)
+	time.Sleep(20 * time.Millisecond)
+
+	if snap := pane.snapshot(); snap.command != "version" || snap.content != "" || snap.loading {
+		t.Errorf("expected stale branch preview to be discarded, got %+v", snap)
+	}
+}
+
+func newPreviewTestUI(width int, reader *fakePreviewReader) (*UI, *bytes.Buffer) {
+	var stdout bytes.Buffer
+	colors := NewANSIColors()
+	ui := &UI{
+		stdout:   &stdout,
+		stderr:   &bytes.Buffer{},
+		colors:   colors,
+		renderer: &Renderer{writer: &stdout, width: width, height: 24, colors: colors},
+		state: &UIState{
+			input:     "stash",
+			cursorPos: 5,
+			commands:  []CommandInfo{{Command: "stash list", Description: "List all stashes"}},
+		},
+		preview: &previewPane{reader: reader},
+	}
+	ui.handler = &KeyHandler{ui: ui}
+	ui.state.UpdateFiltered()
+	return ui, &stdout
+}
+
+func TestKeyHandler_TogglePreview(t *testing.T) {
+	ui, _ := newPreviewTestUI(120, &fakePreviewReader{})
+	ui.handler.HandleKey(15, false, nil, nil) // Ctrl+O
+	if !ui.previewVisible() {
+		t.Fatal("expected Ctrl+O to show the preview pane")
+	}
+	ui.handler.HandleKey(15, false, nil, nil)
+	if ui.previewVisible() {
+		t.Fatal("expected Ctrl+O to hide the preview pane")
+	}
+}
+
+func TestRenderer_PreviewLayoutAdaptsToWidth(t *testing.T) {
+	for _, tc := range []struct {
+		width int
+		split bool
+	}{{width: 120, split: true}, {width: 80, split: false}} {
+		ui, stdout := newPreviewTestUI(tc.width, &fakePreviewReader{})
+		ui.togglePreview()
+		ui.refreshPreview()
+		waitForPreviewResult(t, ui.preview)
+		stdout.Reset()
+
+		ui.renderer.renderSearchWithPreview(ui, ui.state)
+		out := stdout.String()
+		if !strings.Contains(out, "Preview: stash list") || !strings.Contains(out, "stash list output") {
+			t.Fatalf("width %d: expected preview content, got %q", tc.width, out)
+		}
+		if got := strings.Contains(out, " │ "); got != tc.split {
+			t.Errorf("width %d: side-by-side = %v, want %v", tc.width, got, tc.split)
+		}
+	}
+}
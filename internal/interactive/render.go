@@ -10,10 +10,12 @@ import (
 
 // Renderer handles all terminal rendering operations
 type Renderer struct {
-	writer io.Writer
-	width  int
-	height int
-	colors *ANSIColors
+	writer  io.Writer
+	width   int
+	height  int
+	colors  *ANSIColors
+	symbols *Symbols
+	theme   *Theme
 }
 
 type keybindHelpEntry struct {
@@ -21,12 +23,37 @@ type keybindHelpEntry struct {
 	desc string
 }
 
+// sym returns the glyphs for the configured ui.style.
+func (r *Renderer) sym() *Symbols {
+	if r.symbols == nil {
+		r.symbols = uiutil.NewSymbols()
+	}
+	return r.symbols
+}
+
+// th returns the colors for the configured ui.theme.
+func (r *Renderer) th() *Theme {
+	if r.theme == nil {
+		r.theme = uiutil.DefaultTheme()
+	}
+	return r.theme
+}
+
 // updateSize updates the terminal dimensions
 func (r *Renderer) updateSize() {
 	w, h := uiutil.Dimensions(r.writer, 80, 24)
 	r.width, r.height = w, h
 }
 
+// fitWidth truncates s so that it fits in the terminal after reserved columns
+// of surrounding chrome. Text is left untouched when the width is unknown.
+func (r *Renderer) fitWidth(s string, reserved int) string {
+	if r.width <= 0 {
+		return s
+	}
+	return uiutil.TruncateToWidth(s, max(r.width-reserved, 1))
+}
+
 // Render displays the command list with proper terminal handling
 func (r *Renderer) Render(ui *UI, state *UIState) {
 	clearScreen(r.writer)
@@ -50,27 +77,49 @@ func (r *Renderer) Render(ui *UI, state *UIState) {
 	r.renderWorkflowError(ui)
 	r.renderWorkflowNotice(ui)
 
+	if state.help != nil {
+		r.renderHelpOverlay(ui, state)
+		return
+	}
+
 	switch state.mode {
 	case ModeWorkflow:
 		// Workflow mode: no search prompt, just workflow management
 		r.renderWorkflowMode(ui, state)
+	case ModeOutput:
+		r.renderOutputMode(ui, state)
+	case ModeBrowse:
+		r.renderBrowseMode(ui, state)
 	default:
 		r.renderSearchPrompt(ui, state)
 		restoreCursor = r.saveCursorAtSearchPrompt(state)
 
-		switch {
-		case state.input == "":
-			r.renderEmptyState(ui)
-			r.writeEmptyLine()
-			r.renderSearchKeybinds(ui)
-		case len(state.filtered) == 0:
-			r.renderNoMatches(ui, state)
-		default:
-			r.renderCommandList(ui, state)
+		if ui.previewVisible() {
+			r.renderSearchWithPreview(ui, state)
+		} else {
+			r.renderSearchBody(ui, state)
 		}
 	}
 }
 
+// renderSearchBody renders everything below the search prompt.
+func (r *Renderer) renderSearchBody(ui *UI, state *UIState) {
+	switch {
+	case state.input == "" && state.IsRecentView():
+		r.renderRecentList(ui, state)
+		r.writeEmptyLine()
+		r.renderSearchKeybinds(ui)
+	case state.input == "":
+		r.renderEmptyState(ui)
+		r.writeEmptyLine()
+		r.renderSearchKeybinds(ui)
+	case len(state.filtered) == 0:
+		r.renderNoMatches(ui, state)
+	default:
+		r.renderCommandList(ui, state)
+	}
+}
+
 // clearScreen clears the entire screen and hides cursor
 func clearScreen(w io.Writer) {
 	uiutil.ClearScreen(w)
@@ -82,18 +131,6 @@ func showCursor(w io.Writer) {
 	uiutil.ShowCursor(w)
 }
 
-// ellipsis truncates string and adds ellipsis if it exceeds maxLen (ASCII only)
-func ellipsis(s string, maxLen int) string {
-	return uiutil.Ellipsis(s, maxLen)
-}
-
-func pluralize(n int) string {
-	if n == 1 {
-		return ""
-	}
-	return "s"
-}
-
 // writeColorln writes a colored line to the terminal.
 // The *UI parameter is intentionally unused but kept in the signature
 // to stay consistent with other rendering helpers and to allow future
@@ -117,8 +154,8 @@ func (r *Renderer) calculateMaxCommandLength(filtered []CommandInfo) int {
 
 	maxLen := 0
 	for _, cmd := range filtered {
-		if len(cmd.Command) > maxLen {
-			maxLen = len(cmd.Command)
+		if w := uiutil.DisplayWidth(cmd.Command); w > maxLen {
+			maxLen = w
 		}
 	}
 	return maxLen
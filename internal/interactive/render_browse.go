@@ -0,0 +1,112 @@
+package interactive
+
+import (
+	"fmt"
+	"strings"
+
+	uiutil "github.com/bmf-san/ggc/v8/internal/ui"
+)
+
+// browseChromeLines is the number of rows the category browser uses around
+// the command list: title, git status, tab bar, category heading, spacing,
+// notice and footer.
+const browseChromeLines = 10
+
+// renderBrowseMode renders the category tabs and the commands of the active
+// category.
+func (r *Renderer) renderBrowseMode(ui *UI, state *UIState) {
+	b := state.browser
+	if b == nil {
+		return
+	}
+	cat := b.current()
+
+	r.writeEmptyLine()
+	r.writeColorln(ui, r.renderCategoryTabs(b))
+	r.writeEmptyLine()
+	r.writeColorln(ui, fmt.Sprintf("%s%s%s %s(%d command%s)%s",
+		r.th().Title, r.categoryLabel(cat.name), r.colors.Reset,
+		r.th().Muted, len(cat.commands), uiutil.Pluralize(len(cat.commands)), r.colors.Reset))
+
+	if len(cat.commands) == 0 {
+		msg := "No commands in this category"
+		if cat.name == pinnedCategory {
+			msg = "No pinned commands yet. Select a command and press p to pin it"
+		}
+		r.writeColorln(ui, fmt.Sprintf("   %s%s%s", r.th().Muted, msg, r.colors.Reset))
+	}
+
+	rows := max(r.height-browseChromeLines, 1)
+	b.reveal(rows)
+	maxCmdLen := r.calculateMaxCommandLength(cat.commands)
+	end := min(b.offset+rows, len(cat.commands))
+	for i := b.offset; i < end; i++ {
+		cmd := cat.commands[i]
+		if ui.isPinned(cmd.Command) && cat.name != pinnedCategory {
+			cmd.Description = r.sym().Star + " " + cmd.Description
+		}
+		r.renderCommandItem(ui, cmd, nil, i, b.selected, maxCmdLen)
+	}
+
+	r.writeEmptyLine()
+	if b.notice != "" {
+		r.writeColorln(ui, fmt.Sprintf("%s%s%s", r.th().Warning, b.notice, r.colors.Reset))
+	}
+	sym := r.sym()
+	r.writeColorln(ui, fmt.Sprintf("%s%s/%s category  %s/%s select  Enter run  p pin  Esc back  ? help%s",
+		r.th().Muted, sym.Left, sym.Right, sym.Up, sym.Down, r.colors.Reset))
+}
+
+// renderCategoryTabs renders the tab bar, scrolled so the active tab is
+// visible when the tabs do not fit the terminal width.
+func (r *Renderer) renderCategoryTabs(b *categoryBrowser) string {
+	labels := make([]string, len(b.categories))
+	for i, cat := range b.categories {
+		labels[i] = " " + r.categoryLabel(cat.name) + " "
+	}
+
+	start, end := 0, len(labels)
+	if r.width > 0 {
+		// Drop leading tabs until the active one fits, then trailing tabs
+		// that would overflow the line.
+		for start < b.catIdx && tabsWidth(labels[start:b.catIdx+1]) > r.width-4 {
+			start++
+		}
+		for end > b.catIdx+1 && tabsWidth(labels[start:end]) > r.width-4 {
+			end--
+		}
+	}
+
+	var sb strings.Builder
+	if start > 0 {
+		sb.WriteString(r.th().Muted + "‹ " + r.colors.Reset)
+	}
+	for i := start; i < end; i++ {
+		if i == b.catIdx {
+			sb.WriteString(r.th().Selected + labels[i] + r.colors.Reset)
+		} else {
+			sb.WriteString(r.th().Muted + labels[i] + r.colors.Reset)
+		}
+		sb.WriteString(" ")
+	}
+	if end < len(labels) {
+		sb.WriteString(r.th().Muted + "›" + r.colors.Reset)
+	}
+	return sb.String()
+}
+
+// categoryLabel returns the display name of a category.
+func (r *Renderer) categoryLabel(name string) string {
+	if name == pinnedCategory {
+		return r.sym().Star + " " + name
+	}
+	return name
+}
+
+func tabsWidth(labels []string) int {
+	w := 0
+	for _, l := range labels {
+		w += uiutil.DisplayWidth(l) + 1
+	}
+	return w
+}
@@ -2,13 +2,15 @@ package interactive
 
 import (
 	"fmt"
+
+	uiutil "github.com/bmf-san/ggc/v8/internal/ui"
 )
 
 func (r *Renderer) renderSoftCancelFlash(ui *UI) {
 	if !ui.consumeSoftCancelFlash() {
 		return
 	}
-	alert := fmt.Sprintf("%s⚠️  Operation canceled%s", r.colors.BrightRed+r.colors.Bold, r.colors.Reset)
+	alert := fmt.Sprintf("%s%sOperation canceled%s", r.th().Error, r.sym().Warning, r.colors.Reset)
 	r.writeColorln(ui, alert)
 	r.writeColorln(ui, "")
 }
@@ -21,7 +23,7 @@ func (r *Renderer) renderWorkflowError(ui *UI) {
 	if message == "" {
 		return
 	}
-	alert := fmt.Sprintf("%s⚠️  %s%s", r.colors.BrightRed+r.colors.Bold, message, r.colors.Reset)
+	alert := fmt.Sprintf("%s%s%s%s", r.th().Error, r.sym().Warning, message, r.colors.Reset)
 	r.writeColorln(ui, alert)
 	r.writeColorln(ui, "")
 }
@@ -34,7 +36,7 @@ func (r *Renderer) renderWorkflowNotice(ui *UI) {
 	if message == "" {
 		return
 	}
-	notice := fmt.Sprintf("%s%s%s", r.colors.BrightGreen+r.colors.Bold, message, r.colors.Reset)
+	notice := fmt.Sprintf("%s%s%s", r.th().Success, message, r.colors.Reset)
 	r.writeColorln(ui, notice)
 	r.writeColorln(ui, "")
 }
@@ -53,12 +55,19 @@ func (r *Renderer) renderWorkflowMode(ui *UI, state *UIState) {
 // renderHeader renders the title, git status, and navigation subtitle
 func (r *Renderer) renderHeader(ui *UI) {
 	// Modern header with title
-	titleText := "🚀 ggc Interactive Mode"
+	sym := r.sym()
+	titleText := sym.Launch + "ggc Interactive Mode"
 	if ui != nil && ui.state != nil && ui.state.IsWorkflowMode() {
-		titleText = "📋 Workflow Mode"
+		titleText = sym.Workflow + "Workflow Mode"
+	}
+	if ui != nil && ui.state != nil && ui.state.IsOutputMode() {
+		titleText = sym.Output + "Command Output"
+	}
+	if ui != nil && ui.state != nil && ui.state.IsBrowseMode() {
+		titleText = sym.Browse + "Browse Commands"
 	}
 	title := fmt.Sprintf("%s%s%s",
-		r.colors.BrightCyan+r.colors.Bold,
+		r.th().Title,
 		titleText,
 		r.colors.Reset)
 	r.writeColorln(ui, title)
@@ -85,22 +94,22 @@ func (r *Renderer) renderWorkflowActiveSummary(ui *UI) {
 
 	if activeID == 0 {
 		r.writeColorln(ui, fmt.Sprintf("%sActive:%s %s(none)%s",
-			r.colors.BrightYellow+r.colors.Bold,
+			r.th().Heading,
 			r.colors.Reset,
-			r.colors.BrightBlack,
+			r.th().Muted,
 			r.colors.Reset))
 		return
 	}
 
 	r.writeColorln(ui, fmt.Sprintf("%sActive:%s %sW%d%s %s(%d step%s)%s",
-		r.colors.BrightYellow+r.colors.Bold,
+		r.th().Heading,
 		r.colors.Reset,
-		r.colors.BrightWhite+r.colors.Bold,
+		r.th().Emphasis,
 		activeID,
 		r.colors.Reset,
-		r.colors.BrightBlack,
+		r.th().Muted,
 		stepCount,
-		pluralize(stepCount),
+		uiutil.Pluralize(stepCount),
 		r.colors.Reset))
 }
 
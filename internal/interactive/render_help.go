@@ -0,0 +1,60 @@
+package interactive
+
+import (
+	"fmt"
+	"strings"
+
+	uiutil "github.com/bmf-san/ggc/v8/internal/ui"
+)
+
+// helpChromeLines is the number of rows the help overlay uses around the
+// binding list: title, git status, overlay heading, filter, spacing, footer.
+const helpChromeLines = 9
+
+// renderHelpOverlay renders every key binding of the current context,
+// grouped by category and filtered by the overlay query.
+func (r *Renderer) renderHelpOverlay(ui *UI, state *UIState) {
+	help := state.help
+	entries := filterHelpEntries(ui.helpEntries(), help.query)
+	rows := helpRows(entries)
+
+	r.writeEmptyLine()
+	r.writeColorln(ui, fmt.Sprintf("%s%s%sKeyboard shortcuts%s %s(profile: %s, context: %s)%s",
+		r.th().Accent, r.sym().Keyboard, r.th().Emphasis, r.colors.Reset,
+		r.th().Muted, ui.profile, state.GetCurrentContext(), r.colors.Reset))
+	r.writeColorln(ui, fmt.Sprintf("%s%s%sFilter:%s %s%s%s%s%s",
+		r.th().Accent, r.sym().Filter, r.th().Prompt, r.colors.Reset,
+		r.th().Input, help.query, r.th().Emphasis, r.sym().Block, r.colors.Reset))
+	r.writeEmptyLine()
+
+	help.pageSize = max(r.height-helpChromeLines, 1)
+	help.scroll(0, len(rows))
+	if len(rows) == 0 {
+		r.writeColorln(ui, fmt.Sprintf("%sNo key bindings match '%s'%s", r.th().Muted, help.query, r.colors.Reset))
+	}
+
+	keyWidth := 0
+	for _, e := range entries {
+		keyWidth = max(keyWidth, uiutil.DisplayWidth(e.keys))
+	}
+	end := min(help.offset+help.pageSize, len(rows))
+	for _, row := range rows[help.offset:end] {
+		if row.heading != "" {
+			r.writeColorln(ui, fmt.Sprintf("%s%s%s", r.th().Title, row.heading, r.colors.Reset))
+			continue
+		}
+		e := row.entry
+		padding := strings.Repeat(" ", keyWidth-uiutil.DisplayWidth(e.keys))
+		r.writeColorln(ui, fmt.Sprintf("   %s%s%s%s  %s%s%s",
+			r.th().Key, e.keys, r.colors.Reset, padding,
+			r.th().Muted, r.fitWidth(e.desc, keyWidth+5), r.colors.Reset))
+	}
+
+	r.writeEmptyLine()
+	position := fmt.Sprintf("%d binding%s", len(entries), uiutil.Pluralize(len(entries)))
+	if len(rows) > help.pageSize {
+		position += fmt.Sprintf("  rows %d-%d of %d", help.offset+1, end, len(rows))
+	}
+	r.writeColorln(ui, fmt.Sprintf("%s%s  %s  type to filter  %s/%s scroll  Esc close%s",
+		r.th().Muted, position, r.sym().Dot, r.sym().Up, r.sym().Down, r.colors.Reset))
+}
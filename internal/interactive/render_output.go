@@ -0,0 +1,108 @@
+package interactive
+
+import (
+	"fmt"
+	"strings"
+
+	uiutil "github.com/bmf-san/ggc/v8/internal/ui"
+)
+
+// outputChromeLines is the number of rows the output pane uses around the
+// output itself: title, git status, command status, spacing, and footer.
+const outputChromeLines = 8
+
+// renderOutputMode renders the captured output of the last command.
+func (r *Renderer) renderOutputMode(ui *UI, state *UIState) {
+	view := state.output
+	if view == nil {
+		return
+	}
+
+	r.writeEmptyLine()
+	r.renderOutputStatus(ui, view)
+	r.writeEmptyLine()
+
+	view.setPageSize(r.height - outputChromeLines)
+	if len(view.lines) == 0 {
+		r.writeColorln(ui, fmt.Sprintf("%s(no output)%s", r.th().Muted, r.colors.Reset))
+	}
+	end := min(view.offset+view.pageSize, len(view.lines))
+	for i := view.offset; i < end; i++ {
+		line := view.lines[i]
+		if view.isMatch(i) {
+			line = r.highlightMatches(view.plain[i], queryPositions(view.plain[i], view.query), "", r.th().Match)
+		}
+		r.writeColorln(ui, line+r.colors.Reset)
+	}
+
+	r.writeEmptyLine()
+	r.renderOutputFooter(ui, view)
+}
+
+// renderOutputStatus renders the executed command and how it finished.
+func (r *Renderer) renderOutputStatus(ui *UI, view *outputView) {
+	status := fmt.Sprintf("%s%sdone%s", r.th().Success, r.sym().Success, r.colors.Reset)
+	if view.result.Err != nil {
+		status = fmt.Sprintf("%s%s%s%s", r.th().Error, r.sym().Failure, view.result.Err, r.colors.Reset)
+	}
+	r.writeColorln(ui, fmt.Sprintf("%s$ ggc %s%s  %s",
+		r.th().Emphasis,
+		view.result.Command,
+		r.colors.Reset,
+		status))
+}
+
+// renderOutputFooter renders the scroll position, search prompt and keys.
+func (r *Renderer) renderOutputFooter(ui *UI, view *outputView) {
+	switch {
+	case view.searching:
+		r.writeColorln(ui, fmt.Sprintf("%s/%s%s", r.th().Prompt, r.colors.Reset, view.query))
+	case view.notice != "":
+		r.writeColorln(ui, fmt.Sprintf("%s%s%s", r.th().Warning, view.notice, r.colors.Reset))
+	default:
+		position := fmt.Sprintf("%d line%s", len(view.lines), uiutil.Pluralize(len(view.lines)))
+		if len(view.lines) > view.pageSize {
+			position = fmt.Sprintf("lines %d-%d of %d", view.offset+1, min(view.offset+view.pageSize, len(view.lines)), len(view.lines))
+		}
+		if len(view.matches) > 0 {
+			position += fmt.Sprintf("  match %d/%d for '%s'", view.matchIdx+1, len(view.matches), view.query)
+		}
+		r.writeColorln(ui, fmt.Sprintf("%s%s%s", r.th().Muted, position, r.colors.Reset))
+	}
+
+	keys := []keybindHelpEntry{
+		{key: "Enter/Esc/q", desc: "back to search"},
+		{key: r.sym().Up + "/" + r.sym().Down + " j/k", desc: "scroll"},
+		{key: "Space/b", desc: "page"},
+		{key: "/ n N", desc: "search"},
+		{key: "y", desc: "copy"},
+		{key: "?", desc: "help"},
+	}
+	parts := make([]string, 0, len(keys))
+	for _, entry := range keys {
+		parts = append(parts, fmt.Sprintf("%s%s%s %s%s%s",
+			r.th().Key, entry.key, r.colors.Reset,
+			r.th().Muted, entry.desc, r.colors.Reset))
+	}
+	r.writeColorln(ui, strings.Join(parts, "  "))
+}
+
+// queryPositions returns the rune indices of every case-insensitive
+// occurrence of query in text.
+func queryPositions(text, query string) []int {
+	textRunes := []rune(strings.ToLower(text))
+	queryRunes := []rune(strings.ToLower(query))
+	if len(queryRunes) == 0 || len(textRunes) != len([]rune(text)) {
+		return nil
+	}
+	var positions []int
+	for i := 0; i+len(queryRunes) <= len(textRunes); i++ {
+		if string(textRunes[i:i+len(queryRunes)]) == string(queryRunes) {
+			for j := range queryRunes {
+				positions = append(positions, i+j)
+			}
+			i += len(queryRunes) - 1
+		}
+	}
+	return positions
+}
@@ -0,0 +1,98 @@
+package interactive
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	uiutil "github.com/bmf-san/ggc/v8/internal/ui"
+)
+
+const (
+	// previewSplitMinWidth is the narrowest terminal that gets a side-by-side
+	// preview; narrower terminals stack the preview below the list.
+	previewSplitMinWidth = 100
+	// previewListPercent is the share of the width given to the command list
+	// in the side-by-side layout.
+	previewListPercent = 55
+	// previewMinRows is the minimum height of the preview pane.
+	previewMinRows = 8
+)
+
+// renderSearchWithPreview renders the search results next to, or above, the
+// preview of the highlighted command depending on the terminal width.
+func (r *Renderer) renderSearchWithPreview(ui *UI, state *UIState) {
+	if r.width < previewSplitMinWidth {
+		r.renderSearchBody(ui, state)
+		r.writeEmptyLine()
+		for _, line := range r.previewLines(ui, r.width, previewMinRows) {
+			r.writeColorln(ui, line+r.colors.Reset)
+		}
+		return
+	}
+
+	listWidth := r.width * previewListPercent / 100
+	var buf bytes.Buffer
+	list := &Renderer{writer: &buf, width: listWidth, height: r.height, colors: r.colors, symbols: r.symbols, theme: r.theme}
+	list.renderSearchBody(ui, state)
+	listLines := renderedLines(buf.String())
+
+	rows := max(len(listLines), previewMinRows)
+	separator := r.th().Muted + " " + r.sym().Separator + " " + r.colors.Reset
+	previewLines := r.previewLines(ui, r.width-listWidth-3, rows)
+	for i := 0; i < rows; i++ {
+		left, right := "", ""
+		if i < len(listLines) {
+			left = listLines[i]
+		}
+		if i < len(previewLines) {
+			right = previewLines[i]
+		}
+		r.writeColorln(ui, uiutil.FitWidth(left, listWidth)+r.colors.Reset+separator+right+r.colors.Reset)
+	}
+}
+
+// previewLines returns at most rows lines describing the preview state,
+// each fitted to width columns.
+func (r *Renderer) previewLines(ui *UI, width, rows int) []string {
+	snap := ui.preview.snapshot()
+	title := r.sym().Preview + "Preview"
+	if snap.command != "" {
+		title += ": " + snap.command
+	}
+	lines := []string{fmt.Sprintf("%s%s%s", r.th().Title, title, r.colors.Reset)}
+
+	var body []string
+	switch {
+	case snap.command == "" || !snap.supported:
+		body = []string{r.th().Muted + "No preview for this command" + r.colors.Reset}
+	case snap.loading:
+		body = []string{r.th().Muted + "Loading" + r.sym().Ellipsis + r.colors.Reset}
+	case snap.err != nil:
+		body = []string{r.th().Error + snap.err.Error() + r.colors.Reset}
+	case strings.TrimSpace(uiutil.StripANSI(snap.content)) == "":
+		body = []string{r.th().Muted + "(nothing to show)" + r.colors.Reset}
+	default:
+		body = strings.Split(strings.TrimRight(snap.content, "\n"), "\n")
+	}
+
+	for _, line := range body {
+		if len(lines) >= rows {
+			break
+		}
+		lines = append(lines, line)
+	}
+	for i := range lines {
+		lines[i] = uiutil.FitWidth(lines[i], width)
+	}
+	return lines
+}
+
+// renderedLines splits output written with writeColorln back into lines.
+func renderedLines(out string) []string {
+	lines := strings.Split(strings.TrimSuffix(out, "\r\n"), "\r\n")
+	for i, line := range lines {
+		lines[i] = strings.TrimPrefix(line, "\r\x1b[K")
+	}
+	return lines
+}
@@ -2,26 +2,31 @@ package interactive
 
 import (
 	"fmt"
+	"strings"
 	"unicode/utf8"
 
+	"github.com/bmf-san/ggc/v8/internal/git"
 	kb "github.com/bmf-san/ggc/v8/internal/keybindings"
+	uiutil "github.com/bmf-san/ggc/v8/internal/ui"
 )
 
 func (r *Renderer) renderSearchPrompt(ui *UI, state *UIState) {
 	inputWithCursor := r.formatInputWithCursor(state)
 
-	searchPrompt := fmt.Sprintf("%s┌─ %sSearch:%s %s",
-		r.colors.BrightBlue,
-		r.colors.BrightGreen+r.colors.Bold,
+	searchPrompt := fmt.Sprintf("%s%s%sSearch:%s %s",
+		r.th().Accent,
+		r.sym().BoxTop,
+		r.th().Prompt,
 		r.colors.Reset,
 		inputWithCursor)
 	r.writeColorln(ui, searchPrompt)
 
 	// Results separator
 	if state.input != "" {
-		separator := fmt.Sprintf("%s└─ %sResults:%s",
-			r.colors.BrightBlue,
-			r.colors.BrightMagenta+r.colors.Bold,
+		separator := fmt.Sprintf("%s%s%sResults:%s",
+			r.th().Accent,
+			r.sym().BoxBottom,
+			r.th().Heading,
 			r.colors.Reset)
 		r.writeColorln(ui, separator)
 	}
@@ -34,11 +39,11 @@ func (r *Renderer) saveCursorAtSearchPrompt(state *UIState) func() {
 		linesUp++
 	}
 	_, _ = fmt.Fprintf(r.writer, "\x1b[%dA", linesUp)
-	const prefix = "┌─ Search: "
+	prefix := r.sym().BoxTop + "Search: "
 	// Compute display width (columns) of the prefix using runeDisplayWidth
 	prefixCols := 0
 	for _, pr := range prefix {
-		prefixCols += runeDisplayWidth(pr)
+		prefixCols += uiutil.RuneWidth(pr)
 	}
 	// Compute display width up to the logical cursor position
 	runes := []rune(state.input)
@@ -48,7 +53,7 @@ func (r *Renderer) saveCursorAtSearchPrompt(state *UIState) func() {
 	}
 	cursorWidth := 0
 	for _, rr := range runes[:cursorPos] {
-		cursorWidth += runeDisplayWidth(rr)
+		cursorWidth += uiutil.RuneWidth(rr)
 	}
 	column := prefixCols + cursorWidth + 1
 	if column < 1 {
@@ -65,38 +70,73 @@ func (r *Renderer) saveCursorAtSearchPrompt(state *UIState) func() {
 // formatInputWithCursor formats the input string with cursor position
 func (r *Renderer) formatInputWithCursor(state *UIState) string {
 	if state.input == "" {
-		return fmt.Sprintf("%s█%s", r.colors.BrightWhite+r.colors.Bold, r.colors.Reset)
+		return fmt.Sprintf("%s%s%s", r.th().Emphasis, r.sym().Block, r.colors.Reset)
 	}
 
 	inputRunes := []rune(state.input)
 	beforeCursor := string(inputRunes[:state.cursorPos])
 	afterCursor := string(inputRunes[state.cursorPos:])
-	cursor := "│"
+	cursor := r.sym().Caret
 	if state.cursorPos >= utf8.RuneCountInString(state.input) {
-		cursor = "█"
+		cursor = r.sym().Block
 	}
 
 	return fmt.Sprintf("%s%s%s%s%s%s%s",
-		r.colors.BrightYellow,
+		r.th().Input,
 		beforeCursor,
-		r.colors.BrightWhite+r.colors.Bold,
+		r.th().Emphasis,
 		cursor,
-		r.colors.Reset+r.colors.BrightYellow,
+		r.colors.Reset+r.th().Input,
 		afterCursor,
 		r.colors.Reset)
 }
 
 // renderEmptyState renders the empty input state
 func (r *Renderer) renderEmptyState(ui *UI) {
-	r.writeColorln(ui, fmt.Sprintf("%s💭 %sStart typing to search commands...%s",
-		r.colors.BrightBlue, r.colors.BrightBlack, r.colors.Reset))
+	r.writeColorln(ui, fmt.Sprintf("%s%s%sStart typing to search commands...%s",
+		r.th().Accent, r.sym().Hint, r.th().Muted, r.colors.Reset))
+}
+
+// renderRecentList renders the commands suggested for the operation in
+// progress followed by the most recently executed command lines
+func (r *Renderer) renderRecentList(ui *UI, state *UIState) {
+	if len(state.suggestions) == 0 {
+		r.renderRecentHeader(ui)
+		r.renderCommandList(ui, state)
+		return
+	}
+
+	state.clampSelection()
+	maxCmdLen := r.calculateMaxCommandLength(state.filtered)
+	heading := "Suggested"
+	if ui.gitStatus != nil && ui.gitStatus.Operation != git.OperationNone {
+		op := string(ui.gitStatus.Operation)
+		heading = strings.ToUpper(op[:1]) + op[1:] + " in progress"
+	}
+	r.writeColorln(ui, fmt.Sprintf("%s%s%s%s%s %s(Enter to run, or start typing to search)%s",
+		r.th().Warning, r.sym().Warning, r.th().Emphasis, heading, r.colors.Reset,
+		r.th().Muted, r.colors.Reset))
+	for i, cmd := range state.filtered {
+		if i == len(state.suggestions) {
+			r.writeEmptyLine()
+			r.renderRecentHeader(ui)
+		}
+		r.renderCommandItem(ui, cmd, nil, i, state.selected, maxCmdLen)
+	}
+}
+
+func (r *Renderer) renderRecentHeader(ui *UI) {
+	r.writeColorln(ui, fmt.Sprintf("%s%s%sRecent commands%s %s(Enter to re-run, or start typing to search)%s",
+		r.th().Accent, r.sym().Recent, r.th().Emphasis, r.colors.Reset,
+		r.th().Muted, r.colors.Reset))
 }
 
 func (r *Renderer) buildSearchKeybindEntries(ui *UI) []keybindHelpEntry {
+	leftRight := r.sym().Left + "/" + r.sym().Right
 	entries := []keybindHelpEntry{
-		{key: "←/→", desc: "Move cursor"},
-		{key: "Ctrl+←/→", desc: "Move by word"},
-		{key: "Option+←/→", desc: "Move by word (macOS)"},
+		{key: leftRight, desc: "Move cursor"},
+		{key: "Ctrl+" + leftRight, desc: "Move by word"},
+		{key: "Option+" + leftRight, desc: "Move by word (macOS)"},
 	}
 	// Future: extend this helper for additional contexts such as workflow views.
 
@@ -138,6 +178,10 @@ func (r *Renderer) buildSearchKeybindEntries(ui *UI) []keybindHelpEntry {
 
 	appendDynamic(km.AddToWorkflow, defaultMap.AddToWorkflow, "Add to workflow")
 	appendDynamic(km.ToggleWorkflowView, defaultMap.ToggleWorkflowView, "Toggle workflow view")
+	appendDynamic(km.TogglePreview, defaultMap.TogglePreview, "Toggle preview pane")
+	appendDynamic(km.ToggleCategoryView, defaultMap.ToggleCategoryView, "Browse by category")
+
+	entries = append(entries, keybindHelpEntry{key: "?", desc: "Show all keybindings"})
 
 	entries = append(entries, keybindHelpEntry{key: "Ctrl+c", desc: "Quit"})
 
@@ -149,15 +193,15 @@ func (r *Renderer) renderKeybindEntries(ui *UI, entries []keybindHelpEntry) {
 		return
 	}
 
-	r.writeColorln(ui, fmt.Sprintf("%s⌨️  %sAvailable keybinds:%s",
-		r.colors.BrightBlue, r.colors.BrightWhite+r.colors.Bold, r.colors.Reset))
+	r.writeColorln(ui, fmt.Sprintf("%s%s%sAvailable keybinds:%s",
+		r.th().Accent, r.sym().Keyboard, r.th().Emphasis, r.colors.Reset))
 
 	for _, entry := range entries {
 		r.writeColorln(ui, fmt.Sprintf("   %s%s%s  %s%s%s",
-			r.colors.BrightGreen+r.colors.Bold,
+			r.th().Key,
 			entry.key,
 			r.colors.Reset,
-			r.colors.BrightBlack,
+			r.th().Muted,
 			entry.desc,
 			r.colors.Reset))
 	}
@@ -166,12 +210,13 @@ func (r *Renderer) renderKeybindEntries(ui *UI, entries []keybindHelpEntry) {
 // renderNoMatches renders the no matches found state with keybind help
 func (r *Renderer) renderNoMatches(ui *UI, state *UIState) {
 	// No matches message
-	r.writeColorln(ui, fmt.Sprintf("%s🔍 %sNo commands found for '%s%s%s'%s",
-		r.colors.BrightYellow,
-		r.colors.BrightWhite,
-		r.colors.BrightYellow+r.colors.Bold,
+	r.writeColorln(ui, fmt.Sprintf("%s%s%sNo commands found for '%s%s%s'%s",
+		r.th().Warning,
+		r.sym().NoResults,
+		r.th().Text,
+		r.th().Input,
 		state.input,
-		r.colors.Reset+r.colors.BrightWhite,
+		r.colors.Reset+r.th().Text,
 		r.colors.Reset))
 
 	r.writeEmptyLine()
@@ -3,19 +3,14 @@ package interactive
 import (
 	"fmt"
 	"strings"
+
+	"github.com/bmf-san/ggc/v8/internal/git"
+	uiutil "github.com/bmf-san/ggc/v8/internal/ui"
 )
 
 func (r *Renderer) renderGitStatus(ui *UI, status *GitStatus) {
 	var parts []string
 
-	// Branch name
-	branchPart := fmt.Sprintf("%s📍 %s%s%s",
-		r.colors.BrightBlue,
-		r.colors.BrightWhite+r.colors.Bold,
-		status.Branch,
-		r.colors.Reset)
-	parts = append(parts, branchPart)
-
 	// Working directory status
 	if status.HasChanges {
 		var statusParts []string
@@ -26,31 +21,68 @@ func (r *Renderer) renderGitStatus(ui *UI, status *GitStatus) {
 			statusParts = append(statusParts, fmt.Sprintf("%d staged", status.Staged))
 		}
 
-		workingPart := fmt.Sprintf("%s📝 %s%s%s",
-			r.colors.BrightYellow,
-			r.colors.BrightWhite+r.colors.Bold,
+		workingPart := fmt.Sprintf("%s%s%s%s%s",
+			r.th().Changes,
+			r.sym().Changes,
+			r.th().Emphasis,
 			strings.Join(statusParts, ", "),
 			r.colors.Reset)
 		parts = append(parts, workingPart)
 	}
 
+	// Operation waiting to be continued, such as a stopped rebase
+	if status.Operation != git.OperationNone {
+		parts = append(parts, fmt.Sprintf("%s%s%s in progress%s",
+			r.th().Warning,
+			r.sym().Warning,
+			status.Operation,
+			r.colors.Reset))
+	}
+
+	// Submodules that need an update or have changes of their own
+	if status.Submodules > 0 {
+		noun := "submodule"
+		if status.Submodules > 1 {
+			noun += "s"
+		}
+		parts = append(parts, fmt.Sprintf("%s%d %s changed%s",
+			r.th().Warning,
+			status.Submodules,
+			noun,
+			r.colors.Reset))
+	}
+
 	// Remote tracking status
 	if status.Ahead > 0 || status.Behind > 0 {
 		var remoteParts []string
 		if status.Ahead > 0 {
-			remoteParts = append(remoteParts, fmt.Sprintf("↑%d", status.Ahead))
+			remoteParts = append(remoteParts, fmt.Sprintf("%s%d", r.sym().Ahead, status.Ahead))
 		}
 		if status.Behind > 0 {
-			remoteParts = append(remoteParts, fmt.Sprintf("↓%d", status.Behind))
+			remoteParts = append(remoteParts, fmt.Sprintf("%s%d", r.sym().Behind, status.Behind))
 		}
 
 		remotePart := fmt.Sprintf("%s%s%s",
-			r.colors.BrightMagenta+r.colors.Bold,
+			r.th().Remote,
 			strings.Join(remoteParts, " "),
 			r.colors.Reset)
 		parts = append(parts, remotePart)
 	}
 
+	// Branch name, truncated so the whole line fits on one row
+	branchPrefix := r.sym().Branch
+	reserved := uiutil.DisplayWidth(branchPrefix)
+	for _, part := range parts {
+		reserved += uiutil.DisplayWidth(part) + 2
+	}
+	branchPart := fmt.Sprintf("%s%s%s%s%s",
+		r.th().Branch,
+		branchPrefix,
+		r.th().Emphasis,
+		r.fitWidth(status.Branch, reserved),
+		r.colors.Reset)
+	parts = append([]string{branchPart}, parts...)
+
 	// Render the status line
 	statusLine := strings.Join(parts, "  ")
 	r.writeColorln(ui, statusLine)
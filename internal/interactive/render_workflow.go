@@ -3,6 +3,16 @@ package interactive
 import (
 	"fmt"
 	"strings"
+
+	uiutil "github.com/bmf-san/ggc/v8/internal/ui"
+)
+
+const (
+	// workflowSummaryChrome is the width reserved on a workflow summary line
+	// for the markers, step count and active label.
+	workflowSummaryChrome = 32
+	// workflowStepChrome is the width reserved for a step's number and indent.
+	workflowStepChrome = 8
 )
 
 func workflowLineCounts(summaries []WorkflowSummary, maxStepPreview int) []int {
@@ -79,11 +89,11 @@ func (r *Renderer) renderWorkflowList(ui *UI, state *UIState) {
 	summaries := ui.listWorkflows()
 	ui.ensureWorkflowListSelection()
 
-	r.writeColorln(ui, fmt.Sprintf("%s📋 Workflows%s", r.colors.BrightYellow+r.colors.Bold, r.colors.Reset))
+	r.writeColorln(ui, fmt.Sprintf("%s%sWorkflows%s", r.th().Heading, r.sym().Workflow, r.colors.Reset))
 
 	if len(summaries) == 0 {
 		r.writeColorln(ui, fmt.Sprintf("  %sNo workflows yet. Press Ctrl+N to create a workflow.%s",
-			r.colors.BrightBlack, r.colors.Reset))
+			r.th().Muted, r.colors.Reset))
 		return
 	}
 
@@ -107,31 +117,32 @@ func (r *Renderer) renderWorkflowSummary(ui *UI, state *UIState, summary Workflo
 	if displayName == "" {
 		displayName = fmt.Sprintf("W%d", summary.ID)
 	}
+	displayName = r.fitWidth(displayName, workflowSummaryChrome)
 
 	activePrefix := " "
 	if summary.IsActive {
-		activePrefix = fmt.Sprintf("%s▶%s", r.colors.BrightCyan+r.colors.Bold, r.colors.Reset)
+		activePrefix = fmt.Sprintf("%s%s%s", r.th().Pointer, r.sym().Pointer, r.colors.Reset)
 	}
 
 	selectPrefix := " "
 	if state.workflowListIdx == index {
-		selectPrefix = fmt.Sprintf("%s>%s", r.colors.BrightWhite+r.colors.Bold, r.colors.Reset)
+		selectPrefix = fmt.Sprintf("%s>%s", r.th().Emphasis, r.colors.Reset)
 	}
 
 	activeLabel := ""
 	if summary.IsActive {
-		activeLabel = fmt.Sprintf(" %s[Active]%s", r.colors.BrightCyan, r.colors.Reset)
+		activeLabel = fmt.Sprintf(" %s[Active]%s", r.th().Pointer, r.colors.Reset)
 	}
 
 	line := fmt.Sprintf("%s%s %s%s%s %s(%d step%s)%s%s",
 		selectPrefix,
 		activePrefix,
-		r.colors.BrightWhite+r.colors.Bold,
+		r.th().Emphasis,
 		displayName,
 		r.colors.Reset,
-		r.colors.BrightBlack,
+		r.th().Muted,
 		summary.StepCount,
-		pluralize(summary.StepCount),
+		uiutil.Pluralize(summary.StepCount),
 		r.colors.Reset,
 		activeLabel,
 	)
@@ -161,17 +172,17 @@ func (r *Renderer) renderWorkflowStepPreview(ui *UI, steps []WorkflowStep, maxSt
 			}
 		}
 		stepLine := fmt.Sprintf("  %s%d.%s %s%s%s",
-			r.colors.BrightBlue+r.colors.Bold,
+			r.th().Accent,
 			s+1,
 			r.colors.Reset,
-			r.colors.BrightGreen,
-			description,
+			r.th().Command,
+			r.fitWidth(description, workflowStepChrome),
 			r.colors.Reset)
 		r.writeColorln(ui, stepLine)
 	}
 	if len(steps) > previewCount {
 		r.writeColorln(ui, fmt.Sprintf("  %s... +%d more%s",
-			r.colors.BrightBlack,
+			r.th().Muted,
 			len(steps)-previewCount,
 			r.colors.Reset))
 	}
@@ -189,18 +200,19 @@ func (r *Renderer) renderWorkflowModeKeybinds(_ *UI, _ *UIState) {
 		{"x", "Execute active workflow"},
 		{"Ctrl+n/p", "Navigate workflows"},
 		{"Ctrl+t", "Return to Search Mode"},
+		{"?", "Show all keybindings"},
 		{"Ctrl+c", "Quit"},
 	}
 
-	r.writeColorln(nil, fmt.Sprintf("%s⌨️  %sWorkflow mode keybinds:%s",
-		r.colors.BrightBlue, r.colors.BrightWhite+r.colors.Bold, r.colors.Reset))
+	r.writeColorln(nil, fmt.Sprintf("%s%s%sWorkflow mode keybinds:%s",
+		r.th().Accent, r.sym().Keyboard, r.th().Emphasis, r.colors.Reset))
 
 	for _, kb := range keybinds {
 		r.writeColorln(nil, fmt.Sprintf("   %s%s%s  %s%s%s",
-			r.colors.BrightGreen+r.colors.Bold,
+			r.th().Key,
 			kb.key,
 			r.colors.Reset,
-			r.colors.BrightBlack,
+			r.th().Muted,
 			kb.desc,
 			r.colors.Reset))
 	}
@@ -208,95 +220,147 @@ func (r *Renderer) renderWorkflowModeKeybinds(_ *UI, _ *UIState) {
 
 // renderCommandList renders the filtered command list
 func (r *Renderer) renderCommandList(ui *UI, state *UIState) {
-	// Clamp selection index to valid range
-	if state.selected >= len(state.filtered) {
-		state.selected = len(state.filtered) - 1
-	}
-	if state.selected < 0 {
-		state.selected = 0
-	}
+	state.clampSelection()
 
 	// Calculate maximum command length for consistent alignment
 	maxCmdLen := r.calculateMaxCommandLength(state.filtered)
 
 	for i, cmd := range state.filtered {
-		r.renderCommandItem(ui, cmd, i, state.selected, maxCmdLen)
+		r.renderCommandItem(ui, cmd, state.matchPositions(i), i, state.selected, maxCmdLen)
 	}
 }
 
-// renderCommandItem renders a single command item
-func (r *Renderer) renderCommandItem(ui *UI, cmd CommandInfo, index, selected, maxCmdLen int) {
+// renderCommandItem renders a single command item, highlighting the runes at matches
+func (r *Renderer) renderCommandItem(ui *UI, cmd CommandInfo, matches []int, index, selected, maxCmdLen int) {
 	desc := cmd.Description
 	if desc == "" {
 		desc = "No description"
 	}
 
 	// Calculate padding for consistent command alignment
-	paddingLen := maxCmdLen - len(cmd.Command)
+	cmdWidth := uiutil.DisplayWidth(cmd.Command)
+	paddingLen := maxCmdLen - cmdWidth
 	if paddingLen < 0 {
 		paddingLen = 0
 	}
 	padding := strings.Repeat(" ", paddingLen)
 
+	tag, tagWidth := r.kindTag(cmd.Kind)
+
 	// Calculate available width for description
-	usedWidth := 4 + len(cmd.Command) + len(padding) + 3 // prefix + command + padding + separator
+	usedWidth := 4 + cmdWidth + len(padding) + 3 + tagWidth // prefix + command + padding + separator + marker
 	availableDescWidth := r.width - usedWidth
 	if availableDescWidth < 10 {
 		availableDescWidth = 10
 	}
 
 	// Truncate description if needed
-	trimmedDesc := ellipsis(desc, availableDescWidth)
+	trimmedDesc := uiutil.TruncateToWidth(desc, availableDescWidth)
 
 	if index == selected {
 		// Selected item with modern highlighting
-		selectedLine := fmt.Sprintf("%s▶ %s%s%s%s %s│%s %s%s%s",
-			r.colors.BrightCyan+r.colors.Bold,
-			r.colors.BrightWhite+r.colors.Bold+r.colors.Reverse,
-			" "+cmd.Command+" ",
+		selectedStyle := r.th().Selected
+		selectedLine := fmt.Sprintf("%s%s %s%s%s%s %s%s%s %s%s%s%s",
+			r.th().Pointer,
+			r.sym().Pointer,
+			selectedStyle,
+			" "+r.highlightMatches(cmd.Command, matches, selectedStyle, r.th().Match)+" ",
 			r.colors.Reset,
 			padding,
-			r.colors.BrightBlue,
+			r.th().Accent,
+			r.sym().Separator,
 			r.colors.Reset,
-			r.colors.BrightWhite,
+			tag,
+			r.th().Text,
 			trimmedDesc,
 			r.colors.Reset)
 		r.writeColorln(ui, selectedLine)
 	} else {
 		// Regular item with improved styling
-		regularLine := fmt.Sprintf("  %s%s%s%s %s│%s %s%s%s",
-			r.colors.BrightGreen+r.colors.Bold,
-			cmd.Command,
+		regularStyle := r.th().Command
+		regularLine := fmt.Sprintf("  %s%s%s%s %s%s%s %s%s%s%s",
+			regularStyle,
+			r.highlightMatches(cmd.Command, matches, regularStyle, r.th().Match),
 			r.colors.Reset,
 			padding,
-			r.colors.BrightBlack,
+			r.th().Muted,
+			r.sym().Separator,
 			r.colors.Reset,
-			r.colors.BrightBlack,
+			tag,
+			r.th().Muted,
 			trimmedDesc,
 			r.colors.Reset)
 		r.writeColorln(ui, regularLine)
 	}
 }
 
+// kindTag returns the marker shown before the description of aliases and
+// workflows, along with its display width.
+func (r *Renderer) kindTag(kind CommandKind) (string, int) {
+	var label, color string
+	switch kind {
+	case KindAlias:
+		label, color = "[alias]", r.th().Alias
+	case KindWorkflow:
+		label, color = "[workflow]", r.th().Workflow
+	default:
+		return "", 0
+	}
+	return color + label + r.colors.Reset + " ", len(label) + 1
+}
+
+// highlightMatches applies highlight to the runes of text at positions. The
+// base style is re-applied after each highlighted run so the caller's styling
+// continues uninterrupted.
+func (r *Renderer) highlightMatches(text string, positions []int, base, highlight string) string {
+	if len(positions) == 0 {
+		return text
+	}
+	matched := make(map[int]bool, len(positions))
+	for _, pos := range positions {
+		matched[pos] = true
+	}
+
+	var b strings.Builder
+	inMatch := false
+	for i, ch := range []rune(text) {
+		if matched[i] != inMatch {
+			if matched[i] {
+				b.WriteString(highlight)
+			} else {
+				b.WriteString(r.colors.Reset + base)
+			}
+			inMatch = matched[i]
+		}
+		b.WriteRune(ch)
+	}
+	if inMatch {
+		b.WriteString(r.colors.Reset + base)
+	}
+	return b.String()
+}
+
 // renderWorkflowView renders the detailed workflow view
 func (r *Renderer) renderWorkflowView(ui *UI, _ *UIState) {
 	if ui == nil {
-		r.writeColorln(ui, fmt.Sprintf("%s📋 Workflow Details (0 steps)%s",
-			r.colors.BrightYellow+r.colors.Bold,
+		r.writeColorln(ui, fmt.Sprintf("%s%sWorkflow Details (0 steps)%s",
+			r.th().Heading,
+			r.sym().Workflow,
 			r.colors.Reset))
 		r.writeColorln(ui, fmt.Sprintf("%s  No active workflow%s",
-			r.colors.BrightBlack,
+			r.th().Muted,
 			r.colors.Reset))
 		r.writeColorln(ui, "")
 		return
 	}
 	wf := ui.activeWorkflow()
 	if wf == nil {
-		r.writeColorln(ui, fmt.Sprintf("%s📋 Workflow Details (0 steps)%s",
-			r.colors.BrightYellow+r.colors.Bold,
+		r.writeColorln(ui, fmt.Sprintf("%s%sWorkflow Details (0 steps)%s",
+			r.th().Heading,
+			r.sym().Workflow,
 			r.colors.Reset))
 		r.writeColorln(ui, fmt.Sprintf("%s  No active workflow%s",
-			r.colors.BrightBlack,
+			r.th().Muted,
 			r.colors.Reset))
 		r.writeColorln(ui, "")
 		return
@@ -304,15 +368,16 @@ func (r *Renderer) renderWorkflowView(ui *UI, _ *UIState) {
 	steps := wf.GetSteps()
 
 	// Detailed workflow header
-	r.writeColorln(ui, fmt.Sprintf("%s📋 Workflow Details (%d steps)%s",
-		r.colors.BrightYellow+r.colors.Bold,
+	r.writeColorln(ui, fmt.Sprintf("%s%sWorkflow Details (%d steps)%s",
+		r.th().Heading,
+		r.sym().Workflow,
 		len(steps),
 		r.colors.Reset))
 	r.writeColorln(ui, "")
 
 	if len(steps) == 0 {
 		r.writeColorln(ui, fmt.Sprintf("%s  No steps in workflow%s",
-			r.colors.BrightBlack,
+			r.th().Muted,
 			r.colors.Reset))
 		r.writeColorln(ui, "")
 		return
@@ -321,11 +386,11 @@ func (r *Renderer) renderWorkflowView(ui *UI, _ *UIState) {
 	// Render all workflow steps
 	for i, step := range steps {
 		stepLine := fmt.Sprintf("  %s%d.%s %s%s%s",
-			r.colors.BrightBlue+r.colors.Bold,
+			r.th().Accent,
 			i+1,
 			r.colors.Reset,
-			r.colors.BrightGreen+r.colors.Bold,
-			step.Description,
+			r.th().Command,
+			r.fitWidth(step.Description, workflowStepChrome),
 			r.colors.Reset)
 		r.writeColorln(ui, stepLine)
 	}
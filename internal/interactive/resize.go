@@ -0,0 +1,72 @@
+package interactive
+
+import (
+	"os"
+	"os/signal"
+	"time"
+
+	"github.com/bmf-san/ggc/v8/internal/termio"
+	uiutil "github.com/bmf-san/ggc/v8/internal/ui"
+)
+
+// inputPollInterval is how often the main loop checks for a resize or a
+// finished preview while waiting for the next key.
+const inputPollInterval = 50 * time.Millisecond
+
+// watchResize flags the UI for a full re-render whenever the terminal is
+// resized. Where the platform has no resize signal the size is polled
+// instead. The returned function stops watching.
+func (ui *UI) watchResize() func() {
+	ch := make(chan os.Signal, 1)
+	if !termio.NotifyResize(ch) {
+		ui.pollSize = true
+		return func() { ui.pollSize = false }
+	}
+	done := make(chan struct{})
+	go func() {
+		for {
+			select {
+			case <-ch:
+				ui.resized.Store(true)
+			case <-done:
+				return
+			}
+		}
+	}()
+	return func() {
+		signal.Stop(ch)
+		close(done)
+	}
+}
+
+// needsRedraw reports whether the screen is stale because the terminal was
+// resized or a preview result arrived since the last render.
+func (ui *UI) needsRedraw() bool {
+	redraw := ui.resized.Swap(false)
+	if ui.pollSize && ui.renderer != nil {
+		w, h := uiutil.Dimensions(ui.renderer.writer, 80, 24)
+		redraw = redraw || w != ui.renderer.width || h != ui.renderer.height
+	}
+	if ui.preview != nil && ui.preview.takeDirty() {
+		redraw = true
+	}
+	return redraw
+}
+
+// waitForInput blocks until a key is available, re-rendering in the meantime
+// whenever the screen goes stale so that typing is never delayed.
+func (ui *UI) waitForInput() {
+	f, ok := ui.stdin.(*os.File)
+	if !ok {
+		return
+	}
+	for {
+		ready, err := termio.WaitForInput(f.Fd(), inputPollInterval)
+		if err != nil || ready {
+			return
+		}
+		if ui.needsRedraw() {
+			ui.renderer.Render(ui, ui.state)
+		}
+	}
+}
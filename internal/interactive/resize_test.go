@@ -0,0 +1,65 @@
+package interactive
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	uiutil "github.com/bmf-san/ggc/v8/internal/ui"
+)
+
+func TestRenderGitStatus_TruncatesBranchToWidth(t *testing.T) {
+	var buf bytes.Buffer
+	renderer := &Renderer{writer: &buf, colors: NewANSIColors(), width: 30, height: 24}
+	status := &GitStatus{Branch: "feature/" + strings.Repeat("very-long-", 6), Ahead: 2}
+
+	renderer.renderGitStatus(&UI{}, status)
+
+	line := strings.TrimSuffix(strings.TrimPrefix(buf.String(), "\r\x1b[K"), "\r\n")
+	if got := uiutil.DisplayWidth(line); got > 30 {
+		t.Errorf("status line is %d columns wide, want <= 30: %q", got, line)
+	}
+	if !strings.Contains(line, "…") || !strings.Contains(line, "↑2") {
+		t.Errorf("expected truncated branch and ahead count, got %q", line)
+	}
+}
+
+func TestRenderCommandItem_TruncatesWideDescription(t *testing.T) {
+	var buf bytes.Buffer
+	renderer := &Renderer{writer: &buf, colors: NewANSIColors(), width: 40, height: 24}
+	cmd := CommandInfo{Command: "status", Description: strings.Repeat("作業ツリーの状態を表示", 4)}
+
+	renderer.renderCommandItem(nil, cmd, nil, 0, 1, 6)
+
+	line := strings.TrimSuffix(strings.TrimPrefix(buf.String(), "\r\x1b[K"), "\r\n")
+	if got := uiutil.DisplayWidth(line); got > 40 {
+		t.Errorf("command line is %d columns wide, want <= 40: %q", got, line)
+	}
+}
+
+func TestNeedsRedraw_AfterResize(t *testing.T) {
+	ui := &UI{renderer: &Renderer{colors: NewANSIColors(), width: 80, height: 24}}
+	if ui.needsRedraw() {
+		t.Fatal("needsRedraw without a resize should be false")
+	}
+	ui.resized.Store(true)
+	if !ui.needsRedraw() {
+		t.Fatal("needsRedraw after a resize should be true")
+	}
+	if ui.needsRedraw() {
+		t.Fatal("resize flag should be consumed by needsRedraw")
+	}
+}
+
+func TestNeedsRedraw_PollsSizeWithoutSignals(t *testing.T) {
+	var buf bytes.Buffer
+	// Non-terminal writers report the 80x24 fallback size.
+	ui := &UI{pollSize: true, renderer: &Renderer{writer: &buf, width: 120, height: 40}}
+	if !ui.needsRedraw() {
+		t.Fatal("a changed size should trigger a redraw")
+	}
+	ui.renderer.updateSize()
+	if ui.needsRedraw() {
+		t.Fatal("an unchanged size should not trigger a redraw")
+	}
+}
@@ -2,10 +2,14 @@
 package interactive
 
 import (
+	"fmt"
+	"slices"
 	"sort"
 	"strings"
+	"time"
 
 	kb "github.com/bmf-san/ggc/v8/internal/keybindings"
+	uiutil "github.com/bmf-san/ggc/v8/internal/ui"
 )
 
 // UIMode describes the high-level mode of the interactive UI.
@@ -16,6 +20,10 @@ const (
 	ModeSearch UIMode = iota
 	// ModeWorkflow renders the dedicated workflow management interface.
 	ModeWorkflow
+	// ModeOutput renders the captured output of the last executed command.
+	ModeOutput
+	// ModeBrowse renders the commands grouped by category.
+	ModeBrowse
 )
 
 // WorkflowFocus indicates which pane in workflow mode has focus.
@@ -35,13 +43,20 @@ type UIState struct {
 	cursorPos       int           // Cursor position in input string
 	commands        []CommandInfo // injected by NewUI; never modified after init
 	filtered        []CommandInfo
-	context         kb.Context   // Current UI context (input/results/search/global)
-	contextStack    []kb.Context // Context stack for nested states
+	matches         [][]int // matched rune indices, parallel to filtered
+	history         *CommandHistory
+	recentView      bool          // filtered holds recent command lines rather than commands
+	suggestions     []CommandInfo // commands for the operation in progress, listed before recent lines
+	context         kb.Context    // Current UI context (input/results/search/global)
+	contextStack    []kb.Context  // Context stack for nested states
 	onContextChange func(kb.Context, kb.Context)
 	mode            UIMode
 	workflowFocus   WorkflowFocus
 	workflowListIdx int
 	workflowOffset  int
+	output          *outputView      // set while in ModeOutput
+	help            *helpOverlay     // set while the keyboard help overlay is open
+	browser         *categoryBrowser // set while in ModeBrowse
 }
 
 // SetMode switches between search and workflow modes.
@@ -57,6 +72,16 @@ func (s *UIState) IsWorkflowMode() bool {
 	return s.mode == ModeWorkflow
 }
 
+// IsOutputMode reports whether the UI is showing command output.
+func (s *UIState) IsOutputMode() bool {
+	return s.mode == ModeOutput
+}
+
+// IsBrowseMode reports whether the UI is showing the category browser.
+func (s *UIState) IsBrowseMode() bool {
+	return s.mode == ModeBrowse
+}
+
 // FocusInput moves focus to the command input/results pane.
 func (s *UIState) FocusInput() {
 	s.workflowFocus = FocusInput
@@ -91,30 +116,46 @@ func (s *UIState) SetWorkflowListIndex(idx, total int) {
 // UpdateFiltered updates the filtered commands based on current input using fuzzy matching
 func (s *UIState) UpdateFiltered() {
 	input := strings.ToLower(s.input)
+	s.recentView = false
 	if input == "" {
-		s.filtered = make([]CommandInfo, len(s.commands))
-		copy(s.filtered, s.commands)
+		s.matches = nil
+		recent := s.history.Recent(recentLimit)
+		if len(recent) > 0 || len(s.suggestions) > 0 {
+			s.filtered = s.suggestionsWithRecent(recent)
+			s.recentView = true
+		} else {
+			s.filtered = make([]CommandInfo, len(s.commands))
+			copy(s.filtered, s.commands)
+		}
 	} else {
 		type match struct {
-			info  CommandInfo
-			score matchScore
+			info      CommandInfo
+			score     matchScore
+			positions []int
 		}
 		matches := make([]match, 0, len(s.commands))
 		for _, cmd := range s.commands {
 			cmdLower := strings.ToLower(cmd.Command)
-			if ok, score := fuzzyMatchScore(cmdLower, input); ok {
-				matches = append(matches, match{info: cmd, score: score})
+			if ok, score, positions := fuzzyMatchScore(cmdLower, input); ok {
+				score.points += min(s.history.Frecency(cmd.Command), frecencyBonusCap)
+				matches = append(matches, match{info: cmd, score: score, positions: positions})
 			}
 		}
 		sort.SliceStable(matches, func(i, j int) bool {
 			return matches[i].score.less(matches[j].score)
 		})
 		s.filtered = make([]CommandInfo, len(matches))
+		s.matches = make([][]int, len(matches))
 		for i, match := range matches {
 			s.filtered[i] = match.info
+			s.matches[i] = match.positions
 		}
 	}
-	// Reset selection if out of bounds
+	s.clampSelection()
+}
+
+// clampSelection keeps the selection within the filtered list.
+func (s *UIState) clampSelection() {
 	if s.selected >= len(s.filtered) {
 		s.selected = len(s.filtered) - 1
 	}
@@ -123,6 +164,59 @@ func (s *UIState) UpdateFiltered() {
 	}
 }
 
+// recentCommandInfos converts history entries into list items whose command is
+// the full recorded line.
+func recentCommandInfos(recent []HistoryEntry, now time.Time) []CommandInfo {
+	infos := make([]CommandInfo, len(recent))
+	for i, entry := range recent {
+		infos[i] = CommandInfo{
+			Command:     entry.Line,
+			Description: fmt.Sprintf("run %d time%s, %s", entry.Count, uiutil.Pluralize(entry.Count), formatAge(now.Sub(entry.LastUsed))),
+		}
+	}
+	return infos
+}
+
+// setSuggestions offers commands first while the search is empty. Commands
+// that are not registered are skipped.
+func (s *UIState) setSuggestions(commands []string) {
+	s.suggestions = nil
+	for _, command := range commands {
+		for _, cmd := range s.commands {
+			if cmd.Command == command {
+				s.suggestions = append(s.suggestions, cmd)
+				break
+			}
+		}
+	}
+}
+
+// suggestionsWithRecent lists the suggestions followed by the recent command
+// lines that are not already suggested.
+func (s *UIState) suggestionsWithRecent(recent []HistoryEntry) []CommandInfo {
+	infos := slices.Clone(s.suggestions)
+	if len(recent) == 0 {
+		return infos
+	}
+	for _, info := range recentCommandInfos(recent, s.history.now()) {
+		if !slices.ContainsFunc(s.suggestions, func(c CommandInfo) bool { return c.Command == info.Command }) {
+			infos = append(infos, info)
+		}
+	}
+	return infos
+}
+
+// IsSuggestionSelected reports whether the selected item of the empty search
+// is a suggested command rather than a recent command line.
+func (s *UIState) IsSuggestionSelected() bool {
+	return s.input == "" && s.recentView && s.selected < len(s.suggestions)
+}
+
+// IsRecentView reports whether the list shows recent command lines.
+func (s *UIState) IsRecentView() bool {
+	return s.recentView
+}
+
 // Context Management Methods
 
 // EnterContext pushes the current context onto the stack and switches to the new context
@@ -219,6 +313,14 @@ func (s *UIState) GetSelectedCommand() *CommandInfo {
 	return nil
 }
 
+// matchPositions returns the matched rune indices for the filtered entry at index.
+func (s *UIState) matchPositions(index int) []int {
+	if index < 0 || index >= len(s.matches) {
+		return nil
+	}
+	return s.matches[index]
+}
+
 // HasInput returns true if there is input
 func (s *UIState) HasInput() bool {
 	return s.input != ""
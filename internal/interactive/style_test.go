@@ -0,0 +1,35 @@
+package interactive
+
+import (
+	"strings"
+	"testing"
+	"unicode"
+
+	kb "github.com/bmf-san/ggc/v8/internal/keybindings"
+	uiutil "github.com/bmf-san/ggc/v8/internal/ui"
+)
+
+func TestRender_PlainStyleIsASCII(t *testing.T) {
+	defer uiutil.SetStyle(uiutil.CurrentStyle())
+	uiutil.SetStyle(uiutil.StylePlain)
+
+	ui, _ := newHelpTestUI(kb.DefaultKeyBindingMap())
+	ui.gitStatus = &GitStatus{Branch: "main", Modified: 1, Ahead: 2, HasChanges: true}
+	typeKeys(ui, "st")
+
+	var buf strings.Builder
+	ui.renderer.writer = &buf
+	ui.renderer.Render(ui, ui.state)
+	out := uiutil.StripANSI(buf.String())
+
+	for _, r := range out {
+		if r > unicode.MaxASCII {
+			t.Fatalf("plain output contains non-ASCII rune %q:\n%s", r, out)
+		}
+	}
+	for _, want := range []string{"ggc Interactive Mode", "Search: st", "ahead 2", "> "} {
+		if !strings.Contains(out, want) {
+			t.Errorf("plain output missing %q:\n%s", want, out)
+		}
+	}
+}
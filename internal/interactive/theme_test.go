@@ -0,0 +1,46 @@
+package interactive
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/bmf-san/ggc/v8/internal/config"
+	"github.com/bmf-san/ggc/v8/internal/testutil"
+	uiutil "github.com/bmf-san/ggc/v8/internal/ui"
+)
+
+func TestNewUI_AppliesConfiguredTheme(t *testing.T) {
+	cfg := &config.Config{}
+	cfg.UI.Theme.Name = "light"
+	cfg.UI.Theme.Branch = "#ff8800"
+
+	ui := NewUI(testutil.NewMockGitClient(), nil, cfg)
+
+	want, _ := uiutil.NewTheme("light", map[string]string{"branch": "#ff8800"})
+	if *ui.theme != *want || ui.renderer.theme != ui.theme {
+		t.Errorf("UI and renderer should share the configured theme, got %+v", ui.renderer.theme)
+	}
+}
+
+func TestRenderer_UsesThemeRoles(t *testing.T) {
+	theme, err := uiutil.NewTheme("solarized", nil)
+	if err != nil {
+		t.Fatalf("NewTheme: %v", err)
+	}
+	var buf bytes.Buffer
+	r := &Renderer{writer: &buf, colors: NewANSIColors(), theme: theme, width: 80, height: 24}
+
+	r.renderGitStatus(nil, &GitStatus{Branch: "main"})
+	r.renderCommandItem(nil, CommandInfo{Command: "status", Description: "Show status"}, []int{0}, 0, 0, 6)
+
+	out := buf.String()
+	for role, seq := range map[string]string{"branch": theme.Branch, "selected": theme.Selected, "match": theme.Match} {
+		if !strings.Contains(out, seq) {
+			t.Errorf("output does not use the %s role %q:\n%q", role, seq, out)
+		}
+	}
+	if strings.Contains(out, r.colors.BrightYellow) {
+		t.Errorf("output should not use hard-coded palette colors:\n%q", out)
+	}
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/bmf-san/ggc/v8/internal/git"
 	kb "github.com/bmf-san/ggc/v8/internal/keybindings"
 	"github.com/bmf-san/ggc/v8/internal/termio"
+	uiutil "github.com/bmf-san/ggc/v8/internal/ui"
 )
 
 // initialInputCapacity defines the initial capacity for the input rune buffer
@@ -30,13 +31,21 @@ type UI struct {
 	state           *UIState
 	handler         *KeyHandler
 	colors          *ANSIColors
+	symbols         *Symbols
+	theme           *Theme
 	gitStatus       *GitStatus
 	gitClient       git.StatusInfoReader
 	reader          *bufio.Reader
 	profile         kb.Profile
 	workflowMgr     *WorkflowManager
 	workflowEx      *WorkflowExecutor
+	preview         *previewPane
+	commandKeys     []commandKey
+	pinned          []string // commands pinned in the category browser
+	pinStore        PinStore
 	softCancelFlash atomic.Bool
+	resized         atomic.Bool // set on SIGWINCH; the next wait re-renders
+	pollSize        bool        // no resize signal; compare sizes while waiting
 	workflowError   string
 	errorExpiresAt  time.Time
 	workflowNotice  string
@@ -50,10 +59,12 @@ type UI struct {
 // used by the standalone Run helper).
 func NewUI(gitClient git.StatusInfoReader, commands []CommandInfo, cfg *config.Config, router ...CommandRouter) *UI {
 	colors := NewANSIColors()
+	symbols := uiutil.NewSymbols()
 
 	renderer := &Renderer{
-		writer: os.Stdout,
-		colors: colors,
+		writer:  os.Stdout,
+		colors:  colors,
+		symbols: symbols,
 	}
 	renderer.updateSize()
 
@@ -70,6 +81,7 @@ func NewUI(gitClient git.StatusInfoReader, commands []CommandInfo, cfg *config.C
 		mode:           ModeSearch,
 		workflowFocus:  FocusInput,
 		workflowOffset: 0,
+		history:        loadCommandHistory(gitClient),
 	}
 
 	// Use the provided config or load from gitClient when not supplied
@@ -86,6 +98,13 @@ func NewUI(gitClient git.StatusInfoReader, commands []CommandInfo, cfg *config.C
 		}
 	}
 
+	theme, err := uiutil.NewTheme(cfg.UI.Theme.Name, cfg.UI.Theme.Overrides())
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Warning: %v, using default theme\n", err)
+		theme = uiutil.DefaultTheme()
+	}
+	renderer.theme = theme
+
 	// Create KeyBinding resolver and register built-in profiles
 	resolver := kb.NewKeyBindingResolver(cfg)
 	kb.RegisterBuiltinProfiles(resolver)
@@ -134,12 +153,23 @@ func NewUI(gitClient git.StatusInfoReader, commands []CommandInfo, cfg *config.C
 		renderer:    renderer,
 		state:       state,
 		colors:      colors,
+		symbols:     symbols,
+		theme:       theme,
 		gitClient:   gitClient,
-		gitStatus:   getGitStatus(gitClient),
 		profile:     profile,
 		workflowMgr: workflowMgr,
+		preview:     newPreviewPane(gitClient),
 	}
 
+	ui.refreshGitStatus()
+
+	commandKeys, errs := resolveCommandKeys(cfg.Interactive.Commands, commands, contextualMap)
+	for _, err := range errs {
+		fmt.Fprintf(os.Stderr, "Warning: %v; ignoring\n", err)
+	}
+	ui.commandKeys = commandKeys
+	ui.pinned = cfg.Interactive.Pinned
+
 	// Keep ContextManager alive via the onContextChange callback so it stays
 	// in sync with UIState; the field was removed from UI (Problem I fix).
 	state.onContextChange = func(_ kb.Context, newCtx kb.Context) {
@@ -154,11 +184,30 @@ func NewUI(gitClient git.StatusInfoReader, commands []CommandInfo, cfg *config.C
 	// Set up workflow executor if router is provided
 	if len(router) > 0 && router[0] != nil {
 		ui.workflowEx = NewWorkflowExecutor(router[0], ui)
+		ui.pinStore, _ = router[0].(PinStore)
 	}
 
 	return ui
 }
 
+// loadCommandHistory opens the history for the current repository, or returns
+// nil when the client cannot report a repository root.
+func loadCommandHistory(gitClient git.StatusInfoReader) *CommandHistory {
+	rootReader, ok := gitClient.(git.RepositoryRootReader)
+	if !ok {
+		return nil
+	}
+	root, err := rootReader.GetRepositoryRoot()
+	if err != nil {
+		return nil
+	}
+	path, err := historyPathForRepo(root)
+	if err != nil {
+		return nil
+	}
+	return NewCommandHistory(path)
+}
+
 // Run executes the incremental search interactive UI with the provided custom git client,
 // and returns the selected command as []string (or nil if nothing is selected).
 func Run(gitClient git.StatusInfoReader) []string {
@@ -1,11 +1,29 @@
 package interactive
 
+// CommandKind identifies where an interactive search entry comes from.
+type CommandKind int
+
+const (
+	// KindCommand is a built-in ggc command.
+	KindCommand CommandKind = iota
+	// KindAlias is a user alias from the config; Command starts with the alias name.
+	KindAlias
+	// KindWorkflow is a named workflow from the config; Command is the workflow name.
+	KindWorkflow
+)
+
+// WorkflowExecutedCommand is returned as the command name by UI.Run after a
+// named workflow has been executed from search mode.
+const WorkflowExecutedCommand = "workflow-executed"
+
 // CommandInfo contains the name and description of a command available in
 // interactive mode. The list is injected at construction time via NewUI so
 // that this package does not depend on the cmd layer.
 type CommandInfo struct {
 	Command     string
 	Description string
+	Kind        CommandKind
+	Category    string // category of a built-in command, used by the category browser
 }
 
 // extractPlaceholders extracts <...> placeholders from a string
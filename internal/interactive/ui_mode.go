@@ -44,8 +44,14 @@ func (ui *UI) resetToSearchMode() bool {
 	}
 
 	state := ui.state
-	active := state.HasInput() || state.IsWorkflowMode() || len(state.contextStack) > 0 || state.GetCurrentContext() != kb.ContextGlobal
+	active := state.HasInput() || state.IsWorkflowMode() || state.IsOutputMode() || state.IsBrowseMode() || len(state.contextStack) > 0 || state.GetCurrentContext() != kb.ContextGlobal
 	state.ClearInput()
+	state.output = nil
+	state.browser = nil
+	if ui.preview != nil {
+		// The repository may have changed; refresh the preview on next render.
+		ui.preview.stop()
+	}
 	state.selected = 0
 	state.contextStack = nil
 	state.SetContext(kb.ContextGlobal)
@@ -3,6 +3,8 @@ package interactive
 import (
 	"fmt"
 	"time"
+
+	uiutil "github.com/bmf-san/ggc/v8/internal/ui"
 )
 
 // writeError writes an error message to stderr
@@ -10,6 +12,22 @@ func (ui *UI) writeError(format string, a ...interface{}) {
 	_, _ = fmt.Fprintf(ui.stderr, format+"\n", a...)
 }
 
+// sym returns the glyphs for the configured ui.style.
+func (ui *UI) sym() *Symbols {
+	if ui.symbols == nil {
+		ui.symbols = uiutil.NewSymbols()
+	}
+	return ui.symbols
+}
+
+// th returns the colors for the configured ui.theme.
+func (ui *UI) th() *Theme {
+	if ui.theme == nil {
+		ui.theme = uiutil.DefaultTheme()
+	}
+	return ui.theme
+}
+
 // write writes a message to stdout
 func (ui *UI) write(format string, a ...interface{}) {
 	_, _ = fmt.Fprintf(ui.stdout, format, a...)
@@ -80,3 +98,15 @@ func (ui *UI) workflowNoticeMessage() string {
 	}
 	return ui.workflowNotice
 }
+
+// recordHistory stores the arguments of an executed command in the
+// repository history.
+// Failures are reported but never block command execution.
+func (ui *UI) recordHistory(template string, args []string) {
+	if ui == nil || ui.state == nil || ui.state.history == nil || len(args) < 2 {
+		return
+	}
+	if err := ui.state.history.Record(template, args[1:]); err != nil {
+		ui.writeError("failed to record command history: %v", err)
+	}
+}
@@ -48,6 +48,11 @@ func (ui *UI) Run() []string {
 		}()
 	}
 
+	if isRawMode {
+		stopWatching := ui.watchResize()
+		defer stopWatching()
+	}
+
 	return ui.runMainLoop(reader, isRawMode, oldState)
 }
 
@@ -82,7 +87,11 @@ func (ui *UI) runMainLoop(reader *bufio.Reader, isRawMode bool, oldState *term.S
 
 	for {
 		ui.state.UpdateFiltered()
+		ui.refreshPreview()
 		ui.renderer.Render(ui, ui.state)
+		if isRawMode {
+			ui.waitForInput()
+		}
 
 		r, err := ui.readNextRune(reader, isRawMode)
 		if err != nil {
@@ -4,6 +4,8 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+
+	uiutil "github.com/bmf-san/ggc/v8/internal/ui"
 )
 
 // CommandRouter represents an interface for routing commands
@@ -38,6 +40,14 @@ func (we *WorkflowExecutor) uiWrite(format string, a ...interface{}) {
 	_, _ = fmt.Printf(format, a...)
 }
 
+// sym returns the glyphs for the configured ui.style.
+func (we *WorkflowExecutor) sym() *Symbols {
+	if we.ui != nil {
+		return we.ui.sym()
+	}
+	return uiutil.NewSymbols()
+}
+
 // Execute runs all steps in the workflow sequentially
 func (we *WorkflowExecutor) Execute(workflow *Workflow) error {
 	steps := workflow.GetSteps()
@@ -46,10 +56,11 @@ func (we *WorkflowExecutor) Execute(workflow *Workflow) error {
 		return fmt.Errorf("workflow is empty")
 	}
 
-	we.uiWrite("🚀 Starting workflow execution (%d steps)\n\n", len(steps))
+	sym := we.sym()
+	we.uiWrite("%sStarting workflow execution (%d steps)\n\n", sym.Launch, len(steps))
 
 	for i, step := range steps {
-		we.uiWrite("📋 Step %d/%d: %s\n", i+1, len(steps), step.String())
+		we.uiWrite("%sStep %d/%d: %s\n", sym.Workflow, i+1, len(steps), step.String())
 
 		// Resolve placeholders in each argument individually to preserve multiword values
 		resolvedArgs, canceled := resolveStepPlaceholders(we.ui, step)
@@ -65,21 +76,21 @@ func (we *WorkflowExecutor) Execute(workflow *Workflow) error {
 		}
 
 		// Show resolved command
-		we.uiWrite("   → Resolved to: %s\n", strings.Join(parts, " "))
+		we.uiWrite("   %s Resolved to: %s\n", sym.Arrow, strings.Join(parts, " "))
 
 		// Execute the resolved command and propagate any routing error
 		if err := we.router.Route(parts); err != nil {
 			return fmt.Errorf("step %d/%d failed: %w", i+1, len(steps), err)
 		}
 
-		we.uiWrite("✅ Step %d completed successfully\n", i+1)
+		we.uiWrite("%sStep %d completed successfully\n", sym.Done, i+1)
 
 		// Add separator between steps (except for the last one)
 		if i < len(steps)-1 {
-			we.uiWrite("─────────────────────────────────────\n")
+			we.uiWrite("%s\n", strings.Repeat(sym.Rule, 37))
 		}
 	}
 
-	we.uiWrite("\n🎉 Workflow completed successfully! (%d steps executed)\n", len(steps))
+	we.uiWrite("\n%sWorkflow completed successfully! (%d steps executed)\n", sym.Celebrate, len(steps))
 	return nil
 }
@@ -183,6 +183,18 @@ func (m *WorkflowManager) GetWorkflow(id int) (*Workflow, bool) {
 	return w.data, true
 }
 
+// FindByName returns the workflow registered under name.
+func (m *WorkflowManager) FindByName(name string) (*Workflow, bool) {
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+	for _, id := range m.order {
+		if w := m.workflows[id]; w != nil && w.name == name {
+			return w.data, true
+		}
+	}
+	return nil, false
+}
+
 // AddStep adds a step to the specified workflow.
 func (m *WorkflowManager) AddStep(id int, command string, args []string, description string) (int, bool) {
 	m.mutex.RLock()
@@ -134,3 +134,19 @@ func TestLoadFromConfig_StepParsing(t *testing.T) {
 		t.Errorf("Description = %q, want %q", s.Description, "push origin main")
 	}
 }
+
+func TestWorkflowManagerFindByName(t *testing.T) {
+	mgr := NewWorkflowManager()
+	mgr.LoadFromConfig(map[string][]string{"sync": {"fetch", "pull"}})
+
+	wf, ok := mgr.FindByName("sync")
+	if !ok || wf == nil {
+		t.Fatal("expected to find workflow loaded from config")
+	}
+	if wf.Size() != 2 {
+		t.Errorf("expected 2 steps, got %d", wf.Size())
+	}
+	if _, ok := mgr.FindByName("missing"); ok {
+		t.Error("expected lookup of unknown workflow to fail")
+	}
+}
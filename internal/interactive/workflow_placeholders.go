@@ -5,6 +5,8 @@ import (
 	"fmt"
 	"os"
 	"strings"
+
+	uiutil "github.com/bmf-san/ggc/v8/internal/ui"
 )
 
 // deriveArgsFromDescription extracts arguments from a description string.
@@ -84,13 +86,13 @@ func interactiveInputForWorkflowUI(ui *UI, placeholders []string) (map[string]st
 		ui.write("\n")
 		if len(placeholders) > 1 {
 			ui.write("%s[%d/%d]%s ",
-				ui.colors.BrightBlue+ui.colors.Bold,
+				ui.th().Accent,
 				i+1, len(placeholders),
 				ui.colors.Reset)
 		}
 		ui.write("%s? %s%s%s: ",
-			ui.colors.BrightGreen,
-			ui.colors.BrightWhite+ui.colors.Bold,
+			ui.th().Prompt,
+			ui.th().Emphasis,
 			ph,
 			ui.colors.Reset)
 
@@ -103,11 +105,12 @@ func interactiveInputForWorkflowUI(ui *UI, placeholders []string) (map[string]st
 		}
 
 		inputs[ph] = value
-		ui.write("%s✓ %s%s: %s%s%s\n",
-			ui.colors.BrightGreen,
-			ui.colors.BrightBlue,
+		ui.write("%s%s%s%s: %s%s%s\n",
+			ui.th().Success,
+			ui.sym().Success,
+			ui.th().Accent,
 			ph,
-			ui.colors.BrightYellow+ui.colors.Bold,
+			ui.th().Input,
 			value,
 			ui.colors.Reset)
 	}
@@ -139,7 +142,7 @@ func interactiveInputForWorkflowScanner(scanner *bufio.Scanner, placeholders []s
 		}
 
 		inputs[ph] = value
-		fmt.Printf("✓ %s: %s\n", ph, value)
+		fmt.Printf("%s%s: %s\n", uiutil.NewSymbols().Success, ph, value)
 	}
 
 	if err := scanner.Err(); err != nil {
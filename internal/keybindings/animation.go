@@ -2,7 +2,10 @@ package keybindings
 
 import (
 	"fmt"
+	"os"
 	"time"
+
+	"github.com/bmf-san/ggc/v8/internal/ui"
 )
 
 // ContextTransitionAnimator provides visual feedback for context transitions
@@ -63,7 +66,7 @@ func (cta *ContextTransitionAnimator) AnimateTransition(from, to Context) {
 
 // fadeTransition performs a fade animation
 func (cta *ContextTransitionAnimator) fadeTransition(from, to Context) {
-	fmt.Printf("\033[2J\033[H") // Clear screen
+	ui.ClearScreen(os.Stdout)
 	fmt.Printf("Transitioning from %s to %s...\n", from, to)
 	time.Sleep(cta.duration)
 }
@@ -76,8 +79,11 @@ func (cta *ContextTransitionAnimator) slideTransition(from, to Context) {
 
 // highlightTransition performs a highlight animation
 func (cta *ContextTransitionAnimator) highlightTransition(from, to Context) {
-	// Use ANSI escape codes for highlighting
-	fmt.Printf("\033[1;33m[%s]\033[0m → \033[1;32m[%s]\033[0m\n", from, to)
+	colors := ui.NewANSIColors()
+	fmt.Printf("%s[%s]%s %s %s[%s]%s\n",
+		colors.Bold+colors.Yellow, from, colors.Reset,
+		ui.NewSymbols().Arrow,
+		colors.Bold+colors.Green, to, colors.Reset)
 }
 
 // RegisterAnimation registers a custom animation function
@@ -6,6 +6,8 @@ import (
 	"strings"
 	"sync"
 	"time"
+
+	"github.com/bmf-san/ggc/v8/internal/ui"
 )
 
 // ShowKeysCommand displays effective keybindings
@@ -88,8 +90,9 @@ func (skc *ShowKeysCommand) Execute(profile Profile, context Context, format str
 	fmt.Printf("  4. User Config: (if configured)\n")
 
 	fmt.Printf("\nTips:\n")
-	fmt.Printf("  • Use 'ggc config keybindings --export' to backup your settings\n")
-	fmt.Printf("  • Profile switching: set 'interactive.profile' in config\n")
+	bullet := ui.NewSymbols().Bullet
+	fmt.Printf("  %s Use 'ggc config keybindings --export' to backup your settings\n", bullet)
+	fmt.Printf("  %s Profile switching: set 'interactive.profile' in config\n", bullet)
 
 	return nil
 }
@@ -163,16 +166,17 @@ func (dkc *DebugKeysCommand) StopCapture() error {
 	}
 
 	// Display all captured sequences
+	arrow := ui.NewSymbols().Arrow
 	for i, seq := range sequences {
 		fmt.Printf("%d. %v (hex: %x)\n", i+1, seq, seq)
 
 		// Try to identify common sequences
 		if identified := dkc.identifySequence(seq); identified != "" {
-			fmt.Printf("   → Identified as: %s\n", identified)
+			fmt.Printf("   %s Identified as: %s\n", arrow, identified)
 		}
 
 		// Show binding format
-		fmt.Printf("   → Config format: \"raw:%x\"\n", seq)
+		fmt.Printf("   %s Config format: \"raw:%x\"\n", arrow, seq)
 	}
 
 	// Save to file if requested
@@ -239,30 +243,16 @@ func (dkc *DebugKeysCommand) identifySequence(seq []byte) string { //nolint:revi
 	}
 
 	if len(seq) == 3 && seq[0] == 27 && seq[1] == 91 {
-		switch seq[2] {
-		case 65:
-			return "↑"
-		case 66:
-			return "↓"
-		case 67:
-			return "→"
-		case 68:
-			return "←"
+		if name := arrowKeyName(seq[2]); name != "" {
+			return name
 		}
 	}
 
 	// Shift-modified arrow keys (CSI 1;2X sequences)
 	if len(seq) == 6 && seq[0] == 27 && seq[1] == 91 && seq[2] == 49 && seq[3] == 59 {
 		if seq[4] == 50 {
-			switch seq[5] {
-			case 65:
-				return "Shift+↑"
-			case 66:
-				return "Shift+↓"
-			case 67:
-				return "Shift+→"
-			case 68:
-				return "Shift+←"
+			if name := arrowKeyName(seq[5]); name != "" {
+				return "Shift+" + name
 			}
 		}
 	}
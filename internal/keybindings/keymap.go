@@ -18,6 +18,8 @@ type KeyBindingMap struct {
 	WorkflowCreate     []KeyStroke // default: [Ctrl+N]
 	WorkflowDelete     []KeyStroke // default: [Ctrl+D]
 	SoftCancel         []KeyStroke // default: [Ctrl+G, Esc]
+	TogglePreview      []KeyStroke // default: [Ctrl+O]
+	ToggleCategoryView []KeyStroke // default: [Ctrl+B]
 }
 
 // DefaultKeyBindingMap returns the built-in default control bindings.
@@ -38,6 +40,8 @@ func DefaultKeyBindingMap() *KeyBindingMap {
 		WorkflowCreate:     []KeyStroke{NewCtrlKeyStroke('n')},
 		WorkflowDelete:     []KeyStroke{NewCtrlKeyStroke('d')},
 		SoftCancel:         []KeyStroke{NewCtrlKeyStroke('g'), NewEscapeKeyStroke()},
+		TogglePreview:      []KeyStroke{NewCtrlKeyStroke('o')},
+		ToggleCategoryView: []KeyStroke{NewCtrlKeyStroke('b')},
 	}
 }
 
@@ -105,35 +109,51 @@ func (km *KeyBindingMap) getFirstControlByte(keyStrokes []KeyStroke, fallback by
 	return fallback
 }
 
-// MatchesKeyStroke checks if any KeyStroke in the given action matches the input
-func (km *KeyBindingMap) MatchesKeyStroke(action string, input KeyStroke) bool {
-	actionMap := map[string][]KeyStroke{
-		"delete_word":          km.DeleteWord,
-		"clear_line":           km.ClearLine,
-		"delete_to_end":        km.DeleteToEnd,
-		"move_to_beginning":    km.MoveToBeginning,
-		"move_to_end":          km.MoveToEnd,
-		"move_up":              km.MoveUp,
-		"move_down":            km.MoveDown,
-		"move_left":            km.MoveLeft,
-		"move_right":           km.MoveRight,
-		"add_to_workflow":      km.AddToWorkflow,
-		"toggle_workflow_view": km.ToggleWorkflowView,
-		"clear_workflow":       km.ClearWorkflow,
-		"workflow_create":      km.WorkflowCreate,
-		"workflow_delete":      km.WorkflowDelete,
-		"soft_cancel":          km.SoftCancel,
-	}
+// ActionBinding describes one interactive action and the key strokes bound to it.
+type ActionBinding struct {
+	Action      string
+	Category    string
+	Description string
+	Keys        []KeyStroke
+}
 
-	keyStrokes, exists := actionMap[action]
-	if !exists {
-		return false
+// Bindings lists every action in display order, grouped by category.
+// Actions without key strokes are included so callers can decide whether to
+// show them.
+func (km *KeyBindingMap) Bindings() []ActionBinding {
+	return []ActionBinding{
+		{"move_up", "Navigation", "Move up one line", km.MoveUp},
+		{"move_down", "Navigation", "Move down one line", km.MoveDown},
+		{"move_left", "Navigation", "Move cursor left", km.MoveLeft},
+		{"move_right", "Navigation", "Move cursor right", km.MoveRight},
+		{"move_to_beginning", "Navigation", "Move to line beginning", km.MoveToBeginning},
+		{"move_to_end", "Navigation", "Move to line end", km.MoveToEnd},
+		{"delete_word", "Editing", "Delete previous word", km.DeleteWord},
+		{"delete_to_end", "Editing", "Delete to line end", km.DeleteToEnd},
+		{"clear_line", "Editing", "Clear entire line", km.ClearLine},
+		{"add_to_workflow", "Workflow", "Add command to workflow", km.AddToWorkflow},
+		{"toggle_workflow_view", "Workflow", "Toggle workflow view", km.ToggleWorkflowView},
+		{"clear_workflow", "Workflow", "Clear workflow", km.ClearWorkflow},
+		{"workflow_create", "Workflow", "Create workflow", km.WorkflowCreate},
+		{"workflow_delete", "Workflow", "Delete workflow", km.WorkflowDelete},
+		{"toggle_preview", "View", "Toggle preview pane", km.TogglePreview},
+		{"toggle_category_view", "View", "Browse commands by category", km.ToggleCategoryView},
+		{"soft_cancel", "View", "Cancel and return to search", km.SoftCancel},
 	}
+}
 
-	for _, ks := range keyStrokes {
-		if input.Equals(ks) {
-			return true
+// MatchesKeyStroke checks if any KeyStroke in the given action matches the input
+func (km *KeyBindingMap) MatchesKeyStroke(action string, input KeyStroke) bool {
+	for _, binding := range km.Bindings() {
+		if binding.Action != action {
+			continue
 		}
+		for _, ks := range binding.Keys {
+			if input.Equals(ks) {
+				return true
+			}
+		}
+		return false
 	}
 	return false
 }
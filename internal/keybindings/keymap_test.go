@@ -86,3 +86,28 @@ func TestKeyBindingMap_GetDeleteToEndByte_EmptySlice(t *testing.T) {
 		t.Errorf("GetDeleteToEndByte (empty) = %d, want %d", got, want)
 	}
 }
+
+func TestKeyBindingMap_Bindings(t *testing.T) {
+	km := DefaultKeyBindingMap()
+	seen := make(map[string]bool)
+	for _, binding := range km.Bindings() {
+		if seen[binding.Action] {
+			t.Errorf("action %q listed twice", binding.Action)
+		}
+		seen[binding.Action] = true
+		if binding.Category == "" || binding.Description == "" {
+			t.Errorf("action %q is missing a category or description", binding.Action)
+		}
+		for _, ks := range binding.Keys {
+			if !km.MatchesKeyStroke(binding.Action, ks) {
+				t.Errorf("MatchesKeyStroke(%q, %v) = false for a listed key", binding.Action, ks)
+			}
+		}
+	}
+	if len(seen) != 17 {
+		t.Errorf("Bindings() listed %d actions, want 17", len(seen))
+	}
+	if km.MatchesKeyStroke("unknown_action", NewCtrlKeyStroke('a')) {
+		t.Error("unknown action should not match")
+	}
+}
@@ -3,6 +3,8 @@ package keybindings
 import (
 	"fmt"
 	"strings"
+
+	"github.com/bmf-san/ggc/v8/internal/ui"
 )
 
 // KeyStrokeKind represents the type of key stroke
@@ -473,15 +475,8 @@ func FormatKeyStrokeForDisplay(ks KeyStroke) string { //nolint:revive // handles
 		}
 		// Arrow keys
 		if len(ks.Seq) == 3 && ks.Seq[0] == 27 && ks.Seq[1] == 91 {
-			switch ks.Seq[2] {
-			case 65:
-				return "↑"
-			case 66:
-				return "↓"
-			case 67:
-				return "→"
-			case 68:
-				return "←"
+			if name := arrowKeyName(ks.Seq[2]); name != "" {
+				return name
 			}
 		}
 		return fmt.Sprintf("Raw[%x]", ks.Seq)
@@ -491,3 +486,20 @@ func FormatKeyStrokeForDisplay(ks KeyStroke) string { //nolint:revive // handles
 		return fmt.Sprintf("Unknown[%v]", ks)
 	}
 }
+
+// arrowKeyName returns the display name of the arrow key whose CSI final
+// byte is final, or "" for other keys.
+func arrowKeyName(final byte) string {
+	sym := ui.NewSymbols()
+	switch final {
+	case 'A':
+		return sym.Up
+	case 'B':
+		return sym.Down
+	case 'C':
+		return sym.Right
+	case 'D':
+		return sym.Left
+	}
+	return ""
+}
@@ -218,6 +218,8 @@ func detectConflictsV2(keyMap *KeyBindingMap) []string {
 	addKeyStrokes(keyMap.AddToWorkflow, "add_to_workflow")
 	addKeyStrokes(keyMap.ToggleWorkflowView, "toggle_workflow_view")
 	addKeyStrokes(keyMap.ClearWorkflow, "clear_workflow")
+	addKeyStrokes(keyMap.TogglePreview, "toggle_preview")
+	addKeyStrokes(keyMap.ToggleCategoryView, "toggle_category_view")
 
 	// Find conflicts (multiple actions for same keystroke)
 	for keystroke, actions := range keystrokeToActions {
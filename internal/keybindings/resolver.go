@@ -86,6 +86,8 @@ func (r *KeyBindingResolver) Resolve(profile Profile, context Context) (*KeyBind
 		AddToWorkflow:      []KeyStroke{},
 		ToggleWorkflowView: []KeyStroke{},
 		ClearWorkflow:      []KeyStroke{},
+		TogglePreview:      []KeyStroke{},
+		ToggleCategoryView: []KeyStroke{},
 	}
 
 	// Layer 1: Built-in defaults
@@ -174,6 +176,8 @@ func (r *KeyBindingResolver) GetEffectiveKeybindings(profile Profile, context Co
 	result["clear_workflow"] = clone(keyMap.ClearWorkflow)
 	result["workflow_create"] = clone(keyMap.WorkflowCreate)
 	result["workflow_delete"] = clone(keyMap.WorkflowDelete)
+	result["toggle_preview"] = clone(keyMap.TogglePreview)
+	result["toggle_category_view"] = clone(keyMap.ToggleCategoryView)
 
 	return result
 }
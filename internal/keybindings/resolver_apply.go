@@ -17,6 +17,8 @@ func (r *KeyBindingResolver) applyDefaults(keyMap *KeyBindingMap) {
 	keyMap.WorkflowCreate = append(keyMap.WorkflowCreate, defaults.WorkflowCreate...)
 	keyMap.WorkflowDelete = append(keyMap.WorkflowDelete, defaults.WorkflowDelete...)
 	keyMap.SoftCancel = append(keyMap.SoftCancel, defaults.SoftCancel...)
+	keyMap.TogglePreview = append(keyMap.TogglePreview, defaults.TogglePreview...)
+	keyMap.ToggleCategoryView = append(keyMap.ToggleCategoryView, defaults.ToggleCategoryView...)
 }
 
 func (r *KeyBindingResolver) applyProfile(keyMap *KeyBindingMap, profile *KeyBindingProfile, context Context) {
@@ -42,6 +44,8 @@ func (r *KeyBindingResolver) applyProfile(keyMap *KeyBindingMap, profile *KeyBin
 	applyBinding("workflow_create", &keyMap.WorkflowCreate)
 	applyBinding("workflow_delete", &keyMap.WorkflowDelete)
 	applyBinding("soft_cancel", &keyMap.SoftCancel)
+	applyBinding("toggle_preview", &keyMap.TogglePreview)
+	applyBinding("toggle_category_view", &keyMap.ToggleCategoryView)
 }
 
 func (r *KeyBindingResolver) applyPlatformLayer(keyMap *KeyBindingMap) {
@@ -128,6 +132,8 @@ func (r *KeyBindingResolver) applyWorkflowAction(keyMap *KeyBindingMap, action s
 		"workflow_create":      &keyMap.WorkflowCreate,
 		"workflow_delete":      &keyMap.WorkflowDelete,
 		"soft_cancel":          &keyMap.SoftCancel,
+		"toggle_preview":       &keyMap.TogglePreview,
+		"toggle_category_view": &keyMap.ToggleCategoryView,
 	}
 
 	if target, exists := actionMap[action]; exists {
@@ -22,6 +22,8 @@ func (r *KeyBindingResolver) applyUserConfig(keyMap *KeyBindingMap, context Cont
 		"workflow_create":      userBindings.WorkflowCreate,
 		"workflow_delete":      userBindings.WorkflowDelete,
 		"soft_cancel":          userBindings.SoftCancel,
+		"toggle_preview":       userBindings.TogglePreview,
+		"toggle_category_view": userBindings.ToggleCategoryView,
 	}
 
 	// Apply non-empty user overrides
@@ -59,6 +61,10 @@ func (r *KeyBindingResolver) applyUserConfig(keyMap *KeyBindingMap, context Cont
 					keyMap.WorkflowDelete = []KeyStroke{ks}
 				case "soft_cancel":
 					keyMap.SoftCancel = []KeyStroke{ks}
+				case "toggle_preview":
+					keyMap.TogglePreview = []KeyStroke{ks}
+				case "toggle_category_view":
+					keyMap.ToggleCategoryView = []KeyStroke{ks}
 				}
 			}
 		}
@@ -90,6 +96,8 @@ func (r *KeyBindingResolver) applyEnvironmentOverrides(keyMap *KeyBindingMap) {
 		"GGC_KEYBIND_WORKFLOW_CREATE":      &keyMap.WorkflowCreate,
 		"GGC_KEYBIND_WORKFLOW_DELETE":      &keyMap.WorkflowDelete,
 		"GGC_KEYBIND_SOFT_CANCEL":          &keyMap.SoftCancel,
+		"GGC_KEYBIND_TOGGLE_PREVIEW":       &keyMap.TogglePreview,
+		"GGC_KEYBIND_TOGGLE_CATEGORY_VIEW": &keyMap.ToggleCategoryView,
 	}
 
 	for envVar, target := range envOverrides {
@@ -221,6 +229,8 @@ func (r *KeyBindingResolver) applyUserWorkflowAction(keyMap *KeyBindingMap, acti
 		"workflow_create":      &keyMap.WorkflowCreate,
 		"workflow_delete":      &keyMap.WorkflowDelete,
 		"soft_cancel":          &keyMap.SoftCancel,
+		"toggle_preview":       &keyMap.TogglePreview,
+		"toggle_category_view": &keyMap.ToggleCategoryView,
 	}
 
 	if target, exists := actionMap[action]; exists {
@@ -14,9 +14,14 @@ import (
 	"syscall"
 
 	"golang.org/x/term"
+
+	"github.com/bmf-san/ggc/v8/internal/ui"
 )
 
-const defaultCancelMessage = "⚠️  Operation canceled"
+// defaultCancelMessage is shown when the user cancels a prompt.
+func defaultCancelMessage() string {
+	return ui.NewSymbols().Warning + "Operation canceled"
+}
 
 var (
 	// ErrInvalidSelection indicates the user entered an out-of-range selection.
@@ -71,7 +76,7 @@ func New(reader io.Reader, writer io.Writer) Prompter {
 		baseReader:    reader,
 		reader:        bufio.NewReader(reader),
 		writer:        writer,
-		cancelMessage: defaultCancelMessage,
+		cancelMessage: defaultCancelMessage(),
 	}
 	if file, ok := reader.(*os.File); ok {
 		p.inputFile = file
@@ -43,7 +43,7 @@ func TestWithCancelMessage_EmptyKeepsDefault(t *testing.T) {
 	var buf bytes.Buffer
 	p := New(strings.NewReader(""), &buf).(*StandardPrompter)
 	p.WithCancelMessage("")
-	if p.cancelMessage != defaultCancelMessage {
+	if p.cancelMessage != defaultCancelMessage() {
 		t.Errorf("empty override changed cancelMessage to %q", p.cancelMessage)
 	}
 }
@@ -28,6 +28,9 @@ var (
 Usage:
   ggc <command> [subcommand] [options]
 
+Global Options:
+  --no-color                  Disable colored output (also NO_COLOR=1)
+
 Main Commands:
 {{if .Categories}}
   {{range .Categories}}{{.Name}}:
@@ -65,12 +68,23 @@ Main Commands:
   ggc tag                     Create, list, and delete tags
   ggc log simple              Show simple log
   ggc log graph               Show log with graph
+  ggc bisect                  Find the commit that introduced a bug
+  ggc blame <file>            Show who last changed each line of a file
+  ggc show [<commit>]         Show a commit with its details and changes
+  ggc merge <branch>          Merge a branch into the current branch
+  ggc merge squash <branch>   Stage a branch's changes as one change
+  ggc merge abort             Abort an in-progress merge
+  ggc conflicts               Resolve conflicted files step by step
+  ggc worktree add            Check out a branch in a new worktree
+  ggc submodule update        Check out the recorded submodule commits
   ggc pull current            Pull current branch
   ggc pull rebase             Pull with rebase
   ggc push current            Push current branch
   ggc push force              Force push current branch
   ggc rebase interactive      Interactive rebase
   ggc rebase <upstream>       Rebase current branch onto <upstream>
+  ggc cherry-pick             Pick commits from another branch to apply
+  ggc revert                  Pick a recent commit to undo
   ggc rebase continue         Continue an in-progress rebase
   ggc rebase abort            Abort an in-progress rebase
   ggc rebase skip             Skip current patch and continue
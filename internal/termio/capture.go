@@ -0,0 +1,14 @@
+package termio
+
+import "errors"
+
+// ErrCaptureUnsupported is returned by CaptureOutput on platforms where the
+// process's standard streams cannot be redirected.
+var ErrCaptureUnsupported = errors.New("output capture is not supported on this platform")
+
+// CaptureOutput runs fn with the process's stdout and stderr redirected and
+// returns everything written to them, including output from child processes
+// that inherit the standard streams. A non-nil error means fn was not run.
+func CaptureOutput(fn func()) (string, error) {
+	return captureOutput(fn)
+}
@@ -0,0 +1,74 @@
+//go:build !windows
+
+package termio
+
+import (
+	"fmt"
+	"io"
+	"os"
+
+	"golang.org/x/sys/unix"
+)
+
+func captureOutput(fn func()) (string, error) {
+	r, w, err := os.Pipe()
+	if err != nil {
+		return "", fmt.Errorf("failed to create capture pipe: %w", err)
+	}
+	defer func() { _ = r.Close() }()
+
+	savedOut, err := unix.Dup(int(os.Stdout.Fd()))
+	if err != nil {
+		_ = w.Close()
+		return "", fmt.Errorf("failed to duplicate stdout: %w", err)
+	}
+	savedErr, err := unix.Dup(int(os.Stderr.Fd()))
+	if err != nil {
+		_ = unix.Close(savedOut)
+		_ = w.Close()
+		return "", fmt.Errorf("failed to duplicate stderr: %w", err)
+	}
+
+	if err := redirect(int(w.Fd())); err != nil {
+		_ = redirect2(savedOut, savedErr)
+		_ = unix.Close(savedOut)
+		_ = unix.Close(savedErr)
+		_ = w.Close()
+		return "", err
+	}
+
+	// Drain the pipe concurrently so fn never blocks on a full pipe buffer.
+	done := make(chan []byte, 1)
+	go func() {
+		data, _ := io.ReadAll(r)
+		done <- data
+	}()
+
+	func() {
+		defer func() {
+			_ = redirect2(savedOut, savedErr)
+			_ = unix.Close(savedOut)
+			_ = unix.Close(savedErr)
+			_ = w.Close()
+		}()
+		fn()
+	}()
+
+	return string(<-done), nil
+}
+
+// redirect points stdout and stderr at fd.
+func redirect(fd int) error {
+	return redirect2(fd, fd)
+}
+
+// redirect2 points stdout at outFd and stderr at errFd.
+func redirect2(outFd, errFd int) error {
+	if err := unix.Dup2(outFd, int(os.Stdout.Fd())); err != nil {
+		return fmt.Errorf("failed to redirect stdout: %w", err)
+	}
+	if err := unix.Dup2(errFd, int(os.Stderr.Fd())); err != nil {
+		return fmt.Errorf("failed to redirect stderr: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,55 @@
+//go:build !windows
+
+package termio
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+	"testing"
+)
+
+func TestCaptureOutputCollectsStdoutAndStderr(t *testing.T) {
+	output, err := CaptureOutput(func() {
+		fmt.Fprintln(os.Stdout, "to stdout")
+		fmt.Fprintln(os.Stderr, "to stderr")
+	})
+	if err != nil {
+		t.Fatalf("CaptureOutput returned error: %v", err)
+	}
+	if !strings.Contains(output, "to stdout") || !strings.Contains(output, "to stderr") {
+		t.Fatalf("CaptureOutput = %q, want both streams", output)
+	}
+}
+
+func TestCaptureOutputCollectsChildProcessOutput(t *testing.T) {
+	output, err := CaptureOutput(func() {
+		cmd := exec.Command("echo", "from child")
+		cmd.Stdout = os.Stdout
+		_ = cmd.Run()
+	})
+	if err != nil {
+		t.Fatalf("CaptureOutput returned error: %v", err)
+	}
+	if strings.TrimSpace(output) != "from child" {
+		t.Fatalf("CaptureOutput = %q, want child output", output)
+	}
+}
+
+func TestCaptureOutputRestoresStreams(t *testing.T) {
+	before, err := os.Stdout.Stat()
+	if err != nil {
+		t.Fatalf("stat stdout: %v", err)
+	}
+	if _, err := CaptureOutput(func() { fmt.Print("captured") }); err != nil {
+		t.Fatalf("CaptureOutput returned error: %v", err)
+	}
+	after, err := os.Stdout.Stat()
+	if err != nil {
+		t.Fatalf("stat stdout: %v", err)
+	}
+	if !os.SameFile(before, after) {
+		t.Fatal("expected stdout to be restored after capture")
+	}
+}
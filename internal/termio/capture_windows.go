@@ -0,0 +1,7 @@
+//go:build windows
+
+package termio
+
+func captureOutput(func()) (string, error) {
+	return "", ErrCaptureUnsupported
+}
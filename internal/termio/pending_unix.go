@@ -2,7 +2,12 @@
 
 package termio
 
-import "golang.org/x/sys/unix"
+import (
+	"errors"
+	"time"
+
+	"golang.org/x/sys/unix"
+)
 
 func pendingInput(fd uintptr) (int, error) {
 	pollFds := []unix.PollFd{{Fd: int32(fd), Events: unix.POLLIN}}
@@ -18,3 +23,15 @@ func pendingInput(fd uintptr) (int, error) {
 	}
 	return 0, nil
 }
+
+func waitForInput(fd uintptr, timeout time.Duration) (bool, error) {
+	pollFds := []unix.PollFd{{Fd: int32(fd), Events: unix.POLLIN}}
+	n, err := unix.Poll(pollFds, int(timeout/time.Millisecond))
+	if err != nil {
+		if errors.Is(err, unix.EINTR) {
+			return false, nil
+		}
+		return false, err
+	}
+	return n > 0 && pollFds[0].Revents&unix.POLLIN != 0, nil
+}
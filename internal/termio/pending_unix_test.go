@@ -5,6 +5,7 @@ package termio
 import (
 	"os"
 	"testing"
+	"time"
 )
 
 func mustClose(t *testing.T, f *os.File, name string) {
@@ -59,3 +60,28 @@ func TestPendingInputPipe(t *testing.T) {
 		t.Fatalf("PendingInput after drain returned %d, want 0", n)
 	}
 }
+
+func TestWaitForInputPipe(t *testing.T) {
+	r, w, err := os.Pipe()
+	if err != nil {
+		t.Fatalf("os.Pipe failed: %v", err)
+	}
+	t.Cleanup(func() {
+		mustClose(t, r, "pipe reader")
+		mustClose(t, w, "pipe writer")
+	})
+
+	ready, err := WaitForInput(r.Fd(), 10*time.Millisecond)
+	if err != nil || ready {
+		t.Fatalf("WaitForInput before write = %v, %v; want false, nil", ready, err)
+	}
+
+	go func() {
+		time.Sleep(10 * time.Millisecond)
+		_, _ = w.Write([]byte("x"))
+	}()
+	ready, err = WaitForInput(r.Fd(), 2*time.Second)
+	if err != nil || !ready {
+		t.Fatalf("WaitForInput after write = %v, %v; want true, nil", ready, err)
+	}
+}
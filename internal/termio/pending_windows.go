@@ -4,6 +4,7 @@ package termio
 
 import (
 	"syscall"
+	"time"
 	"unsafe"
 )
 
@@ -52,3 +53,21 @@ func pendingInput(fd uintptr) (int, error) {
 
 	return 0, nil
 }
+
+// waitPollInterval is how often waitForInput re-checks the console on Windows,
+// which has no poll(2) equivalent for console handles.
+const waitPollInterval = 10 * time.Millisecond
+
+func waitForInput(fd uintptr, timeout time.Duration) (bool, error) {
+	deadline := time.Now().Add(timeout)
+	for {
+		n, err := pendingInput(fd)
+		if err != nil || n > 0 {
+			return n > 0, err
+		}
+		if !time.Now().Before(deadline) {
+			return false, nil
+		}
+		time.Sleep(waitPollInterval)
+	}
+}
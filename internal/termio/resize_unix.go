@@ -0,0 +1,15 @@
+//go:build !windows
+
+package termio
+
+import (
+	"os"
+	"os/signal"
+
+	"golang.org/x/sys/unix"
+)
+
+func notifyResize(ch chan<- os.Signal) bool {
+	signal.Notify(ch, unix.SIGWINCH)
+	return true
+}
@@ -0,0 +1,32 @@
+//go:build !windows
+
+package termio
+
+import (
+	"os"
+	"os/signal"
+	"testing"
+	"time"
+
+	"golang.org/x/sys/unix"
+)
+
+func TestNotifyResize(t *testing.T) {
+	ch := make(chan os.Signal, 1)
+	if !NotifyResize(ch) {
+		t.Fatal("NotifyResize returned false on a Unix platform")
+	}
+	defer signal.Stop(ch)
+
+	if err := unix.Kill(os.Getpid(), unix.SIGWINCH); err != nil {
+		t.Fatalf("kill SIGWINCH failed: %v", err)
+	}
+	select {
+	case sig := <-ch:
+		if sig != unix.SIGWINCH {
+			t.Fatalf("got signal %v, want SIGWINCH", sig)
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatal("SIGWINCH was not delivered")
+	}
+}
@@ -0,0 +1,11 @@
+//go:build windows
+
+package termio
+
+import "os"
+
+// notifyResize reports false: Windows consoles have no resize signal, so
+// callers fall back to polling the terminal size.
+func notifyResize(chan<- os.Signal) bool {
+	return false
+}
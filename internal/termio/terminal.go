@@ -1,7 +1,12 @@
 // Package termio provides small terminal utilities shared across the interactive UI.
 package termio
 
-import "golang.org/x/term"
+import (
+	"os"
+	"time"
+
+	"golang.org/x/term"
+)
 
 // Terminal abstracts terminal raw mode operations so callers can swap implementations in tests.
 type Terminal interface {
@@ -29,6 +34,18 @@ func PendingInput(fd uintptr) (int, error) {
 	return pendingInputHook(fd)
 }
 
+// WaitForInput blocks until fd has readable input or timeout elapses, and
+// reports whether input is available.
+func WaitForInput(fd uintptr, timeout time.Duration) (bool, error) {
+	return waitForInput(fd, timeout)
+}
+
+// NotifyResize relays terminal resize signals to ch and reports whether the
+// platform delivers them. Use signal.Stop(ch) to stop relaying.
+func NotifyResize(ch chan<- os.Signal) bool {
+	return notifyResize(ch)
+}
+
 // SetPendingInputFunc overrides the pending-input probe; the returned closure restores the default implementation.
 func SetPendingInputFunc(fn func(uintptr) (int, error)) func() {
 	prev := pendingInputHook
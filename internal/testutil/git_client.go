@@ -52,6 +52,7 @@ func (m *testMockGitClient) DiffHead() (string, error)   { return "", nil }
 func (m *testMockGitClient) DiffWith(_ []string) (string, error) {
 	return "", nil
 }
+func (m *testMockGitClient) DefaultBranch(_ string) (string, error) { return "main", nil }
 
 // Branch Operations
 func (m *testMockGitClient) ListLocalBranches() ([]string, error) { return []string{"main"}, nil }
@@ -75,7 +76,7 @@ func (m *testMockGitClient) RemoteRemove(_ string) error    { return nil }
 func (m *testMockGitClient) RemoteSetURL(_, _ string) error { return nil }
 
 // Tag Operations
-func (m *testMockGitClient) TagList(_ []string) error              { return nil }
+func (m *testMockGitClient) TagList(_ []string) (string, error)    { return "", nil }
 func (m *testMockGitClient) TagCreate(_, _ string) error           { return nil }
 func (m *testMockGitClient) TagCreateAnnotated(_, _ string) error  { return nil }
 func (m *testMockGitClient) TagDelete(_ []string) error            { return nil }
@@ -91,6 +92,81 @@ func (m *testMockGitClient) LogSimple() error                       { return nil
 func (m *testMockGitClient) LogGraph() error                        { return nil }
 func (m *testMockGitClient) LogOneline(_, _ string) (string, error) { return "", nil }
 
+// Merge Operations
+func (m *testMockGitClient) Merge(_ string) error       { return nil }
+func (m *testMockGitClient) MergeNoFF(_ string) error   { return nil }
+func (m *testMockGitClient) MergeSquash(_ string) error { return nil }
+func (m *testMockGitClient) MergeFFOnly(_ string) error { return nil }
+func (m *testMockGitClient) MergeAbort() error          { return nil }
+func (m *testMockGitClient) MergeContinue() error       { return nil }
+
+// Cherry-pick Operations
+func (m *testMockGitClient) CherryPick(_ []string) error                    { return nil }
+func (m *testMockGitClient) CherryPickContinue() error                      { return nil }
+func (m *testMockGitClient) CherryPickAbort() error                         { return nil }
+func (m *testMockGitClient) CherryPickSkip() error                          { return nil }
+func (m *testMockGitClient) LogOnelineUnpicked(_, _ string) (string, error) { return "", nil }
+
+// Revert Operations
+func (m *testMockGitClient) Revert(_ []string, _ *git.RevertOptions) error { return nil }
+func (m *testMockGitClient) RevertContinue() error                         { return nil }
+func (m *testMockGitClient) RevertAbort() error                            { return nil }
+func (m *testMockGitClient) LogRecent(_ int) (string, error)               { return "", nil }
+func (m *testMockGitClient) CommitParents(_ string) ([]string, error)      { return nil, nil }
+func (m *testMockGitClient) CommitFiles(_ string, _ int) (string, error)   { return "", nil }
+
+// Worktree Operations
+func (m *testMockGitClient) ListWorktrees() ([]git.Worktree, error)    { return nil, nil }
+func (m *testMockGitClient) AddWorktree(_, _ string) error             { return nil }
+func (m *testMockGitClient) AddWorktreeNewBranch(_, _, _ string) error { return nil }
+func (m *testMockGitClient) RemoveWorktree(_ string, _ bool) error     { return nil }
+func (m *testMockGitClient) PruneWorktrees() error                     { return nil }
+func (m *testMockGitClient) LockWorktree(_, _ string) error            { return nil }
+func (m *testMockGitClient) UnlockWorktree(_ string) error             { return nil }
+func (m *testMockGitClient) GitPath(name string) (string, error)       { return ".git/" + name, nil }
+func (m *testMockGitClient) GetRepositoryRoot() (string, error)        { return "/repo", nil }
+
+// Bisect Operations
+func (m *testMockGitClient) BisectStart(_, _ string) error { return nil }
+func (m *testMockGitClient) BisectMark(_ string) error     { return nil }
+func (m *testMockGitClient) BisectRun(_ []string) error    { return nil }
+func (m *testMockGitClient) BisectReset() error            { return nil }
+func (m *testMockGitClient) BisectStatus() (*git.BisectStatus, error) {
+	return &git.BisectStatus{}, nil
+}
+func (m *testMockGitClient) CommitDetails(_ string) (*git.CommitDetails, error) {
+	return &git.CommitDetails{}, nil
+}
+
+// Blame Operations
+func (m *testMockGitClient) Blame(_, _ string) ([]git.BlameLine, error) { return nil, nil }
+func (m *testMockGitClient) CommitDiff(_ string) (string, error)        { return "", nil }
+
+// Show Operations
+func (m *testMockGitClient) ShowCommit(_ string) (*git.CommitInfo, error) {
+	return &git.CommitInfo{}, nil
+}
+func (m *testMockGitClient) CommitChildren(_ string) ([]string, error) { return nil, nil }
+
+// Submodule Operations
+func (m *testMockGitClient) ListSubmodules() ([]git.Submodule, error) { return nil, nil }
+func (m *testMockGitClient) SubmoduleInit(_ []string) error           { return nil }
+func (m *testMockGitClient) SubmoduleUpdate(_ []string) error         { return nil }
+func (m *testMockGitClient) SubmoduleSync(_ []string) error           { return nil }
+func (m *testMockGitClient) SubmoduleForeach(_ []string) error        { return nil }
+func (m *testMockGitClient) SubmoduleAdd(_, _ string) error           { return nil }
+
+// Conflict Operations
+func (m *testMockGitClient) ListConflicts() ([]git.Conflict, error) { return nil, nil }
+func (m *testMockGitClient) GetRepositoryPrefix() (string, error)   { return "", nil }
+func (m *testMockGitClient) OperationInProgress() (git.Operation, error) {
+	return git.OperationNone, nil
+}
+func (m *testMockGitClient) ContinueOperation(_ git.Operation) error      { return nil }
+func (m *testMockGitClient) ResolveConflict(_ git.Conflict, _ bool) error { return nil }
+func (m *testMockGitClient) MarkResolved(_ []string) error                { return nil }
+func (m *testMockGitClient) RunMergeTool(_ string, _ []string) error      { return nil }
+
 // Rebase Operations
 func (m *testMockGitClient) RebaseInteractive(_ int) error              { return nil }
 func (m *testMockGitClient) RebaseInteractiveAutosquash(_ int) error    { return nil }
@@ -1,6 +1,85 @@
 // Package ui provides shared terminal rendering utilities for the CLI.
 package ui
 
+import (
+	"fmt"
+	"os"
+	"strings"
+	"sync/atomic"
+
+	"golang.org/x/term"
+)
+
+// ColorMode is the policy deciding whether output is colored.
+type ColorMode int
+
+const (
+	// ColorAuto colors output only when stdout is a terminal.
+	ColorAuto ColorMode = iota
+	// ColorAlways colors output even when it is piped or redirected.
+	ColorAlways
+	// ColorNever disables color.
+	ColorNever
+)
+
+// ParseColorMode parses "auto", "always" or "never".
+func ParseColorMode(s string) (ColorMode, error) {
+	switch strings.ToLower(strings.TrimSpace(s)) {
+	case "auto":
+		return ColorAuto, nil
+	case "always":
+		return ColorAlways, nil
+	case "never":
+		return ColorNever, nil
+	}
+	return ColorAuto, fmt.Errorf("unknown color mode %q (must be one of: always, auto, never)", s)
+}
+
+// String returns the name ParseColorMode accepts for m.
+func (m ColorMode) String() string {
+	switch m {
+	case ColorAlways:
+		return "always"
+	case ColorNever:
+		return "never"
+	}
+	return "auto"
+}
+
+// ColorModeFromEnv returns the mode requested by the environment: GGC_COLOR
+// (always, auto or never) takes precedence over NO_COLOR, which disables
+// color when set to any non-empty value. ok is false when neither applies.
+func ColorModeFromEnv(getenv func(string) string) (mode ColorMode, ok bool) {
+	if v := getenv("GGC_COLOR"); v != "" {
+		if mode, err := ParseColorMode(v); err == nil {
+			return mode, true
+		}
+	}
+	if getenv("NO_COLOR") != "" {
+		return ColorNever, true
+	}
+	return ColorAuto, false
+}
+
+// ColorEnabled resolves mode for output written to out.
+func ColorEnabled(mode ColorMode, out *os.File) bool {
+	switch mode {
+	case ColorAlways:
+		return true
+	case ColorNever:
+		return false
+	}
+	return out != nil && term.IsTerminal(int(out.Fd()))
+}
+
+var colorDisabled atomic.Bool
+
+// SetColor enables or disables color process-wide. The cmd layer calls it
+// once the color policy is resolved; until then color is enabled.
+func SetColor(enabled bool) {
+	colorDisabled.Store(!enabled)
+}
+
 // ANSIColors defines terminal color escape sequences for both base and bright palettes,
 // plus common text attributes. All fields contain raw ANSI escape codes suitable for
 // writing directly to an io.Writer.
@@ -29,8 +108,12 @@ type ANSIColors struct {
 	Reset     string
 }
 
-// NewANSIColors returns a palette initialized with the standard ANSI escape codes.
+// NewANSIColors returns a palette initialized with the standard ANSI escape
+// codes, or an empty palette when color is disabled.
 func NewANSIColors() *ANSIColors {
+	if colorDisabled.Load() {
+		return &ANSIColors{}
+	}
 	return &ANSIColors{
 		Black:   "\033[30m",
 		Red:     "\033[31m",
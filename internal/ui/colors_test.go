@@ -0,0 +1,69 @@
+package ui
+
+import "testing"
+
+func TestParseColorMode(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    ColorMode
+		wantErr bool
+	}{
+		{"auto", ColorAuto, false},
+		{"Always", ColorAlways, false},
+		{" never ", ColorNever, false},
+		{"sometimes", ColorAuto, true},
+	}
+	for _, tt := range tests {
+		got, err := ParseColorMode(tt.in)
+		if got != tt.want || (err != nil) != tt.wantErr {
+			t.Errorf("ParseColorMode(%q) = %v, %v; want %v, error %v", tt.in, got, err, tt.want, tt.wantErr)
+		}
+	}
+}
+
+func TestColorModeFromEnv(t *testing.T) {
+	tests := []struct {
+		name   string
+		env    map[string]string
+		want   ColorMode
+		wantOK bool
+	}{
+		{"unset", nil, ColorAuto, false},
+		{"no color", map[string]string{"NO_COLOR": "1"}, ColorNever, true},
+		{"ggc color wins", map[string]string{"NO_COLOR": "1", "GGC_COLOR": "always"}, ColorAlways, true},
+		{"invalid ggc color ignored", map[string]string{"GGC_COLOR": "bogus"}, ColorAuto, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := ColorModeFromEnv(func(k string) string { return tt.env[k] })
+			if got != tt.want || ok != tt.wantOK {
+				t.Errorf("ColorModeFromEnv() = %v, %v; want %v, %v", got, ok, tt.want, tt.wantOK)
+			}
+		})
+	}
+}
+
+func TestColorEnabled(t *testing.T) {
+	if !ColorEnabled(ColorAlways, nil) {
+		t.Error("always should enable color")
+	}
+	if ColorEnabled(ColorNever, nil) {
+		t.Error("never should disable color")
+	}
+	if ColorEnabled(ColorAuto, nil) {
+		t.Error("auto should disable color without a terminal")
+	}
+}
+
+func TestNewANSIColors_Disabled(t *testing.T) {
+	SetColor(false)
+	defer SetColor(true)
+
+	if got := *NewANSIColors(); got != (ANSIColors{}) {
+		t.Errorf("NewANSIColors() with color disabled = %+v, want empty palette", got)
+	}
+	SetColor(true)
+	if NewANSIColors().Reset == "" {
+		t.Error("NewANSIColors() with color enabled should return escape codes")
+	}
+}
@@ -0,0 +1,50 @@
+package ui
+
+import (
+	"io"
+	"os"
+	"os/exec"
+	"runtime"
+	"strings"
+)
+
+// DefaultPager is used when neither ggc, git nor the environment configures
+// a pager.
+const DefaultPager = "less -FRX"
+
+// PagerCommand resolves the pager command line: GGC_PAGER, then git's
+// core.pager, then PAGER, then DefaultPager.
+func PagerCommand(getenv func(string) string, corePager string) string {
+	if v := strings.TrimSpace(getenv("GGC_PAGER")); v != "" {
+		return v
+	}
+	if v := strings.TrimSpace(corePager); v != "" {
+		return v
+	}
+	if v := strings.TrimSpace(getenv("PAGER")); v != "" {
+		return v
+	}
+	return DefaultPager
+}
+
+// IsNoopPager reports whether command would copy its input unchanged, in
+// which case the output is written directly instead.
+func IsNoopPager(command string) bool {
+	command = strings.TrimSpace(command)
+	return command == "" || command == "cat"
+}
+
+// Page runs command through the shell with text on its stdin and its output
+// on out. It returns an error when the pager cannot be run.
+func Page(command, text string, out io.Writer) error {
+	var cmd *exec.Cmd
+	if runtime.GOOS == "windows" {
+		cmd = exec.Command("cmd", "/C", command)
+	} else {
+		cmd = exec.Command("sh", "-c", command)
+	}
+	cmd.Stdin = strings.NewReader(text)
+	cmd.Stdout = out
+	cmd.Stderr = os.Stderr
+	return cmd.Run()
+}
@@ -0,0 +1,53 @@
+package ui
+
+import (
+	"bytes"
+	"runtime"
+	"testing"
+)
+
+func TestPagerCommand(t *testing.T) {
+	tests := []struct {
+		name      string
+		env       map[string]string
+		corePager string
+		want      string
+	}{
+		{"default", nil, "", DefaultPager},
+		{"PAGER", map[string]string{"PAGER": "more"}, "", "more"},
+		{"core.pager over PAGER", map[string]string{"PAGER": "more"}, "delta", "delta"},
+		{"GGC_PAGER first", map[string]string{"PAGER": "more", "GGC_PAGER": "most"}, "delta", "most"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := PagerCommand(func(k string) string { return tt.env[k] }, tt.corePager)
+			if got != tt.want {
+				t.Errorf("PagerCommand() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIsNoopPager(t *testing.T) {
+	for cmd, want := range map[string]bool{"": true, " cat ": true, "less -R": false} {
+		if got := IsNoopPager(cmd); got != want {
+			t.Errorf("IsNoopPager(%q) = %v, want %v", cmd, got, want)
+		}
+	}
+}
+
+func TestPage(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("pager commands run through sh")
+	}
+	var out bytes.Buffer
+	if err := Page("tr a-z A-Z", "paged\n", &out); err != nil {
+		t.Fatalf("Page: %v", err)
+	}
+	if out.String() != "PAGED\n" {
+		t.Errorf("Page output = %q, want %q", out.String(), "PAGED\n")
+	}
+	if err := Page("ggc-missing-pager-command", "x", &out); err == nil {
+		t.Error("Page with a missing command should fail")
+	}
+}
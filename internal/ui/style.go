@@ -0,0 +1,202 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+	"sync/atomic"
+)
+
+// Style selects how terminal output is decorated.
+type Style int
+
+const (
+	// StyleRich decorates output with emoji and Unicode box drawing.
+	StyleRich Style = iota
+	// StylePlain uses ASCII labels only, for screen readers, limited fonts
+	// and log files.
+	StylePlain
+)
+
+// Style setting values accepted by ParseStyle.
+const (
+	StyleSettingAuto  = "auto"
+	StyleSettingRich  = "rich"
+	StyleSettingPlain = "plain"
+)
+
+// ParseStyle resolves a ui.style setting. "auto" (or an empty setting)
+// selects StylePlain when TERM is "dumb" and StyleRich otherwise.
+func ParseStyle(setting, term string) (Style, error) {
+	switch strings.ToLower(strings.TrimSpace(setting)) {
+	case "", StyleSettingAuto:
+		if term == "dumb" {
+			return StylePlain, nil
+		}
+		return StyleRich, nil
+	case StyleSettingRich:
+		return StyleRich, nil
+	case StyleSettingPlain:
+		return StylePlain, nil
+	}
+	return StyleRich, fmt.Errorf("unknown style %q (must be one of: auto, rich, plain)", setting)
+}
+
+var currentStyle atomic.Int32
+
+// SetStyle sets the process-wide output style. The cmd layer calls it once
+// the config is loaded; until then StyleRich is used.
+func SetStyle(s Style) {
+	currentStyle.Store(int32(s))
+}
+
+// CurrentStyle returns the process-wide output style.
+func CurrentStyle() Style {
+	return Style(currentStyle.Load())
+}
+
+// Symbols holds the glyphs used to decorate output. Icons include their
+// trailing spacing so that an empty icon leaves no gap.
+type Symbols struct {
+	// Icons prefixing titles and messages.
+	Launch    string
+	Workflow  string
+	Output    string
+	Browse    string
+	Keyboard  string
+	Filter    string
+	NoResults string
+	Hint      string
+	Recent    string
+	Rerun     string
+	Branch    string
+	Changes   string
+	Preview   string
+	Create    string
+	Delete    string
+	Clear     string
+	Added     string
+	Celebrate string
+
+	// Status labels.
+	Warning  string
+	Success  string
+	Failure  string
+	Done     string
+	Enabled  string
+	Disabled string
+
+	// Structural glyphs.
+	Pointer   string
+	Separator string
+	Arrow     string
+	Bullet    string
+	Star      string
+	Dot       string
+	Ellipsis  string
+	Rule      string
+	BoxTop    string
+	BoxBottom string
+	Caret     string
+	Block     string
+	Ahead     string
+	Behind    string
+
+	// Arrow key labels.
+	Up    string
+	Down  string
+	Left  string
+	Right string
+}
+
+var (
+	richSymbols = Symbols{
+		Launch:    "🚀 ",
+		Workflow:  "📋 ",
+		Output:    "📄 ",
+		Browse:    "🗂  ",
+		Keyboard:  "⌨️  ",
+		Filter:    "🔎 ",
+		NoResults: "🔍 ",
+		Hint:      "💭 ",
+		Recent:    "🕘 ",
+		Rerun:     "🔁 ",
+		Branch:    "📍 ",
+		Changes:   "📝 ",
+		Preview:   "👁  ",
+		Create:    "✨ ",
+		Delete:    "🗑  ",
+		Clear:     "🧹 ",
+		Added:     "🎯 ",
+		Celebrate: "🎉 ",
+
+		Warning:  "⚠️  ",
+		Success:  "✓ ",
+		Failure:  "✗ ",
+		Done:     "✅ ",
+		Enabled:  "✓",
+		Disabled: "✗",
+
+		Pointer:   "▶",
+		Separator: "│",
+		Arrow:     "→",
+		Bullet:    "•",
+		Star:      "★",
+		Dot:       "·",
+		Ellipsis:  "…",
+		Rule:      "─",
+		BoxTop:    "┌─ ",
+		BoxBottom: "└─ ",
+		Caret:     "│",
+		Block:     "█",
+		Ahead:     "↑",
+		Behind:    "↓",
+
+		Up:    "↑",
+		Down:  "↓",
+		Left:  "←",
+		Right: "→",
+	}
+
+	plainSymbols = Symbols{
+		Warning:  "Warning: ",
+		Success:  "[ok] ",
+		Failure:  "[error] ",
+		Done:     "[ok] ",
+		Enabled:  "[x]",
+		Disabled: "[ ]",
+
+		Pointer:   ">",
+		Separator: "|",
+		Arrow:     "->",
+		Bullet:    "-",
+		Star:      "*",
+		Dot:       "-",
+		Ellipsis:  "...",
+		Rule:      "-",
+		BoxTop:    "",
+		BoxBottom: "",
+		Caret:     "|",
+		Block:     "_",
+		Ahead:     "ahead ",
+		Behind:    "behind ",
+
+		Up:    "Up",
+		Down:  "Down",
+		Left:  "Left",
+		Right: "Right",
+	}
+)
+
+// SymbolsFor returns the glyphs for the given style.
+func SymbolsFor(s Style) *Symbols {
+	symbols := richSymbols
+	if s == StylePlain {
+		symbols = plainSymbols
+	}
+	return &symbols
+}
+
+// NewSymbols returns the glyphs for the current style.
+func NewSymbols() *Symbols {
+	return SymbolsFor(CurrentStyle())
+}
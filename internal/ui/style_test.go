@@ -0,0 +1,53 @@
+package ui
+
+import (
+	"reflect"
+	"testing"
+	"unicode"
+)
+
+func TestParseStyle(t *testing.T) {
+	tests := []struct {
+		setting, term string
+		want          Style
+		wantErr       bool
+	}{
+		{"", "xterm-256color", StyleRich, false},
+		{"auto", "xterm", StyleRich, false},
+		{"", "dumb", StylePlain, false},
+		{"auto", "dumb", StylePlain, false},
+		{"rich", "dumb", StyleRich, false},
+		{"Plain", "xterm", StylePlain, false},
+		{"fancy", "xterm", StyleRich, true},
+	}
+	for _, tt := range tests {
+		got, err := ParseStyle(tt.setting, tt.term)
+		if got != tt.want || (err != nil) != tt.wantErr {
+			t.Errorf("ParseStyle(%q, %q) = %v, %v; want %v, error %v", tt.setting, tt.term, got, err, tt.want, tt.wantErr)
+		}
+	}
+}
+
+func TestSymbolsFor_PlainIsASCII(t *testing.T) {
+	v := reflect.ValueOf(*SymbolsFor(StylePlain))
+	for i := 0; i < v.NumField(); i++ {
+		for _, r := range v.Field(i).String() {
+			if r > unicode.MaxASCII {
+				t.Errorf("plain symbol %s = %q contains non-ASCII rune %q", v.Type().Field(i).Name, v.Field(i).String(), r)
+			}
+		}
+	}
+}
+
+func TestNewSymbols_FollowsCurrentStyle(t *testing.T) {
+	defer SetStyle(CurrentStyle())
+
+	SetStyle(StylePlain)
+	if got := NewSymbols().Arrow; got != "->" {
+		t.Errorf("plain Arrow = %q, want %q", got, "->")
+	}
+	SetStyle(StyleRich)
+	if got := NewSymbols().Arrow; got != "→" {
+		t.Errorf("rich Arrow = %q, want %q", got, "→")
+	}
+}
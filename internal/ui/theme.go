@@ -0,0 +1,304 @@
+package ui
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Theme maps the roles of interactive UI elements to escape sequences.
+// Each role is a complete style; output resets it with ANSIColors.Reset.
+type Theme struct {
+	Title    string // screen titles and section headings of overlays
+	Heading  string // workflow headings and labels
+	Text     string // primary text such as the selected description
+	Emphasis string // names and labels that stand out from text
+	Muted    string // hints, counts and unselected descriptions
+	Accent   string // icons, borders and separators
+	Command  string // command names in lists and workflow steps
+	Key      string // key labels in keybinding lists
+	Selected string // the selected list item and active tab
+	Pointer  string // selection pointer and active markers
+	Match    string // characters matched by the search query
+	Input    string // typed input
+	Prompt   string // input labels such as "Search:"
+	Branch   string // current branch icon in the status line
+	Changes  string // working tree changes in the status line
+	Remote   string // ahead/behind counts in the status line
+	Success  string
+	Warning  string
+	Error    string
+	Alias    string // [alias] tag
+	Workflow string // [workflow] tag
+}
+
+// themeRoles lists the configurable roles in display order.
+var themeRoles = []struct {
+	name  string
+	field func(*Theme) *string
+}{
+	{"title", func(t *Theme) *string { return &t.Title }},
+	{"heading", func(t *Theme) *string { return &t.Heading }},
+	{"text", func(t *Theme) *string { return &t.Text }},
+	{"emphasis", func(t *Theme) *string { return &t.Emphasis }},
+	{"muted", func(t *Theme) *string { return &t.Muted }},
+	{"accent", func(t *Theme) *string { return &t.Accent }},
+	{"command", func(t *Theme) *string { return &t.Command }},
+	{"key", func(t *Theme) *string { return &t.Key }},
+	{"selected", func(t *Theme) *string { return &t.Selected }},
+	{"pointer", func(t *Theme) *string { return &t.Pointer }},
+	{"match", func(t *Theme) *string { return &t.Match }},
+	{"input", func(t *Theme) *string { return &t.Input }},
+	{"prompt", func(t *Theme) *string { return &t.Prompt }},
+	{"branch", func(t *Theme) *string { return &t.Branch }},
+	{"changes", func(t *Theme) *string { return &t.Changes }},
+	{"remote", func(t *Theme) *string { return &t.Remote }},
+	{"success", func(t *Theme) *string { return &t.Success }},
+	{"warning", func(t *Theme) *string { return &t.Warning }},
+	{"error", func(t *Theme) *string { return &t.Error }},
+	{"alias", func(t *Theme) *string { return &t.Alias }},
+	{"workflow", func(t *Theme) *string { return &t.Workflow }},
+}
+
+// DefaultThemeName is the theme used when none is configured.
+const DefaultThemeName = "dark"
+
+// builtinThemes holds the color specs of each builtin theme by role.
+var builtinThemes = map[string]map[string]string{
+	"dark": {
+		"title":    "bright-cyan bold",
+		"heading":  "bright-yellow bold",
+		"text":     "bright-white",
+		"emphasis": "bright-white bold",
+		"muted":    "bright-black",
+		"accent":   "bright-blue",
+		"command":  "bright-green bold",
+		"key":      "bright-green bold",
+		"selected": "bright-white bold reverse",
+		"pointer":  "bright-cyan bold",
+		"match":    "bright-yellow underline",
+		"input":    "bright-yellow",
+		"prompt":   "bright-green bold",
+		"branch":   "bright-blue",
+		"changes":  "bright-yellow",
+		"remote":   "bright-magenta bold",
+		"success":  "bright-green bold",
+		"warning":  "bright-yellow",
+		"error":    "bright-red bold",
+		"alias":    "bright-magenta bold",
+		"workflow": "bright-yellow bold",
+	},
+	"light": {
+		"title":    "blue bold",
+		"heading":  "magenta bold",
+		"text":     "black",
+		"emphasis": "black bold",
+		"muted":    "bright-black",
+		"accent":   "blue",
+		"command":  "green bold",
+		"key":      "blue bold",
+		"selected": "black bold reverse",
+		"pointer":  "blue bold",
+		"match":    "magenta underline",
+		"input":    "blue",
+		"prompt":   "green bold",
+		"branch":   "blue",
+		"changes":  "magenta",
+		"remote":   "magenta bold",
+		"success":  "green bold",
+		"warning":  "red",
+		"error":    "red bold",
+		"alias":    "magenta bold",
+		"workflow": "blue bold",
+	},
+	"solarized": {
+		"title":    "#2aa198 bold",
+		"heading":  "#b58900 bold",
+		"text":     "#839496",
+		"emphasis": "#93a1a1 bold",
+		"muted":    "#586e75",
+		"accent":   "#268bd2",
+		"command":  "#859900 bold",
+		"key":      "#859900 bold",
+		"selected": "#fdf6e3 bg:#268bd2 bold",
+		"pointer":  "#2aa198 bold",
+		"match":    "#cb4b16 underline",
+		"input":    "#b58900",
+		"prompt":   "#859900 bold",
+		"branch":   "#268bd2",
+		"changes":  "#b58900",
+		"remote":   "#d33682 bold",
+		"success":  "#859900 bold",
+		"warning":  "#cb4b16",
+		"error":    "#dc322f bold",
+		"alias":    "#6c71c4 bold",
+		"workflow": "#b58900 bold",
+	},
+	"high-contrast": {
+		"title":    "bright-white bold underline",
+		"heading":  "bright-yellow bold",
+		"text":     "bright-white",
+		"emphasis": "bright-white bold",
+		"muted":    "white",
+		"accent":   "bright-cyan",
+		"command":  "bright-white bold",
+		"key":      "bright-yellow bold",
+		"selected": "black bg:bright-yellow bold",
+		"pointer":  "bright-yellow bold",
+		"match":    "bright-cyan bold underline",
+		"input":    "bright-white bold",
+		"prompt":   "bright-yellow bold",
+		"branch":   "bright-cyan bold",
+		"changes":  "bright-yellow bold",
+		"remote":   "bright-magenta bold",
+		"success":  "bright-green bold",
+		"warning":  "bright-yellow bold",
+		"error":    "bright-red bold",
+		"alias":    "bright-magenta bold",
+		"workflow": "bright-cyan bold",
+	},
+}
+
+// ThemeNames returns the names of the builtin themes, sorted.
+func ThemeNames() []string {
+	names := make([]string, 0, len(builtinThemes))
+	for name := range builtinThemes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// ThemeRoles returns the names of the configurable theme roles.
+func ThemeRoles() []string {
+	names := make([]string, len(themeRoles))
+	for i, role := range themeRoles {
+		names[i] = role.name
+	}
+	return names
+}
+
+// NewTheme builds the named builtin theme ("" selects the default) with the
+// given role overrides applied. Overrides use the color spec syntax of
+// ParseColorSpec; empty overrides are ignored. When color is disabled every
+// role is empty.
+func NewTheme(name string, overrides map[string]string) (*Theme, error) {
+	if name == "" {
+		name = DefaultThemeName
+	}
+	specs, ok := builtinThemes[name]
+	if !ok {
+		return nil, fmt.Errorf("unknown theme %q (must be one of: %s)", name, strings.Join(ThemeNames(), ", "))
+	}
+	theme := &Theme{}
+	for _, role := range themeRoles {
+		spec := specs[role.name]
+		if override := strings.TrimSpace(overrides[role.name]); override != "" {
+			spec = override
+		}
+		seq, err := ParseColorSpec(spec)
+		if err != nil {
+			return nil, fmt.Errorf("theme role %q: %w", role.name, err)
+		}
+		*role.field(theme) = seq
+	}
+	for role := range overrides {
+		if !isThemeRole(role) {
+			return nil, fmt.Errorf("unknown theme role %q", role)
+		}
+	}
+	if colorDisabled.Load() {
+		return &Theme{}, nil
+	}
+	return theme, nil
+}
+
+// DefaultTheme returns the default theme without overrides.
+func DefaultTheme() *Theme {
+	theme, _ := NewTheme(DefaultThemeName, nil)
+	return theme
+}
+
+func isThemeRole(name string) bool {
+	for _, role := range themeRoles {
+		if role.name == name {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	namedColorCodes = map[string]int{
+		"black": 0, "red": 1, "green": 2, "yellow": 3,
+		"blue": 4, "magenta": 5, "cyan": 6, "white": 7,
+	}
+	attributeCodes = map[string]int{
+		"bold": 1, "dim": 2, "italic": 3, "underline": 4, "reverse": 7,
+	}
+)
+
+// ParseColorSpec converts a space-separated color spec into escape
+// sequences. Tokens are attributes (bold, dim, italic, underline, reverse)
+// and colors: a name (red, bright-blue, default, ...), a 256-color index
+// (0-255) or a truecolor hex value (#rrggbb). A color prefixed with "bg:"
+// sets the background. "none" yields no styling.
+func ParseColorSpec(spec string) (string, error) {
+	var b strings.Builder
+	for _, token := range strings.Fields(strings.ToLower(spec)) {
+		if token == "none" {
+			continue
+		}
+		if code, ok := attributeCodes[token]; ok {
+			fmt.Fprintf(&b, "\033[%dm", code)
+			continue
+		}
+		background := false
+		if rest, ok := strings.CutPrefix(token, "bg:"); ok {
+			token, background = rest, true
+		}
+		seq, err := colorSequence(token, background)
+		if err != nil {
+			return "", err
+		}
+		b.WriteString(seq)
+	}
+	return b.String(), nil
+}
+
+func colorSequence(token string, background bool) (string, error) {
+	base := 30
+	if background {
+		base = 40
+	}
+	if token == "default" {
+		return fmt.Sprintf("\033[%dm", base+9), nil
+	}
+	if code, ok := namedColorCodes[strings.TrimPrefix(token, "bright-")]; ok {
+		if strings.HasPrefix(token, "bright-") {
+			code += 60
+		}
+		return fmt.Sprintf("\033[%dm", base+code), nil
+	}
+	if token == "gray" || token == "grey" {
+		return fmt.Sprintf("\033[%dm", base+60), nil
+	}
+	if hex, ok := strings.CutPrefix(token, "#"); ok {
+		if len(hex) != 6 {
+			return "", fmt.Errorf("invalid hex color %q (want #rrggbb)", token)
+		}
+		rgb, err := strconv.ParseUint(hex, 16, 32)
+		if err != nil {
+			return "", fmt.Errorf("invalid hex color %q (want #rrggbb)", token)
+		}
+		return fmt.Sprintf("\033[%d;2;%d;%d;%dm", base+8, rgb>>16, rgb>>8&0xff, rgb&0xff), nil
+	}
+	if n, err := strconv.Atoi(token); err == nil {
+		if n < 0 || n > 255 {
+			return "", fmt.Errorf("256-color index %d out of range 0-255", n)
+		}
+		return fmt.Sprintf("\033[%d;5;%dm", base+8, n), nil
+	}
+	return "", fmt.Errorf("unknown color or attribute %q", token)
+}
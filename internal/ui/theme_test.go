@@ -0,0 +1,99 @@
+package ui
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestParseColorSpec(t *testing.T) {
+	tests := []struct {
+		spec    string
+		want    string
+		wantErr bool
+	}{
+		{"", "", false},
+		{"none", "", false},
+		{"bright-cyan bold", "\033[96m\033[1m", false},
+		{"red", "\033[31m", false},
+		{"bg:blue", "\033[44m", false},
+		{"214", "\033[38;5;214m", false},
+		{"bg:#268bd2 underline", "\033[48;2;38;139;210m\033[4m", false},
+		{"Default", "\033[39m", false},
+		{"256", "", true},
+		{"#12345", "", true},
+		{"#gggggg", "", true},
+		{"sparkly", "", true},
+	}
+	for _, tt := range tests {
+		got, err := ParseColorSpec(tt.spec)
+		if got != tt.want || (err != nil) != tt.wantErr {
+			t.Errorf("ParseColorSpec(%q) = %q, %v; want %q, error %v", tt.spec, got, err, tt.want, tt.wantErr)
+		}
+	}
+}
+
+func TestNewTheme_DarkMatchesPalette(t *testing.T) {
+	colors := NewANSIColors()
+	theme, err := NewTheme("", nil)
+	if err != nil {
+		t.Fatalf("NewTheme: %v", err)
+	}
+	if want := colors.BrightWhite + colors.Bold + colors.Reverse; theme.Selected != want {
+		t.Errorf("Selected = %q, want %q", theme.Selected, want)
+	}
+	if want := colors.BrightYellow + colors.Underline; theme.Match != want {
+		t.Errorf("Match = %q, want %q", theme.Match, want)
+	}
+}
+
+func TestNewTheme_BuiltinsDefineEveryRole(t *testing.T) {
+	for _, name := range ThemeNames() {
+		specs := builtinThemes[name]
+		for _, role := range ThemeRoles() {
+			if specs[role] == "" {
+				t.Errorf("theme %q does not define role %q", name, role)
+			}
+		}
+		if _, err := NewTheme(name, nil); err != nil {
+			t.Errorf("NewTheme(%q): %v", name, err)
+		}
+	}
+}
+
+func TestNewTheme_Overrides(t *testing.T) {
+	theme, err := NewTheme("light", map[string]string{"branch": "#ff8800 bold", "selected": ""})
+	if err != nil {
+		t.Fatalf("NewTheme: %v", err)
+	}
+	if theme.Branch != "\033[38;2;255;136;0m\033[1m" {
+		t.Errorf("Branch = %q, want the truecolor override", theme.Branch)
+	}
+	if want, _ := ParseColorSpec(builtinThemes["light"]["selected"]); theme.Selected != want {
+		t.Errorf("empty override should keep the theme's selected style, got %q", theme.Selected)
+	}
+}
+
+func TestNewTheme_Errors(t *testing.T) {
+	if _, err := NewTheme("neon", nil); err == nil || !strings.Contains(err.Error(), "high-contrast") {
+		t.Errorf("unknown theme: expected error listing themes, got %v", err)
+	}
+	if _, err := NewTheme("dark", map[string]string{"banner": "red"}); err == nil {
+		t.Error("unknown role: expected error")
+	}
+	if _, err := NewTheme("dark", map[string]string{"branch": "sparkly"}); err == nil || !strings.Contains(err.Error(), "branch") {
+		t.Errorf("invalid spec: expected error naming the role, got %v", err)
+	}
+}
+
+func TestNewTheme_ColorDisabled(t *testing.T) {
+	SetColor(false)
+	defer SetColor(true)
+
+	theme, err := NewTheme("solarized", nil)
+	if err != nil {
+		t.Fatalf("NewTheme: %v", err)
+	}
+	if *theme != (Theme{}) {
+		t.Errorf("theme with color disabled = %+v, want empty", *theme)
+	}
+}
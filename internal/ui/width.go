@@ -0,0 +1,151 @@
+package ui
+
+import (
+	"regexp"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"golang.org/x/text/width"
+)
+
+var ansiSequence = regexp.MustCompile(`\x1b\[[0-9;?]*[ -/]*[@-~]|\x1b\][^\a]*\a`)
+
+// StripANSI removes terminal escape sequences from s.
+func StripANSI(s string) string {
+	if !strings.ContainsRune(s, 0x1b) {
+		return s
+	}
+	return ansiSequence.ReplaceAllString(s, "")
+}
+
+// IsCombining reports whether r is a combining mark (zero display width)
+func IsCombining(r rune) bool {
+	return unicode.Is(unicode.Mn, r) || unicode.Is(unicode.Me, r) || unicode.Is(unicode.Mc, r)
+}
+
+// IsVariationSelector reports whether r is a variation selector (zero width)
+func IsVariationSelector(r rune) bool {
+	// U+FE00..U+FE0F (VS1..VS16) and U+E0100..U+E01EF (IVS)
+	return (r >= 0xFE00 && r <= 0xFE0F) || (r >= 0xE0100 && r <= 0xE01EF)
+}
+
+// IsRegionalIndicator reports whether r is a regional indicator rune (used for flags)
+func IsRegionalIndicator(r rune) bool { return r >= 0x1F1E6 && r <= 0x1F1FF }
+
+// IsZWJ reports whether r is ZERO WIDTH JOINER
+func IsZWJ(r rune) bool { return r == 0x200D }
+
+// isEmoji reports common emoji ranges that should render as width 2 on most terminals
+func isEmoji(r rune) bool {
+	return isEmojiRange1(r) || isEmojiRange2(r)
+}
+
+// isEmojiRange1 checks the first set of emoji Unicode ranges
+func isEmojiRange1(r rune) bool {
+	return (r >= 0x1F300 && r <= 0x1F5FF) || // Misc Symbols and Pictographs
+		(r >= 0x1F600 && r <= 0x1F64F) || // Emoticons
+		(r >= 0x1F680 && r <= 0x1F6FF) || // Transport and Map Symbols
+		(r >= 0x1F700 && r <= 0x1F77F) || // Alchemical Symbols
+		(r >= 0x1F780 && r <= 0x1F7FF) // Geometric Shapes Extended
+}
+
+// isEmojiRange2 checks the second set of emoji Unicode ranges
+func isEmojiRange2(r rune) bool {
+	return (r >= 0x1F800 && r <= 0x1F8FF) || // Supplemental Arrows-C
+		(r >= 0x1F900 && r <= 0x1F9FF) || // Supplemental Symbols and Pictographs
+		(r >= 0x1FA00 && r <= 0x1FAFF) || // Symbols and Pictographs Extended-A
+		(r >= 0x2600 && r <= 0x26FF) || // Misc symbols
+		(r >= 0x2700 && r <= 0x27BF) // Dingbats
+}
+
+// RuneWidth returns the number of terminal columns used by r
+func RuneWidth(r rune) int {
+	// Zero-width characters
+	if IsCombining(r) || IsVariationSelector(r) || IsZWJ(r) {
+		return 0
+	}
+	// East Asian wide/fullwidth
+	switch width.LookupRune(r).Kind() {
+	case width.EastAsianFullwidth, width.EastAsianWide:
+		return 2
+	}
+	// Common emoji are typically 2 columns
+	if isEmoji(r) {
+		return 2
+	}
+	return 1
+}
+
+// DisplayWidth returns the number of terminal columns used by s, ignoring
+// ANSI escape sequences.
+func DisplayWidth(s string) int {
+	cols := 0
+	for _, r := range StripANSI(s) {
+		cols += RuneWidth(r)
+	}
+	return cols
+}
+
+// TruncateToWidth shortens plain text s to at most maxWidth columns, marking
+// the cut with an ellipsis. Wide runes are never split.
+func TruncateToWidth(s string, maxWidth int) string {
+	if maxWidth <= 0 {
+		return ""
+	}
+	if DisplayWidth(s) <= maxWidth {
+		return s
+	}
+	ellipsis := NewSymbols().Ellipsis
+	if DisplayWidth(ellipsis) > maxWidth {
+		ellipsis = ""
+	}
+	limit := maxWidth - DisplayWidth(ellipsis)
+	var b strings.Builder
+	cols := 0
+	for _, r := range s {
+		w := RuneWidth(r)
+		if cols+w > limit {
+			break
+		}
+		b.WriteRune(r)
+		cols += w
+	}
+	return b.String() + ellipsis
+}
+
+// FitWidth truncates or pads s to exactly width terminal columns, keeping
+// ANSI escape sequences intact.
+func FitWidth(s string, width int) string {
+	var b strings.Builder
+	used := 0
+	for i := 0; i < len(s); {
+		if s[i] == 0x1b {
+			if loc := ansiSequence.FindStringIndex(s[i:]); loc != nil && loc[0] == 0 {
+				b.WriteString(s[i : i+loc[1]])
+				i += loc[1]
+				continue
+			}
+		}
+		r, size := utf8.DecodeRuneInString(s[i:])
+		w := RuneWidth(r)
+		if used+w > width {
+			break
+		}
+		b.WriteRune(r)
+		used += w
+		i += size
+	}
+	if used < width {
+		b.WriteString(strings.Repeat(" ", width-used))
+	}
+	return b.String()
+}
+
+// Pluralize returns the "s" suffix for counts other than one.
+func Pluralize(n int) string {
+	if n == 1 {
+		return ""
+	}
+	return "s"
+}
@@ -0,0 +1,81 @@
+package ui
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestRuneWidth(t *testing.T) {
+	tests := []struct {
+		r    rune
+		want int
+	}{
+		{'A', 1},
+		{'中', 2},    // East Asian Wide
+		{0x0301, 0}, // combining acute accent
+		{0xFE0F, 0}, // variation selector
+		{0x200D, 0}, // zero width joiner
+		{'😀', 2},
+	}
+	for _, tt := range tests {
+		if got := RuneWidth(tt.r); got != tt.want {
+			t.Errorf("RuneWidth(%U) = %d, want %d", tt.r, got, tt.want)
+		}
+	}
+}
+
+func TestDisplayWidthAndTruncate(t *testing.T) {
+	if got := DisplayWidth("日本語ab"); got != 8 {
+		t.Errorf("DisplayWidth(CJK) = %d, want 8", got)
+	}
+	if got := DisplayWidth("\x1b[1mab\x1b[0m"); got != 2 {
+		t.Errorf("DisplayWidth ignores escapes: got %d, want 2", got)
+	}
+	if got := DisplayWidth("cafe\u0301"); got != 4 {
+		t.Errorf("DisplayWidth counts combining marks: got %d, want 4", got)
+	}
+
+	tests := []struct {
+		in    string
+		width int
+		want  string
+	}{
+		{"feature/login", 20, "feature/login"},
+		{"feature/login", 8, "feature…"},
+		{"日本語ブランチ", 7, "日本語…"},
+		{"日本語ブランチ", 6, "日本…"},
+		{"abc", 0, ""},
+	}
+	for _, tt := range tests {
+		got := TruncateToWidth(tt.in, tt.width)
+		if got != tt.want {
+			t.Errorf("TruncateToWidth(%q, %d) = %q, want %q", tt.in, tt.width, got, tt.want)
+		}
+		if tt.width > 0 && DisplayWidth(got) > tt.width {
+			t.Errorf("TruncateToWidth(%q, %d) is %d columns wide", tt.in, tt.width, DisplayWidth(got))
+		}
+	}
+}
+
+func TestFitWidth(t *testing.T) {
+	red, reset := "\x1b[31m", "\x1b[0m"
+	got := FitWidth(red+"abcdef"+reset, 4)
+	if StripANSI(got) != "abcd" || !strings.HasPrefix(got, red) {
+		t.Errorf("FitWidth truncate = %q", got)
+	}
+	if got := FitWidth("日本", 6); got != "日本  " {
+		t.Errorf("FitWidth pad wide runes = %q", got)
+	}
+	if got := FitWidth("cafe\u0301", 6); got != "cafe\u0301  " {
+		t.Errorf("FitWidth pad combining marks = %q", got)
+	}
+}
+
+func TestPluralize(t *testing.T) {
+	if got := Pluralize(1); got != "" {
+		t.Errorf("Pluralize(1) = %q, want empty", got)
+	}
+	if got := Pluralize(2); got != "s" {
+		t.Errorf("Pluralize(2) = %q, want s", got)
+	}
+}
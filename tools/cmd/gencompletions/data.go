@@ -10,6 +10,7 @@ import (
 const (
 	cmdBranch   = "branch"
 	cmdAdd      = "add"
+	cmdBlame    = "blame"
 	cmdRebase   = "rebase"
 	subCheckout = "checkout"
 )
@@ -29,6 +30,8 @@ type CommandData struct {
 	SubcommandList     string
 	KeywordSubcommands []SubcommandData
 	IncludeInCase      bool
+	// CompletesFiles marks commands whose arguments are file paths.
+	CompletesFiles bool
 }
 
 type SubcommandData struct {
@@ -162,6 +165,7 @@ func buildCommandData(cmd *command.Info) *CommandData {
 		SubcommandList:     strings.Join(subNames, " "),
 		KeywordSubcommands: keywordSubs,
 		IncludeInCase:      shouldIncludeInCase(cmd.Name, len(subcommands) > 0),
+		CompletesFiles:     completesFiles(cmd.Name),
 	}
 }
 
@@ -286,10 +290,13 @@ func shouldIncludeInCase(commandName string, hasSubcommands bool) bool {
 	if !hasSubcommands {
 		return false
 	}
-	if commandName == cmdAdd {
-		return false
-	}
-	return true
+	return !completesFiles(commandName)
+}
+
+// completesFiles reports whether the arguments of a command are file paths,
+// offered along with its subcommands.
+func completesFiles(commandName string) bool {
+	return commandName == cmdAdd || commandName == cmdBlame
 }
 
 func containsFold(values []string, candidate string) bool {
@@ -312,6 +312,10 @@ func TestShouldIncludeInCase(t *testing.T) {
 	if shouldIncludeInCase(cmdAdd, true) {
 		t.Error("shouldIncludeInCase(cmdAdd) should return false")
 	}
+	// cmdBlame completes files like cmdAdd → false
+	if shouldIncludeInCase(cmdBlame, true) {
+		t.Error("shouldIncludeInCase(cmdBlame) should return false")
+	}
 	// Other command with subcommands → true
 	if !shouldIncludeInCase("branch", true) {
 		t.Error("shouldIncludeInCase(branch, true) should return true")
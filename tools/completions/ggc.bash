@@ -8,13 +8,23 @@ _ggc()
     cur="${COMP_WORDS[COMP_CWORD]}"
     prev="${COMP_WORDS[COMP_CWORD-1]}"
 
-    opts="add branch clean commit config debug-keys diff fetch help hook log pull push quit rebase remote reset restore stash status tag version"
+    opts="add bisect blame branch cherry-pick clean commit config conflicts debug-keys diff fetch help hook log merge pull push quit rebase remote reset restore revert show stash status submodule tag version worktree"
     case ${prev} in
+        bisect)
+            subopts="bad good reset run skip start status"
+            COMPREPLY=( $(compgen -W "${subopts}" -- ${cur}) )
+            return 0
+            ;;
         branch)
             subopts="checkout contains create current delete info list move rename set sort"
             COMPREPLY=( $(compgen -W "${subopts}" -- ${cur}) )
             return 0
             ;;
+        cherry-pick)
+            subopts="abort continue skip"
+            COMPREPLY=( $(compgen -W "${subopts}" -- ${cur}) )
+            return 0
+            ;;
         clean)
             subopts="dirs files interactive"
             COMPREPLY=( $(compgen -W "${subopts}" -- ${cur}) )
@@ -30,13 +40,18 @@ _ggc()
             COMPREPLY=( $(compgen -W "${subopts}" -- ${cur}) )
             return 0
             ;;
+        conflicts)
+            subopts="continue list ours resolved theirs tool"
+            COMPREPLY=( $(compgen -W "${subopts}" -- ${cur}) )
+            return 0
+            ;;
         debug-keys)
             subopts="raw"
             COMPREPLY=( $(compgen -W "${subopts}" -- ${cur}) )
             return 0
             ;;
         diff)
-            subopts="head staged unstaged"
+            subopts="branch head pick side staged unified unstaged word"
             COMPREPLY=( $(compgen -W "${subopts}" -- ${cur}) )
             return 0
             ;;
@@ -55,6 +70,11 @@ _ggc()
             COMPREPLY=( $(compgen -W "${subopts}" -- ${cur}) )
             return 0
             ;;
+        merge)
+            subopts="abort continue ff-only no-ff squash"
+            COMPREPLY=( $(compgen -W "${subopts}" -- ${cur}) )
+            return 0
+            ;;
         pull)
             subopts="current rebase"
             COMPREPLY=( $(compgen -W "${subopts}" -- ${cur}) )
@@ -85,6 +105,16 @@ _ggc()
             COMPREPLY=( $(compgen -W "${subopts}" -- ${cur}) )
             return 0
             ;;
+        revert)
+            subopts="abort continue no-commit"
+            COMPREPLY=( $(compgen -W "${subopts}" -- ${cur}) )
+            return 0
+            ;;
+        show)
+            subopts="interactive"
+            COMPREPLY=( $(compgen -W "${subopts}" -- ${cur}) )
+            return 0
+            ;;
         stash)
             subopts="apply branch clear create drop list pop push save show store"
             COMPREPLY=( $(compgen -W "${subopts}" -- ${cur}) )
@@ -95,11 +125,21 @@ _ggc()
             COMPREPLY=( $(compgen -W "${subopts}" -- ${cur}) )
             return 0
             ;;
+        submodule)
+            subopts="add foreach init status sync update"
+            COMPREPLY=( $(compgen -W "${subopts}" -- ${cur}) )
+            return 0
+            ;;
         tag)
             subopts="annotated create delete list push show"
             COMPREPLY=( $(compgen -W "${subopts}" -- ${cur}) )
             return 0
             ;;
+        worktree)
+            subopts="add list lock prune remove unlock"
+            COMPREPLY=( $(compgen -W "${subopts}" -- ${cur}) )
+            return 0
+            ;;
     esac
 
     if [[ ${COMP_CWORD} == 1 ]]; then
@@ -126,10 +166,22 @@ _ggc()
         COMPREPLY=( $(compgen -W "no-edit" -- ${cur}) )
         return 0
     fi
+    if [[ ${COMP_WORDS[1]} == "diff" && ${COMP_WORDS[2]} == "branch" ]]; then
+        COMPREPLY=( $(compgen -W "pick" -- ${cur}) )
+        return 0
+    fi
     if [[ ${COMP_WORDS[1]} == "stash" && ${COMP_WORDS[2]} == "push" ]]; then
         COMPREPLY=( $(compgen -W "-m" -- ${cur}) )
         return 0
     fi
+    if [[ ${COMP_WORDS[1]} == "worktree" && ${COMP_WORDS[2]} == "add" ]]; then
+        COMPREPLY=( $(compgen -W "new" -- ${cur}) )
+        return 0
+    fi
+    if [[ ${COMP_WORDS[1]} == "worktree" && ${COMP_WORDS[2]} == "remove" ]]; then
+        COMPREPLY=( $(compgen -W "force" -- ${cur}) )
+        return 0
+    fi
 
     if [[ ${COMP_WORDS[1]} == "branch" && ${COMP_WORDS[2]} == "checkout" ]]; then
         local branches candidates
@@ -151,6 +203,18 @@ _ggc()
         return 0
     fi
 
+    if [[ ${COMP_WORDS[1]} == "blame" ]]; then
+        local files candidates extras
+        extras="interactive"
+        candidates="${extras}"
+        files=$(ggc __complete files 2>/dev/null)
+        if [[ -n ${files} ]]; then
+            candidates="${candidates} ${files}"
+        fi
+        COMPREPLY=( $(compgen -W "${candidates}" -- ${cur}) )
+        return 0
+    fi
+
     if [[ ${COMP_WORDS[1]} == "rebase" && ${COMP_CWORD} -eq 2 ]]; then
         case ${cur} in
             continue|abort|skip|interactive)
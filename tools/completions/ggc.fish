@@ -10,31 +10,42 @@ function __ggc_complete_files
 end
 
 # Main commands
-complete -c ggc -f -a "add branch clean commit config debug-keys diff fetch help hook log pull push quit rebase remote reset restore stash status tag version"
+complete -c ggc -f -a "add bisect blame branch cherry-pick clean commit config conflicts debug-keys diff fetch help hook log merge pull push quit rebase remote reset restore revert show stash status submodule tag version worktree"
+complete -c ggc -f -n "__fish_seen_subcommand_from bisect" -a "bad good reset run skip start status"
 complete -c ggc -f -n "__fish_seen_subcommand_from branch" -a "checkout contains create current delete info list move rename set sort"
 complete -c ggc -f -n "__fish_seen_subcommand_from branch; and __fish_seen_subcommand_from delete" -a "merged"
 complete -c ggc -f -n "__fish_seen_subcommand_from branch; and __fish_seen_subcommand_from list" -a "local remote verbose"
 complete -c ggc -f -n "__fish_seen_subcommand_from branch; and __fish_seen_subcommand_from set" -a "upstream"
+complete -c ggc -f -n "__fish_seen_subcommand_from cherry-pick" -a "abort continue skip"
 complete -c ggc -f -n "__fish_seen_subcommand_from clean" -a "dirs files interactive"
 complete -c ggc -f -n "__fish_seen_subcommand_from commit" -a "allow amend fixup"
 complete -c ggc -f -n "__fish_seen_subcommand_from commit; and __fish_seen_subcommand_from allow" -a "empty"
 complete -c ggc -f -n "__fish_seen_subcommand_from commit; and __fish_seen_subcommand_from amend" -a "no-edit"
 complete -c ggc -f -n "__fish_seen_subcommand_from config" -a "get list set"
+complete -c ggc -f -n "__fish_seen_subcommand_from conflicts" -a "continue list ours resolved theirs tool"
 complete -c ggc -f -n "__fish_seen_subcommand_from debug-keys" -a "raw"
-complete -c ggc -f -n "__fish_seen_subcommand_from diff" -a "head staged unstaged"
+complete -c ggc -f -n "__fish_seen_subcommand_from diff" -a "branch head pick side staged unified unstaged word"
+complete -c ggc -f -n "__fish_seen_subcommand_from diff; and __fish_seen_subcommand_from branch" -a "pick"
 complete -c ggc -f -n "__fish_seen_subcommand_from fetch" -a "prune"
 complete -c ggc -f -n "__fish_seen_subcommand_from hook" -a "disable edit enable install list uninstall"
 complete -c ggc -f -n "__fish_seen_subcommand_from log" -a "graph simple"
+complete -c ggc -f -n "__fish_seen_subcommand_from merge" -a "abort continue ff-only no-ff squash"
 complete -c ggc -f -n "__fish_seen_subcommand_from pull" -a "current rebase"
 complete -c ggc -f -n "__fish_seen_subcommand_from push" -a "current force"
 complete -c ggc -f -n "__fish_seen_subcommand_from rebase" -a "abort autosquash continue interactive skip"
 complete -c ggc -f -n "__fish_seen_subcommand_from remote" -a "add list remove set-url"
 complete -c ggc -f -n "__fish_seen_subcommand_from reset" -a "hard soft"
 complete -c ggc -f -n "__fish_seen_subcommand_from restore" -a "staged"
+complete -c ggc -f -n "__fish_seen_subcommand_from revert" -a "abort continue no-commit"
+complete -c ggc -f -n "__fish_seen_subcommand_from show" -a "interactive"
 complete -c ggc -f -n "__fish_seen_subcommand_from stash" -a "apply branch clear create drop list pop push save show store"
 complete -c ggc -f -n "__fish_seen_subcommand_from stash; and __fish_seen_subcommand_from push" -a "-m"
 complete -c ggc -f -n "__fish_seen_subcommand_from status" -a "short"
+complete -c ggc -f -n "__fish_seen_subcommand_from submodule" -a "add foreach init status sync update"
 complete -c ggc -f -n "__fish_seen_subcommand_from tag" -a "annotated create delete list push show"
+complete -c ggc -f -n "__fish_seen_subcommand_from worktree" -a "add list lock prune remove unlock"
+complete -c ggc -f -n "__fish_seen_subcommand_from worktree; and __fish_seen_subcommand_from add" -a "new"
+complete -c ggc -f -n "__fish_seen_subcommand_from worktree; and __fish_seen_subcommand_from remove" -a "force"
 
 # Branch checkout needs both keyword and dynamic branch names
 complete -c ggc -f -n "__fish_seen_subcommand_from branch; and __fish_seen_subcommand_from checkout" -a "remote (__ggc_complete_branches)"
@@ -42,6 +53,8 @@ complete -c ggc -f -n "__fish_seen_subcommand_from branch; and __fish_seen_subco
 # Rebase branch completion when not using a subcommand
 complete -c ggc -f -n "__fish_seen_subcommand_from rebase; and not __fish_seen_subcommand_from interactive; and not __fish_seen_subcommand_from continue; and not __fish_seen_subcommand_from abort; and not __fish_seen_subcommand_from skip" -a "(__ggc_complete_branches)"
 
-# Add subcommands also allow file completion
+# Add and blame subcommands also allow file completion
 complete -c ggc -f -n "__fish_seen_subcommand_from add" -a "interactive patch"
 complete -c ggc -f -n "__fish_seen_subcommand_from add" -a "(__ggc_complete_files)"
+complete -c ggc -f -n "__fish_seen_subcommand_from blame" -a "interactive"
+complete -c ggc -f -n "__fish_seen_subcommand_from blame" -a "(__ggc_complete_files)"